@@ -0,0 +1,101 @@
+package chat
+
+import (
+	"errors"
+	"io"
+
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// downloadChunkSize is the size of the content chunks sent to the client
+const downloadChunkSize = 32 << 10
+
+// UploadAttachment stores a file to be sent with a message to the chat
+func (i *Implementation) UploadAttachment(stream desc.ChatV1_UploadAttachmentServer) error {
+
+	req, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return status.Error(codes.InvalidArgument, "attachment info is missing")
+		}
+		return err
+	}
+
+	info := req.GetInfo()
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "the first request must carry the attachment info")
+	}
+
+	attachment, err := i.chatService.UploadAttachment(stream.Context(), converter.ToAttachmentUploadFromDesc(info), &uploadReader{stream: stream})
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(converter.ToAttachmentFromService(attachment))
+}
+
+// DownloadAttachment streams an attachment to a member of its chat
+func (i *Implementation) DownloadAttachment(req *desc.DownloadAttachmentRequest, stream desc.ChatV1_DownloadAttachmentServer) error {
+
+	attachment, content, err := i.chatService.OpenAttachment(stream.Context(), req.GetAttachmentId())
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	err = stream.Send(&desc.DownloadAttachmentResponse{
+		Payload: &desc.DownloadAttachmentResponse_Info{Info: converter.ToAttachmentFromService(attachment)},
+	})
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, errRead := content.Read(buf)
+		if n > 0 {
+			err = stream.Send(&desc.DownloadAttachmentResponse{
+				Payload: &desc.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if errors.Is(errRead, io.EOF) {
+			return nil
+		}
+		if errRead != nil {
+			return errRead
+		}
+	}
+}
+
+// uploadReader reads the content chunks following the attachment info
+type uploadReader struct {
+	stream desc.ChatV1_UploadAttachmentServer
+	buf    []byte
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		if req.GetInfo() != nil {
+			return 0, status.Error(codes.InvalidArgument, "attachment info must be sent only once")
+		}
+
+		r.buf = req.GetChunk()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+
+	return n, nil
+}
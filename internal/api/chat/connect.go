@@ -0,0 +1,32 @@
+package chat
+
+import (
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ConnectChat streams messages sent to the chat until the client disconnects
+func (i *Implementation) ConnectChat(req *desc.ConnectChatRequest, stream desc.ChatV1_ConnectChatServer) error {
+	ctx := stream.Context()
+
+	messages, err := i.chatService.ConnectChat(ctx, req.GetChatId())
+	if err != nil {
+		return err
+	}
+
+	for message := range messages {
+		err = stream.Send(converter.ToMessageFromService(message))
+		if err != nil {
+			return err
+		}
+	}
+
+	// the channel is also closed when the subscriber can't keep up
+	if ctx.Err() == nil {
+		return status.Error(codes.Unavailable, "subscription dropped, reconnect and backfill with ListMessages")
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// DeleteMessage deletes a message for the caller only or for everyone in the chat
+func (i *Implementation) DeleteMessage(ctx context.Context, req *desc.DeleteMessageRequest) (*emptypb.Empty, error) {
+
+	err := i.chatService.DeleteMessage(ctx, converter.ToMessageDeleteFromDesc(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,34 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+)
+
+// EditMessage changes the text of a message, keeping the previous one in its history
+func (i *Implementation) EditMessage(ctx context.Context, req *desc.EditMessageRequest) (*desc.EditMessageResponse, error) {
+
+	message, err := i.chatService.EditMessage(ctx, converter.ToMessageEditFromDesc(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.EditMessageResponse{
+		Message: converter.ToMessageFromService(message),
+	}, nil
+}
+
+// GetMessageHistory returns previous revisions of a message, oldest first
+func (i *Implementation) GetMessageHistory(ctx context.Context, req *desc.GetMessageHistoryRequest) (*desc.GetMessageHistoryResponse, error) {
+
+	revisions, err := i.chatService.GetMessageHistory(ctx, req.GetChatId(), req.GetMessageId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetMessageHistoryResponse{
+		Revisions: converter.ToMessageRevisionsFromService(revisions),
+	}, nil
+}
@@ -0,0 +1,21 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+)
+
+// ForwardMessages copies messages of one chat to the end of another
+func (i *Implementation) ForwardMessages(ctx context.Context, req *desc.ForwardMessagesRequest) (*desc.ForwardMessagesResponse, error) {
+
+	messages, err := i.chatService.ForwardMessages(ctx, req.GetSourceChatId(), req.GetMessageIds(), req.GetTargetChatId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.ForwardMessagesResponse{
+		Messages: converter.ToMessagesFromService(messages),
+	}, nil
+}
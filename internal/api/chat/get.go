@@ -0,0 +1,21 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+)
+
+// GetChat returns a chat by id
+func (i *Implementation) GetChat(ctx context.Context, req *desc.GetChatRequest) (*desc.GetChatResponse, error) {
+
+	chat, err := i.chatService.GetChat(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetChatResponse{
+		Chat: converter.ToChatFromService(chat),
+	}, nil
+}
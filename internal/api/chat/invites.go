@@ -0,0 +1,51 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// CreateInviteLink creates a link to join a chat by
+func (i *Implementation) CreateInviteLink(ctx context.Context, req *desc.CreateInviteLinkRequest) (*desc.InviteLink, error) {
+
+	var expiresAt *time.Time
+	if req.GetExpiresAt() != nil {
+		t := req.GetExpiresAt().AsTime()
+		expiresAt = &t
+	}
+
+	invite, err := i.chatService.CreateInviteLink(ctx, req.GetChatId(), expiresAt, req.GetMaxUses())
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToInviteLinkFromService(invite), nil
+}
+
+// RevokeInviteLink stops an invite link from being used
+func (i *Implementation) RevokeInviteLink(ctx context.Context, req *desc.RevokeInviteLinkRequest) (*emptypb.Empty, error) {
+
+	err := i.chatService.RevokeInviteLink(ctx, req.GetChatId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// JoinByInvite adds the caller to the chat of an invite link
+func (i *Implementation) JoinByInvite(ctx context.Context, req *desc.JoinByInviteRequest) (*desc.JoinByInviteResponse, error) {
+
+	chatID, err := i.chatService.JoinByInvite(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.JoinByInviteResponse{
+		ChatId: chatID,
+	}, nil
+}
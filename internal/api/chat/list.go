@@ -0,0 +1,22 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+)
+
+// ListChats returns the chats the caller belongs to, most recently active first
+func (i *Implementation) ListChats(ctx context.Context, req *desc.ListChatsRequest) (*desc.ListChatsResponse, error) {
+
+	page, err := i.chatService.ListChats(ctx, req.GetUserId(), req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.ListChatsResponse{
+		Chats:         converter.ToChatsFromService(page.Chats),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+)
+
+// ListMessages returns a page of chat history in chronological order
+func (i *Implementation) ListMessages(ctx context.Context, req *desc.ListMessagesRequest) (*desc.ListMessagesResponse, error) {
+
+	page, err := i.chatService.ListMessages(ctx, converter.ToMessageListQueryFromDesc(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.ListMessagesResponse{
+		Messages:   converter.ToMessagesFromService(page.Messages),
+		PrevCursor: page.PrevCursor,
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package chat
+
+import (
+	"context"
+
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// AddMembers adds users to a chat
+func (i *Implementation) AddMembers(ctx context.Context, req *desc.AddMembersRequest) (*emptypb.Empty, error) {
+
+	err := i.chatService.AddMembers(ctx, req.GetChatId(), req.GetUserIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RemoveMembers removes users from a chat
+func (i *Implementation) RemoveMembers(ctx context.Context, req *desc.RemoveMembersRequest) (*emptypb.Empty, error) {
+
+	err := i.chatService.RemoveMembers(ctx, req.GetChatId(), req.GetUserIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// LeaveChat removes the user from a chat
+func (i *Implementation) LeaveChat(ctx context.Context, req *desc.LeaveChatRequest) (*emptypb.Empty, error) {
+
+	err := i.chatService.LeaveChat(ctx, req.GetChatId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
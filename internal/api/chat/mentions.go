@@ -0,0 +1,22 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+)
+
+// ListMentions returns the messages mentioning the user, newest first
+func (i *Implementation) ListMentions(ctx context.Context, req *desc.ListMentionsRequest) (*desc.ListMentionsResponse, error) {
+
+	page, err := i.chatService.ListMentions(ctx, req.GetUserId(), req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.ListMentionsResponse{
+		Messages:      converter.ToMessagesFromService(page.Messages),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// PinMessage pins a message in the chat
+func (i *Implementation) PinMessage(ctx context.Context, req *desc.PinMessageRequest) (*emptypb.Empty, error) {
+
+	err := i.chatService.PinMessage(ctx, req.GetChatId(), req.GetMessageId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// UnpinMessage unpins a message in the chat
+func (i *Implementation) UnpinMessage(ctx context.Context, req *desc.UnpinMessageRequest) (*emptypb.Empty, error) {
+
+	err := i.chatService.UnpinMessage(ctx, req.GetChatId(), req.GetMessageId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListPinned returns the pinned messages of the chat, most recently pinned first
+func (i *Implementation) ListPinned(ctx context.Context, req *desc.ListPinnedRequest) (*desc.ListPinnedResponse, error) {
+
+	pins, err := i.chatService.ListPinned(ctx, req.GetChatId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.ListPinnedResponse{
+		Pins: converter.ToPinnedMessagesFromService(pins),
+	}, nil
+}
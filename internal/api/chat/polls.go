@@ -0,0 +1,41 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+)
+
+// CreatePoll posts a poll message to the chat
+func (i *Implementation) CreatePoll(ctx context.Context, req *desc.CreatePollRequest) (*desc.Message, error) {
+
+	message, err := i.chatService.CreatePoll(ctx, converter.ToPollCreateFromDesc(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToMessageFromService(message), nil
+}
+
+// Vote replaces the caller's vote in the poll and returns the live tallies
+func (i *Implementation) Vote(ctx context.Context, req *desc.VoteRequest) (*desc.Poll, error) {
+
+	poll, err := i.chatService.Vote(ctx, converter.ToPollVoteFromDesc(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToPollFromService(poll), nil
+}
+
+// ClosePoll stops the poll from accepting votes
+func (i *Implementation) ClosePoll(ctx context.Context, req *desc.ClosePollRequest) (*desc.Poll, error) {
+
+	poll, err := i.chatService.ClosePoll(ctx, req.GetChatId(), req.GetMessageId())
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToPollFromService(poll), nil
+}
@@ -0,0 +1,59 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Heartbeat keeps the caller online
+func (i *Implementation) Heartbeat(ctx context.Context, _ *desc.HeartbeatRequest) (*emptypb.Empty, error) {
+
+	err := i.chatService.Heartbeat(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetPresence returns the presence of the given users
+func (i *Implementation) GetPresence(ctx context.Context, req *desc.GetPresenceRequest) (*desc.GetPresenceResponse, error) {
+
+	presences, err := i.chatService.GetPresence(ctx, req.GetUserIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetPresenceResponse{
+		Presences: converter.ToPresencesFromService(presences),
+	}, nil
+}
+
+// WatchPresence streams presence changes of the caller's chat members until the client disconnects
+func (i *Implementation) WatchPresence(_ *desc.WatchPresenceRequest, stream desc.ChatV1_WatchPresenceServer) error {
+	ctx := stream.Context()
+
+	changes, err := i.chatService.WatchPresence(ctx)
+	if err != nil {
+		return err
+	}
+
+	for change := range changes {
+		err = stream.Send(converter.ToPresenceFromService(change))
+		if err != nil {
+			return err
+		}
+	}
+
+	// the channel is also closed when the subscriber can't keep up
+	if ctx.Err() == nil {
+		return status.Error(codes.Unavailable, "subscription dropped, reconnect")
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// AddReaction reacts to a message with an emoji on behalf of the caller
+func (i *Implementation) AddReaction(ctx context.Context, req *desc.AddReactionRequest) (*emptypb.Empty, error) {
+
+	err := i.chatService.AddReaction(ctx, converter.ToReactionFromDesc(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RemoveReaction takes back the caller's reaction to a message
+func (i *Implementation) RemoveReaction(ctx context.Context, req *desc.RemoveReactionRequest) (*emptypb.Empty, error) {
+
+	err := i.chatService.RemoveReaction(ctx, converter.ToReactionFromRemoveDesc(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
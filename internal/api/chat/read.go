@@ -0,0 +1,35 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+)
+
+// MarkRead moves the caller's read position in a chat up to the given message
+func (i *Implementation) MarkRead(ctx context.Context, req *desc.MarkReadRequest) (*desc.MarkReadResponse, error) {
+
+	mark, err := i.chatService.MarkRead(ctx, req.GetChatId(), req.GetUpToSeq())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.MarkReadResponse{
+		LastReadSeq: mark.LastReadSeq,
+		UnreadCount: mark.UnreadCount,
+	}, nil
+}
+
+// GetReadState returns how far every member has read a chat
+func (i *Implementation) GetReadState(ctx context.Context, req *desc.GetReadStateRequest) (*desc.GetReadStateResponse, error) {
+
+	states, err := i.chatService.GetReadState(ctx, req.GetChatId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetReadStateResponse{
+		States: converter.ToReadStatesFromService(states),
+	}, nil
+}
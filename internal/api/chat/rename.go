@@ -0,0 +1,19 @@
+package chat
+
+import (
+	"context"
+
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// RenameChat changes the name of a chat
+func (i *Implementation) RenameChat(ctx context.Context, req *desc.RenameChatRequest) (*emptypb.Empty, error) {
+
+	err := i.chatService.RenameChat(ctx, req.GetChatId(), req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
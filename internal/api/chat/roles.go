@@ -0,0 +1,31 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// SetMemberRole makes a chat member an admin or a plain member
+func (i *Implementation) SetMemberRole(ctx context.Context, req *desc.SetMemberRoleRequest) (*emptypb.Empty, error) {
+
+	err := i.chatService.SetMemberRole(ctx, req.GetChatId(), req.GetUserId(), converter.ToMemberRoleFromDesc(req.GetRole()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// TransferOwnership hands a chat over to another member
+func (i *Implementation) TransferOwnership(ctx context.Context, req *desc.TransferOwnershipRequest) (*emptypb.Empty, error) {
+
+	err := i.chatService.TransferOwnership(ctx, req.GetChatId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,44 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ScheduleMessage schedules a message to be sent on behalf of the caller later
+func (i *Implementation) ScheduleMessage(ctx context.Context, req *desc.ScheduleMessageRequest) (*desc.ScheduledMessage, error) {
+
+	scheduled, err := i.chatService.ScheduleMessage(ctx, req.GetChatId(), req.GetText(), req.GetSendAt().AsTime())
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToScheduledMessageFromService(scheduled), nil
+}
+
+// ListScheduledMessages returns the caller's pending scheduled messages of the chat
+func (i *Implementation) ListScheduledMessages(ctx context.Context, req *desc.ListScheduledMessagesRequest) (*desc.ListScheduledMessagesResponse, error) {
+
+	scheduled, err := i.chatService.ListScheduledMessages(ctx, req.GetChatId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.ListScheduledMessagesResponse{
+		ScheduledMessages: converter.ToScheduledMessagesFromService(scheduled),
+	}, nil
+}
+
+// CancelScheduledMessage drops a message the caller scheduled in the chat
+func (i *Implementation) CancelScheduledMessage(ctx context.Context, req *desc.CancelScheduledMessageRequest) (*emptypb.Empty, error) {
+
+	err := i.chatService.CancelScheduledMessage(ctx, req.GetChatId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,22 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+)
+
+// SearchMessages searches the messages of the chats the user belongs to, best matches first
+func (i *Implementation) SearchMessages(ctx context.Context, req *desc.SearchMessagesRequest) (*desc.SearchMessagesResponse, error) {
+
+	page, err := i.chatService.SearchMessages(ctx, converter.ToSearchQueryFromDesc(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.SearchMessagesResponse{
+		Results:       converter.ToSearchResultsFromService(page.Results),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
@@ -5,18 +5,21 @@ import (
 
 	"github.com/BelyaevEI/microservices_chat/internal/converter"
 	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // SendMessage sends a new message
 func (i *Implementation) SendMessage(ctx context.Context, req *desc.SendMessageRequest) (*desc.SendMessageResponse, error) {
 
-	id, err := i.chatService.SendMessage(ctx, converter.ToMessageCreateFromDesc(req))
+	message, err := i.chatService.SendMessage(ctx, converter.ToMessageCreateFromDesc(req))
 	if err != nil {
 		return nil, err
 	}
 
 	return &desc.SendMessageResponse{
-		Id:     id,
-		ChatId: req.GetToChatId(),
+		Id:        message.ID,
+		ChatId:    message.Info.ChatID,
+		Seq:       message.Seq,
+		CreatedAt: timestamppb.New(message.CreatedAt),
 	}, nil
 }
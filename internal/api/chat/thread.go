@@ -0,0 +1,24 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+)
+
+// ListThread returns the root message and a page of its replies in chronological order
+func (i *Implementation) ListThread(ctx context.Context, req *desc.ListThreadRequest) (*desc.ListThreadResponse, error) {
+
+	page, err := i.chatService.ListThread(ctx, converter.ToThreadQueryFromDesc(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.ListThreadResponse{
+		Root:       converter.ToMessageFromService(page.Root),
+		Replies:    converter.ToMessagesFromService(page.Replies),
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
+	}, nil
+}
@@ -0,0 +1,19 @@
+package chat
+
+import (
+	"context"
+
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// SetMessageTTL sets the time-to-live of messages sent to the chat from now on
+func (i *Implementation) SetMessageTTL(ctx context.Context, req *desc.SetMessageTTLRequest) (*emptypb.Empty, error) {
+
+	err := i.chatService.SetMessageTTL(ctx, req.GetChatId(), req.GetTtl().AsDuration())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
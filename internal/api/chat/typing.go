@@ -0,0 +1,46 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/converter"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// SetTyping tells the other members of the chat that the user started or stopped typing
+func (i *Implementation) SetTyping(ctx context.Context, req *desc.SetTypingRequest) (*emptypb.Empty, error) {
+
+	err := i.chatService.SetTyping(ctx, req.GetChatId(), req.GetUserId(), req.GetIsTyping())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// WatchTyping streams typing indicators of the other chat members until the client disconnects
+func (i *Implementation) WatchTyping(req *desc.WatchTypingRequest, stream desc.ChatV1_WatchTypingServer) error {
+	ctx := stream.Context()
+
+	events, err := i.chatService.WatchTyping(ctx, req.GetChatId())
+	if err != nil {
+		return err
+	}
+
+	for event := range events {
+		err = stream.Send(converter.ToTypingEventFromService(event))
+		if err != nil {
+			return err
+		}
+	}
+
+	// the channel is also closed when the subscriber can't keep up
+	if ctx.Err() == nil {
+		return status.Error(codes.Unavailable, "subscription dropped, reconnect")
+	}
+
+	return nil
+}
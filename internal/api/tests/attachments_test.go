@@ -0,0 +1,286 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/BelyaevEI/microservices_chat/internal/api/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/BelyaevEI/microservices_chat/internal/service/mocks"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type uploadAttachmentStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*desc.UploadAttachmentRequest
+	response *desc.Attachment
+}
+
+func (s *uploadAttachmentStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *uploadAttachmentStream) Recv() (*desc.UploadAttachmentRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+
+	return req, nil
+}
+
+func (s *uploadAttachmentStream) SendAndClose(m *desc.Attachment) error {
+	s.response = m
+	return nil
+}
+
+type downloadAttachmentStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*desc.DownloadAttachmentResponse
+}
+
+func (s *downloadAttachmentStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *downloadAttachmentStream) Send(m *desc.DownloadAttachmentResponse) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestUploadAttachment(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller, received *bytes.Buffer) service.ChatService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID       = gofakeit.Int64()
+		attachmentID = gofakeit.UUID()
+		fileName     = gofakeit.Word() + ".txt"
+		createdAt    = gofakeit.Date()
+
+		serviceErr = fmt.Errorf("service error")
+
+		info = &desc.UploadAttachmentRequest{
+			Payload: &desc.UploadAttachmentRequest_Info{Info: &desc.AttachmentInfo{ChatId: chatID, FileName: fileName}},
+		}
+
+		chunks = []*desc.UploadAttachmentRequest{
+			{Payload: &desc.UploadAttachmentRequest_Chunk{Chunk: []byte("hello ")}},
+			{Payload: &desc.UploadAttachmentRequest_Chunk{Chunk: []byte("world")}},
+		}
+
+		upload = &model.AttachmentUpload{
+			ChatID:   chatID,
+			FileName: fileName,
+		}
+
+		attachment = &model.Attachment{
+			ID:        attachmentID,
+			ChatID:    chatID,
+			FileName:  fileName,
+			MimeType:  "text/plain",
+			Size:      11,
+			CreatedAt: createdAt,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		requests        []*desc.UploadAttachmentRequest
+		want            *desc.Attachment
+		wantContent     string
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name:     "success case",
+			requests: append([]*desc.UploadAttachmentRequest{info}, chunks...),
+			want: &desc.Attachment{
+				Id:        attachmentID,
+				ChatId:    chatID,
+				FileName:  fileName,
+				MimeType:  "text/plain",
+				Size:      11,
+				CreatedAt: timestamppb.New(createdAt),
+			},
+			wantContent: "hello world",
+			err:         nil,
+			chatServiceMock: func(mc *minimock.Controller, received *bytes.Buffer) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.UploadAttachmentMock.
+					ExpectCtxParam1(ctx).
+					ExpectUploadParam2(upload).
+					Inspect(func(_ context.Context, _ *model.AttachmentUpload, content io.Reader) {
+						_, _ = io.Copy(received, content)
+					}).
+					Return(attachment, nil)
+				return mock
+			},
+		},
+		{
+			name:     "info sent twice case",
+			requests: []*desc.UploadAttachmentRequest{info, info},
+			want:     nil,
+			err:      serviceErr,
+			chatServiceMock: func(mc *minimock.Controller, received *bytes.Buffer) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.UploadAttachmentMock.
+					ExpectCtxParam1(ctx).
+					ExpectUploadParam2(upload).
+					Inspect(func(_ context.Context, _ *model.AttachmentUpload, content io.Reader) {
+						_, err := io.Copy(received, content)
+						if err == nil {
+							mc.Errorf("second attachment info was read as content")
+						}
+					}).
+					Return(nil, serviceErr)
+				return mock
+			},
+		},
+		{
+			name:     "missing info case",
+			requests: chunks,
+			want:     nil,
+			err:      status.Error(codes.InvalidArgument, "the first request must carry the attachment info"),
+			chatServiceMock: func(mc *minimock.Controller, _ *bytes.Buffer) service.ChatService {
+				return mocks.NewChatServiceMock(mc)
+			},
+		},
+		{
+			name:     "empty stream case",
+			requests: nil,
+			want:     nil,
+			err:      status.Error(codes.InvalidArgument, "attachment info is missing"),
+			chatServiceMock: func(mc *minimock.Controller, _ *bytes.Buffer) service.ChatService {
+				return mocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var received bytes.Buffer
+			attachmentServiceMock := test.chatServiceMock(mc, &received)
+			api := chat.NewImplementation(attachmentServiceMock)
+
+			stream := &uploadAttachmentStream{ctx: ctx, requests: test.requests}
+			err := api.UploadAttachment(stream)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, stream.response)
+			require.Equal(t, test.wantContent, received.String())
+		})
+	}
+
+}
+
+func TestDownloadAttachment(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID       = gofakeit.Int64()
+		attachmentID = gofakeit.UUID()
+		createdAt    = gofakeit.Date()
+		// more than one chunk
+		content = strings.Repeat("a", 40<<10)
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.DownloadAttachmentRequest{
+			AttachmentId: attachmentID,
+		}
+
+		attachment = &model.Attachment{
+			ID:        attachmentID,
+			ChatID:    chatID,
+			Size:      int64(len(content)),
+			CreatedAt: createdAt,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		wantInfo        *desc.Attachment
+		wantContent     string
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			wantInfo: &desc.Attachment{
+				Id:        attachmentID,
+				ChatId:    chatID,
+				Size:      int64(len(content)),
+				CreatedAt: timestamppb.New(createdAt),
+			},
+			wantContent: content,
+			err:         nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.OpenAttachmentMock.Expect(ctx, attachmentID).Return(attachment, io.NopCloser(strings.NewReader(content)), nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.OpenAttachmentMock.Expect(ctx, attachmentID).Return(nil, nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			attachmentServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(attachmentServiceMock)
+
+			stream := &downloadAttachmentStream{ctx: ctx}
+			err := api.DownloadAttachment(req, stream)
+			require.Equal(t, test.err, err)
+
+			var (
+				info     *desc.Attachment
+				received strings.Builder
+			)
+			for i, res := range stream.sent {
+				if i == 0 {
+					info = res.GetInfo()
+					continue
+				}
+				received.Write(res.GetChunk())
+			}
+			require.Equal(t, test.wantInfo, info)
+			require.Equal(t, test.wantContent, received.String())
+		})
+	}
+
+}
@@ -0,0 +1,159 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/api/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/BelyaevEI/microservices_chat/internal/service/mocks"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type connectChatStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*desc.Message
+}
+
+func (s *connectChatStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *connectChatStream) Send(m *desc.Message) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestConnectChat(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller, ctx context.Context) service.ChatService
+
+	type args struct {
+		cancel bool
+		req    *desc.ConnectChatRequest
+	}
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		userID    = gofakeit.Int64()
+		messageID = gofakeit.UUID()
+		text      = gofakeit.Phrase()
+		createdAt = gofakeit.Date().UTC().Truncate(time.Microsecond)
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.ConnectChatRequest{
+			ChatId: chatID,
+		}
+
+		message = &model.Message{
+			ID: messageID,
+			Info: model.MessageInfo{
+				ChatID: chatID,
+				UserID: userID,
+				Text:   text,
+			},
+			CreatedAt: createdAt,
+		}
+
+		sent = []*desc.Message{
+			{
+				Id:        messageID,
+				ChatId:    chatID,
+				UserId:    userID,
+				Text:      text,
+				CreatedAt: timestamppb.New(createdAt),
+			},
+		}
+
+		messages = func() <-chan *model.Message {
+			ch := make(chan *model.Message, 1)
+			ch <- message
+			close(ch)
+			return ch
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            []*desc.Message
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				cancel: true,
+				req:    req,
+			},
+			want: sent,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller, ctx context.Context) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.ConnectChatMock.Expect(ctx, chatID).Return(messages(), nil)
+				return mock
+			},
+		},
+		{
+			name: "dropped subscription case",
+			args: args{
+				req: req,
+			},
+			want: sent,
+			err:  status.Error(codes.Unavailable, "subscription dropped, reconnect and backfill with ListMessages"),
+			chatServiceMock: func(mc *minimock.Controller, ctx context.Context) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.ConnectChatMock.Expect(ctx, chatID).Return(messages(), nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller, ctx context.Context) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.ConnectChatMock.Expect(ctx, chatID).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if test.args.cancel {
+				cancel()
+			}
+
+			connectServiceMock := test.chatServiceMock(mc, ctx)
+			api := chat.NewImplementation(connectServiceMock)
+
+			stream := &connectChatStream{ctx: ctx}
+			err := api.ConnectChat(test.args.req, stream)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, stream.sent)
+		})
+	}
+
+}
@@ -46,8 +46,9 @@ func TestCreate(t *testing.T) {
 		}
 
 		chatCreate = model.ChatCreate{
-			Name:   chatName,
-			UserID: ids,
+			Name:      chatName,
+			UserID:    ids,
+			Usernames: names,
 		}
 	)
 
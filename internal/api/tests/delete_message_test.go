@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/BelyaevEI/microservices_chat/internal/api/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/BelyaevEI/microservices_chat/internal/service/mocks"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestDeleteMessage(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.DeleteMessageRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		messageID = gofakeit.UUID()
+		chatID    = gofakeit.Int64()
+
+		serviceErr = fmt.Errorf("service error")
+
+		forMeReq = &desc.DeleteMessageRequest{
+			MessageId: messageID,
+			ChatId:    chatID,
+			Mode:      desc.DeleteMode_DELETE_MODE_FOR_ME,
+		}
+
+		forEveryoneReq = &desc.DeleteMessageRequest{
+			MessageId: messageID,
+			ChatId:    chatID,
+			Mode:      desc.DeleteMode_DELETE_MODE_FOR_EVERYONE,
+		}
+
+		forMe = &model.MessageDelete{
+			MessageID: messageID,
+			ChatID:    chatID,
+		}
+
+		forEveryone = &model.MessageDelete{
+			MessageID:   messageID,
+			ChatID:      chatID,
+			ForEveryone: true,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *emptypb.Empty
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "for me case",
+			args: args{
+				ctx: ctx,
+				req: forMeReq,
+			},
+			want: &emptypb.Empty{},
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.DeleteMessageMock.Expect(ctx, forMe).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "for everyone case",
+			args: args{
+				ctx: ctx,
+				req: forEveryoneReq,
+			},
+			want: &emptypb.Empty{},
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.DeleteMessageMock.Expect(ctx, forEveryone).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: forEveryoneReq,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.DeleteMessageMock.Expect(ctx, forEveryone).Return(serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			deleteServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(deleteServiceMock)
+
+			res, err := api.DeleteMessage(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
@@ -0,0 +1,223 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/api/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/BelyaevEI/microservices_chat/internal/service/mocks"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestEditMessage(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.EditMessageRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		messageID = gofakeit.UUID()
+		chatID    = gofakeit.Int64()
+		editorID  = gofakeit.Int64()
+		text      = gofakeit.Phrase()
+		createdAt = gofakeit.Date().UTC().Truncate(time.Microsecond)
+		editedAt  = createdAt.Add(time.Minute)
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.EditMessageRequest{
+			MessageId: messageID,
+			ChatId:    chatID,
+			Text:      text,
+		}
+
+		edit = &model.MessageEdit{
+			MessageID: messageID,
+			ChatID:    chatID,
+			Text:      text,
+		}
+
+		message = &model.Message{
+			ID: messageID,
+			Info: model.MessageInfo{
+				ChatID: chatID,
+				UserID: editorID,
+				Text:   text,
+			},
+			CreatedAt: createdAt,
+			EditedAt:  &editedAt,
+		}
+
+		res = &desc.EditMessageResponse{
+			Message: &desc.Message{
+				Id:        messageID,
+				ChatId:    chatID,
+				UserId:    editorID,
+				Text:      text,
+				CreatedAt: timestamppb.New(createdAt),
+				EditedAt:  timestamppb.New(editedAt),
+			},
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *desc.EditMessageResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.EditMessageMock.Expect(ctx, edit).Return(message, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.EditMessageMock.Expect(ctx, edit).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			editServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(editServiceMock)
+
+			res, err := api.EditMessage(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
+
+func TestGetMessageHistory(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.GetMessageHistoryRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		messageID = gofakeit.UUID()
+		chatID    = gofakeit.Int64()
+		text      = gofakeit.Phrase()
+		editedAt  = gofakeit.Date().UTC().Truncate(time.Microsecond)
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.GetMessageHistoryRequest{
+			MessageId: messageID,
+			ChatId:    chatID,
+		}
+
+		revisions = []*model.MessageRevision{
+			{
+				MessageID: messageID,
+				ChatID:    chatID,
+				Text:      text,
+				EditedAt:  editedAt,
+			},
+		}
+
+		res = &desc.GetMessageHistoryResponse{
+			Revisions: []*desc.MessageRevision{
+				{
+					Text:     text,
+					EditedAt: timestamppb.New(editedAt),
+				},
+			},
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *desc.GetMessageHistoryResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.GetMessageHistoryMock.Expect(ctx, chatID, messageID).Return(revisions, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.GetMessageHistoryMock.Expect(ctx, chatID, messageID).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			historyServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(historyServiceMock)
+
+			res, err := api.GetMessageHistory(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
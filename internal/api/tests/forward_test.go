@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/BelyaevEI/microservices_chat/internal/api/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/BelyaevEI/microservices_chat/internal/service/mocks"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestForwardMessages(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.ForwardMessagesRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		sourceChatID = gofakeit.Int64()
+		targetChatID = gofakeit.Int64()
+		authorID     = gofakeit.Int64()
+		userID       = gofakeit.Int64()
+		messageID    = gofakeit.UUID()
+		copyID       = gofakeit.UUID()
+		text         = gofakeit.Phrase()
+		createdAt    = gofakeit.Date()
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.ForwardMessagesRequest{
+			SourceChatId: sourceChatID,
+			MessageIds:   []string{messageID},
+			TargetChatId: targetChatID,
+		}
+
+		forwarded = []*model.Message{
+			{
+				ID:        copyID,
+				Info:      model.MessageInfo{ChatID: targetChatID, UserID: userID, Text: text},
+				CreatedAt: createdAt,
+				ForwardedFrom: &model.ForwardedFrom{
+					UserID:    authorID,
+					ChatID:    sourceChatID,
+					MessageID: messageID,
+				},
+			},
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *desc.ForwardMessagesResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: &desc.ForwardMessagesResponse{
+				Messages: []*desc.Message{
+					{
+						Id:        copyID,
+						ChatId:    targetChatID,
+						UserId:    userID,
+						Text:      text,
+						CreatedAt: timestamppb.New(createdAt),
+						ForwardedFrom: &desc.ForwardedFrom{
+							UserId:    authorID,
+							ChatId:    sourceChatID,
+							MessageId: messageID,
+						},
+					},
+				},
+			},
+			err: nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.ForwardMessagesMock.Expect(ctx, sourceChatID, []string{messageID}, targetChatID).Return(forwarded, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.ForwardMessagesMock.Expect(ctx, sourceChatID, []string{messageID}, targetChatID).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			forwardServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(forwardServiceMock)
+
+			res, err := api.ForwardMessages(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
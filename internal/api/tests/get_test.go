@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/api/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/BelyaevEI/microservices_chat/internal/service/mocks"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetChat(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.GetChatRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id        = gofakeit.Int64()
+		chatName  = gofakeit.Animal()
+		ids       = []int64{1, 2, 3}
+		createdAt = gofakeit.Date().UTC().Truncate(time.Microsecond)
+		activeAt  = createdAt.Add(time.Hour)
+
+		serviceErr  = fmt.Errorf("service error")
+		notFoundErr = status.Errorf(codes.NotFound, "chat with id %d not found", id)
+
+		req = &desc.GetChatRequest{
+			Id: id,
+		}
+
+		chatModel = &model.Chat{
+			ID:             id,
+			Name:           chatName,
+			UserID:         ids,
+			CreatedAt:      createdAt,
+			LastActivityAt: activeAt,
+		}
+
+		res = &desc.GetChatResponse{
+			Chat: &desc.Chat{
+				Id:             id,
+				Name:           chatName,
+				UserIds:        ids,
+				CreatedAt:      timestamppb.New(createdAt),
+				LastActivityAt: timestamppb.New(activeAt),
+			},
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *desc.GetChatResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.GetChatMock.Expect(ctx, id).Return(chatModel, nil)
+				return mock
+			},
+		},
+		{
+			name: "not found case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  notFoundErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.GetChatMock.Expect(ctx, id).Return(nil, notFoundErr)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.GetChatMock.Expect(ctx, id).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			getServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(getServiceMock)
+
+			res, err := api.GetChat(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/api/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/BelyaevEI/microservices_chat/internal/service/mocks"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListMessages(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.ListMessagesRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID     = gofakeit.Int64()
+		userID     = gofakeit.Int64()
+		messageID  = gofakeit.UUID()
+		text       = gofakeit.Phrase()
+		before     = gofakeit.UUID()
+		limit      = int32(10)
+		createdAt  = gofakeit.Date().UTC().Truncate(time.Microsecond)
+		prevCursor = gofakeit.UUID()
+		nextCursor = gofakeit.UUID()
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.ListMessagesRequest{
+			ChatId: chatID,
+			Before: before,
+			Limit:  limit,
+		}
+
+		query = &model.MessageListQuery{
+			ChatID: chatID,
+			Before: before,
+			Limit:  limit,
+		}
+
+		page = &model.MessagePage{
+			Messages: []*model.Message{
+				{
+					ID: messageID,
+					Info: model.MessageInfo{
+						ChatID: chatID,
+						UserID: userID,
+						Text:   text,
+					},
+					CreatedAt: createdAt,
+				},
+			},
+			PrevCursor: prevCursor,
+			NextCursor: nextCursor,
+			HasMore:    true,
+		}
+
+		res = &desc.ListMessagesResponse{
+			Messages: []*desc.Message{
+				{
+					Id:        messageID,
+					ChatId:    chatID,
+					UserId:    userID,
+					Text:      text,
+					CreatedAt: timestamppb.New(createdAt),
+				},
+			},
+			PrevCursor: prevCursor,
+			NextCursor: nextCursor,
+			HasMore:    true,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *desc.ListMessagesResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.ListMessagesMock.Expect(ctx, query).Return(page, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.ListMessagesMock.Expect(ctx, query).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			listServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(listServiceMock)
+
+			res, err := api.ListMessages(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
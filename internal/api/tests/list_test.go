@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/api/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/BelyaevEI/microservices_chat/internal/service/mocks"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListChats(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.ListChatsRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID    = gofakeit.Int64()
+		pageSize  = int32(2)
+		pageToken = gofakeit.UUID()
+		nextToken = gofakeit.UUID()
+		createdAt = gofakeit.Date().UTC().Truncate(time.Microsecond)
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.ListChatsRequest{
+			UserId:    userID,
+			PageSize:  pageSize,
+			PageToken: pageToken,
+		}
+
+		page = &model.ChatPage{
+			Chats: []*model.Chat{
+				{ID: 2, Name: gofakeit.Animal(), UserID: []int64{userID}, CreatedAt: createdAt, LastActivityAt: createdAt.Add(time.Hour)},
+				{ID: 1, Name: gofakeit.Animal(), UserID: []int64{userID}, CreatedAt: createdAt, LastActivityAt: createdAt},
+			},
+			NextPageToken: nextToken,
+		}
+
+		res = &desc.ListChatsResponse{
+			Chats: []*desc.Chat{
+				{Id: 2, Name: page.Chats[0].Name, UserIds: []int64{userID}, CreatedAt: timestamppb.New(createdAt), LastActivityAt: timestamppb.New(createdAt.Add(time.Hour))},
+				{Id: 1, Name: page.Chats[1].Name, UserIds: []int64{userID}, CreatedAt: timestamppb.New(createdAt), LastActivityAt: timestamppb.New(createdAt)},
+			},
+			NextPageToken: nextToken,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *desc.ListChatsResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.ListChatsMock.Expect(ctx, userID, pageSize, pageToken).Return(page, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.ListChatsMock.Expect(ctx, userID, pageSize, pageToken).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			listServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(listServiceMock)
+
+			res, err := api.ListChats(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
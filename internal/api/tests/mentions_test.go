@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/BelyaevEI/microservices_chat/internal/api/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/BelyaevEI/microservices_chat/internal/service/mocks"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListMentions(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.ListMentionsRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID    = gofakeit.Int64()
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.UUID()
+		pageSize  = gofakeit.Int32()
+		pageToken = gofakeit.UUID()
+		nextToken = gofakeit.UUID()
+		createdAt = gofakeit.Date()
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.ListMentionsRequest{
+			UserId:    userID,
+			PageSize:  pageSize,
+			PageToken: pageToken,
+		}
+
+		page = &model.MentionPage{
+			Messages: []*model.Message{
+				{
+					ID:        messageID,
+					Info:      model.MessageInfo{ChatID: chatID},
+					CreatedAt: createdAt,
+					Mentions:  []*model.MessageMention{{All: true, Offset: 3, Length: 4}},
+				},
+			},
+			NextPageToken: nextToken,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *desc.ListMentionsResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: &desc.ListMentionsResponse{
+				Messages: []*desc.Message{
+					{
+						Id:        messageID,
+						ChatId:    chatID,
+						CreatedAt: timestamppb.New(createdAt),
+						Mentions:  []*desc.Mention{{All: true, Offset: 3, Length: 4}},
+					},
+				},
+				NextPageToken: nextToken,
+			},
+			err: nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.ListMentionsMock.Expect(ctx, userID, pageSize, pageToken).Return(page, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.ListMentionsMock.Expect(ctx, userID, pageSize, pageToken).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mentionServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(mentionServiceMock)
+
+			res, err := api.ListMentions(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
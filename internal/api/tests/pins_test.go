@@ -0,0 +1,188 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/api/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/BelyaevEI/microservices_chat/internal/service/mocks"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPinMessage(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.PinMessageRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.UUID()
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.PinMessageRequest{
+			ChatId:    chatID,
+			MessageId: messageID,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *emptypb.Empty
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: &emptypb.Empty{},
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.PinMessageMock.Expect(ctx, chatID, messageID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.PinMessageMock.Expect(ctx, chatID, messageID).Return(serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			pinServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(pinServiceMock)
+
+			res, err := api.PinMessage(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
+
+func TestListPinned(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.ListPinnedRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.UUID()
+		pinnedBy  = gofakeit.Int64()
+		pinnedAt  = time.Now()
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.ListPinnedRequest{
+			ChatId: chatID,
+		}
+
+		pins = []*model.PinnedMessage{
+			{
+				Message:  &model.Message{ID: messageID, Info: model.MessageInfo{ChatID: chatID}, CreatedAt: pinnedAt},
+				PinnedBy: pinnedBy,
+				PinnedAt: pinnedAt,
+			},
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *desc.ListPinnedResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: &desc.ListPinnedResponse{
+				Pins: []*desc.PinnedMessage{
+					{
+						Message:  &desc.Message{Id: messageID, ChatId: chatID, CreatedAt: timestamppb.New(pinnedAt)},
+						PinnedBy: pinnedBy,
+						PinnedAt: timestamppb.New(pinnedAt),
+					},
+				},
+			},
+			err: nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.ListPinnedMock.Expect(ctx, chatID).Return(pins, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.ListPinnedMock.Expect(ctx, chatID).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			pinServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(pinServiceMock)
+
+			res, err := api.ListPinned(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
@@ -0,0 +1,123 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/BelyaevEI/microservices_chat/internal/api/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/BelyaevEI/microservices_chat/internal/service/mocks"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVote(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.VoteRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.UUID()
+		voterID   = gofakeit.Int64()
+		question  = gofakeit.Question()
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.VoteRequest{
+			ChatId:        chatID,
+			MessageId:     messageID,
+			OptionIndexes: []int32{1},
+		}
+
+		vote = &model.PollVote{
+			ChatID:    chatID,
+			MessageID: messageID,
+			Options:   []int32{1},
+		}
+
+		poll = &model.Poll{
+			MessageID: messageID,
+			ChatID:    chatID,
+			Question:  question,
+			Options: []*model.PollOption{
+				{Index: 0, Text: "yes"},
+				{Index: 1, Text: "no", Votes: 1, VoterIDs: []int64{voterID}},
+			},
+			TotalVoters: 1,
+		}
+
+		res = &desc.Poll{
+			MessageId: messageID,
+			ChatId:    chatID,
+			Question:  question,
+			Options: []*desc.PollOption{
+				{Index: 0, Text: "yes"},
+				{Index: 1, Text: "no", Votes: 1, VoterIds: []int64{voterID}},
+			},
+			TotalVoters: 1,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *desc.Poll
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.VoteMock.Expect(ctx, vote).Return(poll, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.VoteMock.Expect(ctx, vote).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(chatServiceMock)
+
+			res, err := api.Vote(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
@@ -0,0 +1,191 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/api/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/BelyaevEI/microservices_chat/internal/service/mocks"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestHeartbeat(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.HeartbeatRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.HeartbeatRequest{}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *emptypb.Empty
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: &emptypb.Empty{},
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.HeartbeatMock.Expect(ctx).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.HeartbeatMock.Expect(ctx).Return(serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			presenceServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(presenceServiceMock)
+
+			res, err := api.Heartbeat(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
+
+func TestGetPresence(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.GetPresenceRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		onlineID   = gofakeit.Int64()
+		offlineID  = gofakeit.Int64()
+		lastSeenAt = gofakeit.Date().UTC().Truncate(time.Microsecond)
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.GetPresenceRequest{
+			UserIds: []int64{onlineID, offlineID},
+		}
+
+		presences = []*model.Presence{
+			{
+				UserID:     onlineID,
+				Status:     model.PresenceOnline,
+				LastSeenAt: lastSeenAt,
+			},
+			{
+				UserID: offlineID,
+				Status: model.PresenceOffline,
+			},
+		}
+
+		res = &desc.GetPresenceResponse{
+			Presences: []*desc.Presence{
+				{
+					UserId:     onlineID,
+					Status:     desc.PresenceStatus_PRESENCE_STATUS_ONLINE,
+					LastSeenAt: timestamppb.New(lastSeenAt),
+				},
+				{
+					UserId: offlineID,
+					Status: desc.PresenceStatus_PRESENCE_STATUS_OFFLINE,
+				},
+			},
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *desc.GetPresenceResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.GetPresenceMock.Expect(ctx, []int64{onlineID, offlineID}).Return(presences, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.GetPresenceMock.Expect(ctx, []int64{onlineID, offlineID}).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			presenceServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(presenceServiceMock)
+
+			res, err := api.GetPresence(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
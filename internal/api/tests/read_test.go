@@ -0,0 +1,183 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/BelyaevEI/microservices_chat/internal/api/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/BelyaevEI/microservices_chat/internal/service/mocks"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkRead(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.MarkReadRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		seq    = gofakeit.Int64()
+		unread = gofakeit.Int64()
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.MarkReadRequest{
+			ChatId:  chatID,
+			UpToSeq: seq,
+		}
+
+		res = &desc.MarkReadResponse{
+			LastReadSeq: seq,
+			UnreadCount: unread,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *desc.MarkReadResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.MarkReadMock.Expect(ctx, chatID, seq).Return(&model.ReadMark{LastReadSeq: seq, UnreadCount: unread}, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.MarkReadMock.Expect(ctx, chatID, seq).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			readServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(readServiceMock)
+
+			res, err := api.MarkRead(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
+
+func TestGetReadState(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.GetReadStateRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID  = gofakeit.Int64()
+		userID  = gofakeit.Int64()
+		lastSeq = gofakeit.Int64()
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.GetReadStateRequest{
+			ChatId: chatID,
+		}
+
+		res = &desc.GetReadStateResponse{
+			States: []*desc.ReadState{
+				{
+					UserId:      userID,
+					LastReadSeq: lastSeq,
+				},
+			},
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *desc.GetReadStateResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.GetReadStateMock.Expect(ctx, chatID).Return([]*model.ReadState{{UserID: userID, LastReadSeq: lastSeq}}, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.GetReadStateMock.Expect(ctx, chatID).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			readServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(readServiceMock)
+
+			res, err := api.GetReadState(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
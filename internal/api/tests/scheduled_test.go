@@ -0,0 +1,194 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/api/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/BelyaevEI/microservices_chat/internal/service/mocks"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestScheduleMessage(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.ScheduleMessageRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id        = gofakeit.UUID()
+		chatID    = gofakeit.Int64()
+		userID    = gofakeit.Int64()
+		text      = gofakeit.Word()
+		sendAt    = time.Now().Add(time.Hour).UTC()
+		createdAt = time.Now().UTC()
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.ScheduleMessageRequest{
+			ChatId: chatID,
+			Text:   text,
+			SendAt: timestamppb.New(sendAt),
+		}
+
+		scheduled = &model.ScheduledMessage{
+			ID: id,
+			Info: model.MessageInfo{
+				ChatID: chatID,
+				UserID: userID,
+				Text:   text,
+			},
+			SendAt:    sendAt,
+			CreatedAt: createdAt,
+		}
+
+		res = &desc.ScheduledMessage{
+			Id:        id,
+			ChatId:    chatID,
+			UserId:    userID,
+			Text:      text,
+			SendAt:    timestamppb.New(sendAt),
+			CreatedAt: timestamppb.New(createdAt),
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *desc.ScheduledMessage
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.ScheduleMessageMock.Expect(ctx, chatID, text, sendAt).Return(scheduled, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.ScheduleMessageMock.Expect(ctx, chatID, text, sendAt).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(chatServiceMock)
+
+			res, err := api.ScheduleMessage(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+}
+
+func TestCancelScheduledMessage(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.CancelScheduledMessageRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id     = gofakeit.UUID()
+		chatID = gofakeit.Int64()
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.CancelScheduledMessageRequest{
+			ChatId: chatID,
+			Id:     id,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *emptypb.Empty
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: &emptypb.Empty{},
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.CancelScheduledMessageMock.Expect(ctx, chatID, id).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.CancelScheduledMessageMock.Expect(ctx, chatID, id).Return(serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(chatServiceMock)
+
+			res, err := api.CancelScheduledMessage(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/BelyaevEI/microservices_chat/internal/api/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/BelyaevEI/microservices_chat/internal/service/mocks"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSearchMessages(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.SearchMessagesRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID    = gofakeit.Int64()
+		chatID    = gofakeit.Int64()
+		senderID  = gofakeit.Int64()
+		messageID = gofakeit.UUID()
+		text      = gofakeit.Word()
+		from      = gofakeit.Date().UTC()
+		createdAt = gofakeit.Date()
+		nextToken = gofakeit.UUID()
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.SearchMessagesRequest{
+			UserId:   userID,
+			Query:    text,
+			ChatId:   chatID,
+			SenderId: senderID,
+			From:     timestamppb.New(from),
+			PageSize: 10,
+		}
+
+		query = &model.SearchQuery{
+			UserID:   userID,
+			Query:    text,
+			ChatID:   chatID,
+			SenderID: senderID,
+			From:     &from,
+			PageSize: 10,
+		}
+
+		page = &model.SearchPage{
+			Results: []*model.SearchResult{
+				{
+					Message: &model.Message{ID: messageID, Info: model.MessageInfo{ChatID: chatID, UserID: senderID, Text: text}, CreatedAt: createdAt},
+					Snippet: "<mark>" + text + "</mark>",
+					Rank:    0.5,
+				},
+			},
+			NextPageToken: nextToken,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *desc.SearchMessagesResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: &desc.SearchMessagesResponse{
+				Results: []*desc.SearchResult{
+					{
+						Message: &desc.Message{Id: messageID, ChatId: chatID, UserId: senderID, Text: text, CreatedAt: timestamppb.New(createdAt)},
+						Snippet: "<mark>" + text + "</mark>",
+						Rank:    0.5,
+					},
+				},
+				NextPageToken: nextToken,
+			},
+			err: nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.SearchMessagesMock.Expect(ctx, query).Return(page, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.SearchMessagesMock.Expect(ctx, query).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			searchServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(searchServiceMock)
+
+			res, err := api.SearchMessages(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
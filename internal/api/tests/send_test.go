@@ -13,6 +13,7 @@ import (
 	"github.com/brianvoe/gofakeit"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestSendMessage(t *testing.T) {
@@ -28,30 +29,38 @@ func TestSendMessage(t *testing.T) {
 		ctx = context.Background()
 		mc  = minimock.NewController(t)
 
-		id         = gofakeit.UUID()
-		fromUserID = gofakeit.Int64()
-		toChatID   = gofakeit.Int64()
-		text       = gofakeit.BeerName()
+		id        = gofakeit.UUID()
+		toChatID  = gofakeit.Int64()
+		text      = gofakeit.BeerName()
+		seq       = gofakeit.Int64()
+		createdAt = gofakeit.Date().UTC()
 
 		serviceErr = fmt.Errorf("service error")
 
 		req = &desc.SendMessageRequest{
-			FromUserId: fromUserID,
-			ToChatId:   toChatID,
-			Text:       text,
+			ToChatId: toChatID,
+			Text:     text,
 		}
 
 		createMessage = model.MessageCreate{
 			Info: model.MessageInfo{
 				ChatID: toChatID,
-				UserID: fromUserID,
 				Text:   text,
 			},
 		}
 
+		message = &model.Message{
+			ID:        id,
+			Seq:       seq,
+			Info:      createMessage.Info,
+			CreatedAt: createdAt,
+		}
+
 		res = &desc.SendMessageResponse{
-			Id:     id,
-			ChatId: toChatID,
+			Id:        id,
+			ChatId:    toChatID,
+			Seq:       seq,
+			CreatedAt: timestamppb.New(createdAt),
 		}
 	)
 
@@ -72,7 +81,7 @@ func TestSendMessage(t *testing.T) {
 			err:  nil,
 			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
 				mock := mocks.NewChatServiceMock(mc)
-				mock.SendMessageMock.Expect(ctx, &createMessage).Return(id, nil)
+				mock.SendMessageMock.Expect(ctx, &createMessage).Return(message, nil)
 				return mock
 			},
 		},
@@ -86,7 +95,7 @@ func TestSendMessage(t *testing.T) {
 			err:  serviceErr,
 			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
 				mock := mocks.NewChatServiceMock(mc)
-				mock.SendMessageMock.Expect(ctx, &createMessage).Return("", serviceErr)
+				mock.SendMessageMock.Expect(ctx, &createMessage).Return(nil, serviceErr)
 				return mock
 			},
 		},
@@ -0,0 +1,162 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/api/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/BelyaevEI/microservices_chat/internal/service/mocks"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListThread(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.ListThreadRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID     = gofakeit.Int64()
+		userID     = gofakeit.Int64()
+		rootID     = gofakeit.UUID()
+		replyID    = gofakeit.UUID()
+		text       = gofakeit.Phrase()
+		after      = gofakeit.UUID()
+		limit      = int32(10)
+		createdAt  = gofakeit.Date().UTC().Truncate(time.Microsecond)
+		nextCursor = gofakeit.UUID()
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.ListThreadRequest{
+			ChatId:        chatID,
+			RootMessageId: rootID,
+			After:         after,
+			Limit:         limit,
+		}
+
+		query = &model.ThreadQuery{
+			ChatID: chatID,
+			RootID: rootID,
+			After:  after,
+			Limit:  limit,
+		}
+
+		info = model.MessageInfo{
+			ChatID: chatID,
+			UserID: userID,
+			Text:   text,
+		}
+
+		page = &model.ThreadPage{
+			Root: &model.Message{
+				ID:         rootID,
+				Seq:        1,
+				Info:       info,
+				CreatedAt:  createdAt,
+				ReplyCount: 1,
+			},
+			Replies: []*model.Message{
+				{
+					ID:        replyID,
+					Seq:       2,
+					Info:      info,
+					CreatedAt: createdAt,
+					ReplyToID: rootID,
+				},
+			},
+			NextCursor: nextCursor,
+			HasMore:    true,
+		}
+
+		res = &desc.ListThreadResponse{
+			Root: &desc.Message{
+				Id:         rootID,
+				Seq:        1,
+				ChatId:     chatID,
+				UserId:     userID,
+				Text:       text,
+				CreatedAt:  timestamppb.New(createdAt),
+				ReplyCount: 1,
+			},
+			Replies: []*desc.Message{
+				{
+					Id:               replyID,
+					Seq:              2,
+					ChatId:           chatID,
+					UserId:           userID,
+					Text:             text,
+					CreatedAt:        timestamppb.New(createdAt),
+					ReplyToMessageId: rootID,
+				},
+			},
+			NextCursor: nextCursor,
+			HasMore:    true,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *desc.ListThreadResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.ListThreadMock.Expect(ctx, query).Return(page, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.ListThreadMock.Expect(ctx, query).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			threadServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(threadServiceMock)
+
+			res, err := api.ListThread(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
@@ -0,0 +1,229 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/api/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/BelyaevEI/microservices_chat/internal/service/mocks"
+	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type watchTypingStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*desc.TypingEvent
+}
+
+func (s *watchTypingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchTypingStream) Send(e *desc.TypingEvent) error {
+	s.sent = append(s.sent, e)
+	return nil
+}
+
+func TestSetTyping(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *desc.SetTypingRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		userID = gofakeit.Int64()
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.SetTypingRequest{
+			ChatId:   chatID,
+			UserId:   userID,
+			IsTyping: true,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *emptypb.Empty
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: &emptypb.Empty{},
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.SetTypingMock.Expect(ctx, chatID, userID, true).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.SetTypingMock.Expect(ctx, chatID, userID, true).Return(serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			typingServiceMock := test.chatServiceMock(mc)
+			api := chat.NewImplementation(typingServiceMock)
+
+			res, err := api.SetTyping(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
+
+func TestWatchTyping(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller, ctx context.Context) service.ChatService
+
+	type args struct {
+		cancel bool
+		req    *desc.WatchTypingRequest
+	}
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		userID    = gofakeit.Int64()
+		expiresAt = gofakeit.Date().UTC().Truncate(time.Microsecond)
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.WatchTypingRequest{
+			ChatId: chatID,
+		}
+
+		sent = []*desc.TypingEvent{
+			{
+				ChatId:    chatID,
+				UserId:    userID,
+				IsTyping:  true,
+				ExpiresAt: timestamppb.New(expiresAt),
+			},
+		}
+
+		events = func() <-chan *model.TypingEvent {
+			ch := make(chan *model.TypingEvent, 1)
+			ch <- &model.TypingEvent{
+				ChatID:    chatID,
+				UserID:    userID,
+				IsTyping:  true,
+				ExpiresAt: expiresAt,
+			}
+			close(ch)
+			return ch
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            []*desc.TypingEvent
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				cancel: true,
+				req:    req,
+			},
+			want: sent,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller, ctx context.Context) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.WatchTypingMock.Expect(ctx, chatID).Return(events(), nil)
+				return mock
+			},
+		},
+		{
+			name: "dropped subscription case",
+			args: args{
+				req: req,
+			},
+			want: sent,
+			err:  status.Error(codes.Unavailable, "subscription dropped, reconnect"),
+			chatServiceMock: func(mc *minimock.Controller, ctx context.Context) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.WatchTypingMock.Expect(ctx, chatID).Return(events(), nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller, ctx context.Context) service.ChatService {
+				mock := mocks.NewChatServiceMock(mc)
+				mock.WatchTypingMock.Expect(ctx, chatID).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if test.args.cancel {
+				cancel()
+			}
+
+			typingServiceMock := test.chatServiceMock(mc, ctx)
+			api := chat.NewImplementation(typingServiceMock)
+
+			stream := &watchTypingStream{ctx: ctx}
+			err := api.WatchTyping(test.args.req, stream)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, stream.sent)
+		})
+	}
+
+}
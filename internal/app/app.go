@@ -11,6 +11,7 @@ import (
 
 	"github.com/BelyaevEI/microservices_chat/internal/config"
 	"github.com/BelyaevEI/microservices_chat/internal/interceptor"
+	"github.com/BelyaevEI/microservices_chat/internal/scheduler"
 	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
 	"github.com/BelyaevEI/platform_common/pkg/closer"
 
@@ -30,6 +31,8 @@ type App struct {
 	grpcServer      *grpc.Server
 	httpServer      *http.Server
 	swaggerServer   *http.Server
+	dispatcher      *scheduler.Dispatcher
+	reaper          *scheduler.Reaper
 }
 
 // Run runs the app.
@@ -41,7 +44,7 @@ func (a *App) Run() error {
 	}()
 
 	wg := sync.WaitGroup{}
-	wg.Add(3)
+	wg.Add(5)
 
 	go func() {
 		defer wg.Done()
@@ -70,6 +73,18 @@ func (a *App) Run() error {
 		}
 	}()
 
+	go func() {
+		defer wg.Done()
+
+		a.runScheduleDispatcher()
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		a.runMessageReaper()
+	}()
+
 	wg.Wait()
 
 	return nil
@@ -94,6 +109,8 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initGRPCServer,
 		a.initHTTPServer,
 		a.initSwaggerServer,
+		a.initScheduleDispatcher,
+		a.initMessageReaper,
 	}
 
 	for _, f := range inits {
@@ -131,6 +148,30 @@ func (a *App) runGRPCServer() error {
 	return nil
 }
 
+func (a *App) initScheduleDispatcher(ctx context.Context) error {
+	a.dispatcher = a.serviceProvider.ScheduleDispatcher(ctx)
+	return nil
+}
+
+// runScheduleDispatcher delivers scheduled messages until the app shuts down
+func (a *App) runScheduleDispatcher() {
+	log.Printf("Schedule dispatcher is running every %s", a.serviceProvider.SchedulerConfig().Interval())
+
+	a.dispatcher.Run()
+}
+
+func (a *App) initMessageReaper(ctx context.Context) error {
+	a.reaper = a.serviceProvider.MessageReaper(ctx)
+	return nil
+}
+
+// runMessageReaper purges expired messages and stale uploads until the app shuts down
+func (a *App) runMessageReaper() {
+	log.Printf("Message reaper is running every %s", a.serviceProvider.SchedulerConfig().ReaperInterval())
+
+	a.reaper.Run()
+}
+
 func (a *App) runHTTPServer() error {
 	log.Printf("HTTP server is running on %s", a.serviceProvider.HTTPConfig().Address())
 
@@ -255,8 +296,14 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 
 	a.grpcServer = grpc.NewServer(
 		grpc.Creds(creds),
-		grpc.UnaryInterceptor(interceptor.ValidateInterceptor),
-		grpc.UnaryInterceptor(interceptor.AuthInterceptor),
+		grpc.ChainUnaryInterceptor(
+			interceptor.ValidateInterceptor,
+			interceptor.AuthInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			interceptor.ValidateStreamInterceptor,
+			interceptor.AuthStreamInterceptor,
+		),
 	)
 
 	reflection.Register(a.grpcServer)
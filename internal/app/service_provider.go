@@ -5,11 +5,19 @@ import (
 	"log"
 
 	"github.com/BelyaevEI/microservices_chat/internal/api/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/client"
+	"github.com/BelyaevEI/microservices_chat/internal/client/directory"
 	"github.com/BelyaevEI/microservices_chat/internal/config"
+	"github.com/BelyaevEI/microservices_chat/internal/hub"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/presence"
 	"github.com/BelyaevEI/microservices_chat/internal/repository"
 	chatRepository "github.com/BelyaevEI/microservices_chat/internal/repository/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/scheduler"
 	"github.com/BelyaevEI/microservices_chat/internal/service"
 	chatService "github.com/BelyaevEI/microservices_chat/internal/service/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/storage"
+	"github.com/BelyaevEI/microservices_chat/internal/typing"
 	"github.com/BelyaevEI/platform_common/pkg/closer"
 	"github.com/BelyaevEI/platform_common/pkg/db"
 	"github.com/BelyaevEI/platform_common/pkg/db/pg"
@@ -17,10 +25,17 @@ import (
 )
 
 type serviceProvider struct {
-	pgConfig      config.PGConfig
-	grpcConfig    config.GRPCConfig
-	httpConfig    config.HTTPConfig
-	swaggerConfig config.SwaggerConfig
+	pgConfig        config.PGConfig
+	grpcConfig      config.GRPCConfig
+	httpConfig      config.HTTPConfig
+	swaggerConfig   config.SwaggerConfig
+	hubConfig       config.HubConfig
+	directoryConfig config.DirectoryConfig
+	typingConfig    config.TypingConfig
+	presenceConfig  config.PresenceConfig
+	pinsConfig      config.PinsConfig
+	attachConfig    config.AttachmentsConfig
+	schedulerConfig config.SchedulerConfig
 
 	pgClient  db.Client
 	txManager db.TxManager
@@ -28,6 +43,13 @@ type serviceProvider struct {
 	chatImpl       *chat.Implementation
 	chatRepository repository.ChatRepository
 	chatService    service.ChatService
+	chatHub        hub.Hub[*model.Message]
+	userDirectory  client.UserDirectory
+	typingTracker  typing.Tracker
+	presence       presence.Tracker
+	blobStore      storage.BlobStore
+	dispatcher     *scheduler.Dispatcher
+	reaper         *scheduler.Reaper
 }
 
 func newServiceProvider() *serviceProvider {
@@ -87,6 +109,110 @@ func (s *serviceProvider) SwaggerConfig() config.SwaggerConfig {
 	return s.swaggerConfig
 }
 
+func (s *serviceProvider) HubConfig() config.HubConfig {
+	if s.hubConfig == nil {
+		cfg, err := config.NewHubConfig()
+		if err != nil {
+			log.Fatalf("failed to get hub config: %s", err.Error())
+		}
+
+		s.hubConfig = cfg
+	}
+
+	return s.hubConfig
+}
+
+func (s *serviceProvider) DirectoryConfig() config.DirectoryConfig {
+	if s.directoryConfig == nil {
+		cfg, err := config.NewDirectoryConfig()
+		if err != nil {
+			log.Fatalf("failed to get user directory config: %s", err.Error())
+		}
+
+		s.directoryConfig = cfg
+	}
+
+	return s.directoryConfig
+}
+
+func (s *serviceProvider) TypingConfig() config.TypingConfig {
+	if s.typingConfig == nil {
+		cfg, err := config.NewTypingConfig()
+		if err != nil {
+			log.Fatalf("failed to get typing config: %s", err.Error())
+		}
+
+		s.typingConfig = cfg
+	}
+
+	return s.typingConfig
+}
+
+func (s *serviceProvider) PresenceConfig() config.PresenceConfig {
+	if s.presenceConfig == nil {
+		cfg, err := config.NewPresenceConfig()
+		if err != nil {
+			log.Fatalf("failed to get presence config: %s", err.Error())
+		}
+
+		s.presenceConfig = cfg
+	}
+
+	return s.presenceConfig
+}
+
+func (s *serviceProvider) PinsConfig() config.PinsConfig {
+	if s.pinsConfig == nil {
+		cfg, err := config.NewPinsConfig()
+		if err != nil {
+			log.Fatalf("failed to get pins config: %s", err.Error())
+		}
+
+		s.pinsConfig = cfg
+	}
+
+	return s.pinsConfig
+}
+
+func (s *serviceProvider) AttachmentsConfig() config.AttachmentsConfig {
+	if s.attachConfig == nil {
+		cfg, err := config.NewAttachmentsConfig()
+		if err != nil {
+			log.Fatalf("failed to get attachments config: %s", err.Error())
+		}
+
+		s.attachConfig = cfg
+	}
+
+	return s.attachConfig
+}
+
+func (s *serviceProvider) SchedulerConfig() config.SchedulerConfig {
+	if s.schedulerConfig == nil {
+		cfg, err := config.NewSchedulerConfig()
+		if err != nil {
+			log.Fatalf("failed to get scheduler config: %s", err.Error())
+		}
+
+		s.schedulerConfig = cfg
+	}
+
+	return s.schedulerConfig
+}
+
+func (s *serviceProvider) BlobStore() storage.BlobStore {
+	if s.blobStore == nil {
+		store, err := storage.NewLocalStore(s.AttachmentsConfig().Dir())
+		if err != nil {
+			log.Fatalf("failed to create blob store: %s", err.Error())
+		}
+
+		s.blobStore = store
+	}
+
+	return s.blobStore
+}
+
 func (s *serviceProvider) PostgresClient(ctx context.Context) db.Client {
 	if s.pgClient == nil {
 		client, err := pg.New(ctx, s.PGConfig().DSN())
@@ -119,12 +245,50 @@ func (s *serviceProvider) ChatService(ctx context.Context) service.ChatService {
 		s.chatService = chatService.NewService(
 			s.ChatRepository(ctx),
 			s.TxManager(ctx),
+			s.ChatHub(),
+			s.UserDirectory(),
+			s.TypingTracker(),
+			s.PresenceTracker(),
+			s.PinsConfig().MaxPerChat(),
+			s.BlobStore(),
+			s.AttachmentsConfig().MaxSize(),
 		)
 	}
 
 	return s.chatService
 }
 
+func (s *serviceProvider) ScheduleDispatcher(ctx context.Context) *scheduler.Dispatcher {
+	if s.dispatcher == nil {
+		dispatcher := scheduler.NewDispatcher(
+			s.ChatRepository(ctx),
+			s.TxManager(ctx),
+			s.ChatService(ctx),
+			s.SchedulerConfig().Interval(),
+		)
+		closer.Add(dispatcher.Close)
+
+		s.dispatcher = dispatcher
+	}
+
+	return s.dispatcher
+}
+
+func (s *serviceProvider) MessageReaper(ctx context.Context) *scheduler.Reaper {
+	if s.reaper == nil {
+		reaper := scheduler.NewReaper(
+			s.ChatService(ctx),
+			s.SchedulerConfig().ReaperInterval(),
+			s.AttachmentsConfig().UploadTTL(),
+		)
+		closer.Add(reaper.Close)
+
+		s.reaper = reaper
+	}
+
+	return s.reaper
+}
+
 func (s *serviceProvider) ChatRepository(ctx context.Context) repository.ChatRepository {
 	if s.chatRepository == nil {
 		s.chatRepository = chatRepository.NewRepository(s.PostgresClient(ctx))
@@ -133,6 +297,53 @@ func (s *serviceProvider) ChatRepository(ctx context.Context) repository.ChatRep
 	return s.chatRepository
 }
 
+func (s *serviceProvider) ChatHub() hub.Hub[*model.Message] {
+	if s.chatHub == nil {
+		s.chatHub = hub.NewHub[*model.Message](s.HubConfig().BufferSize())
+	}
+
+	return s.chatHub
+}
+
+func (s *serviceProvider) TypingTracker() typing.Tracker {
+	if s.typingTracker == nil {
+		cfg := s.TypingConfig()
+		s.typingTracker = typing.NewTracker(
+			hub.NewHub[*model.TypingEvent](s.HubConfig().BufferSize()),
+			cfg.Expiry(),
+			cfg.Rate(),
+			cfg.Burst(),
+		)
+	}
+
+	return s.typingTracker
+}
+
+func (s *serviceProvider) PresenceTracker() presence.Tracker {
+	if s.presence == nil {
+		cfg := s.PresenceConfig()
+		tracker := presence.NewMemoryTracker(
+			hub.NewHub[*model.Presence](s.HubConfig().BufferSize()),
+			cfg.AwayAfter(),
+			cfg.OfflineAfter(),
+			cfg.ForgetAfter(),
+		)
+		closer.Add(tracker.Close)
+
+		s.presence = tracker
+	}
+
+	return s.presence
+}
+
+func (s *serviceProvider) UserDirectory() client.UserDirectory {
+	if s.userDirectory == nil {
+		s.userDirectory = directory.NewStaticDirectory(s.DirectoryConfig().Users())
+	}
+
+	return s.userDirectory
+}
+
 func (s *serviceProvider) TxManager(ctx context.Context) db.TxManager {
 	if s.txManager == nil {
 		s.txManager = transaction.NewTransactionManager(s.PostgresClient(ctx).DB())
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type claims struct {
+	UserID  json.Number `json:"user_id"`
+	Subject string      `json:"sub"`
+}
+
+// UserIDFromToken extracts the caller id from the claims of a JWT.
+// The signature is not checked here: the token must already be verified by the access service.
+func UserIDFromToken(token string) (int64, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return 0, errors.New("malformed access token")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return 0, errors.Wrap(err, "malformed access token payload")
+	}
+
+	var c claims
+	err = json.Unmarshal(payload, &c)
+	if err != nil {
+		return 0, errors.Wrap(err, "malformed access token claims")
+	}
+
+	raw := c.UserID.String()
+	if len(raw) == 0 {
+		raw = c.Subject
+	}
+
+	userID, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || userID <= 0 {
+		return 0, errors.New("access token has no user id")
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,9 @@
+package client
+
+import "context"
+
+// UserDirectory resolves usernames to user ids.
+// Usernames that are unknown to the directory are left out of the result.
+type UserDirectory interface {
+	ResolveUsernames(ctx context.Context, usernames []string) (map[string]int64, error)
+}
@@ -0,0 +1,33 @@
+package directory
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/client"
+)
+
+type staticDirectory struct {
+	users map[string]int64
+}
+
+// NewStaticDirectory creates an in-memory user directory backed by a fixed
+// username to id mapping. It is meant for local runs and tests.
+func NewStaticDirectory(users map[string]int64) client.UserDirectory {
+	copied := make(map[string]int64, len(users))
+	for username, id := range users {
+		copied[username] = id
+	}
+
+	return &staticDirectory{users: copied}
+}
+
+func (d *staticDirectory) ResolveUsernames(_ context.Context, usernames []string) (map[string]int64, error) {
+	resolved := make(map[string]int64, len(usernames))
+	for _, username := range usernames {
+		if id, ok := d.users[username]; ok {
+			resolved[username] = id
+		}
+	}
+
+	return resolved, nil
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	attachmentsDirEnvName     = "ATTACHMENTS_DIR"
+	attachmentsMaxSizeEnvName = "ATTACHMENTS_MAX_SIZE"
+	uploadTTLEnvName          = "ATTACHMENTS_UPLOAD_TTL"
+
+	defaultAttachmentsDir     = "./attachments"
+	defaultAttachmentsMaxSize = 20 << 20
+	defaultUploadTTL          = 24 * time.Hour
+)
+
+// AttachmentsConfig config for message attachments
+type AttachmentsConfig interface {
+	Dir() string
+	MaxSize() int64
+	UploadTTL() time.Duration
+}
+
+type attachmentsConfig struct {
+	dir       string
+	maxSize   int64
+	uploadTTL time.Duration
+}
+
+// NewAttachmentsConfig initializes an attachments configuration.
+// Uploads not sent within ATTACHMENTS_UPLOAD_TTL, a duration such as "24h",
+// are removed.
+func NewAttachmentsConfig() (AttachmentsConfig, error) {
+	dir := os.Getenv(attachmentsDirEnvName)
+	if len(dir) == 0 {
+		dir = defaultAttachmentsDir
+	}
+
+	maxSize := int64(defaultAttachmentsMaxSize)
+	if raw := os.Getenv(attachmentsMaxSizeEnvName); len(raw) != 0 {
+		size, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || size <= 0 {
+			return nil, errors.New("max attachment size must be a positive integer")
+		}
+		maxSize = size
+	}
+
+	uploadTTL := defaultUploadTTL
+	if raw := os.Getenv(uploadTTLEnvName); len(raw) != 0 {
+		ttl, err := time.ParseDuration(raw)
+		if err != nil || ttl <= 0 {
+			return nil, errors.New("upload time-to-live must be a positive duration")
+		}
+		uploadTTL = ttl
+	}
+
+	return &attachmentsConfig{
+		dir:       dir,
+		maxSize:   maxSize,
+		uploadTTL: uploadTTL,
+	}, nil
+}
+
+func (cfg *attachmentsConfig) Dir() string {
+	return cfg.dir
+}
+
+func (cfg *attachmentsConfig) MaxSize() int64 {
+	return cfg.maxSize
+}
+
+func (cfg *attachmentsConfig) UploadTTL() time.Duration {
+	return cfg.uploadTTL
+}
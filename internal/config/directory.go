@@ -0,0 +1,56 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	userDirectoryEnvName = "USER_DIRECTORY"
+)
+
+// DirectoryConfig config for the static user directory
+type DirectoryConfig interface {
+	Users() map[string]int64
+}
+
+type directoryConfig struct {
+	users map[string]int64
+}
+
+// NewDirectoryConfig initializes a user directory configuration.
+// USER_DIRECTORY holds comma separated username:id pairs, e.g. "alice:1,bob:2".
+func NewDirectoryConfig() (DirectoryConfig, error) {
+	users := make(map[string]int64)
+
+	raw := os.Getenv(userDirectoryEnvName)
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if len(pair) == 0 {
+			continue
+		}
+
+		username, rawID, ok := strings.Cut(pair, ":")
+		if !ok || len(username) == 0 {
+			return nil, errors.Errorf("invalid user directory entry %q", pair)
+		}
+
+		id, err := strconv.ParseInt(rawID, 10, 64)
+		if err != nil || id <= 0 {
+			return nil, errors.Errorf("invalid user id in user directory entry %q", pair)
+		}
+
+		users[username] = id
+	}
+
+	return &directoryConfig{
+		users: users,
+	}, nil
+}
+
+func (cfg *directoryConfig) Users() map[string]int64 {
+	return cfg.users
+}
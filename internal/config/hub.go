@@ -0,0 +1,44 @@
+package config
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	hubBufferSizeEnvName = "HUB_BUFFER_SIZE"
+
+	defaultHubBufferSize = 64
+)
+
+// HubConfig config for the in-process message hub
+type HubConfig interface {
+	BufferSize() int
+}
+
+type hubConfig struct {
+	bufferSize int
+}
+
+// NewHubConfig initializes a hub configuration.
+func NewHubConfig() (HubConfig, error) {
+	bufferSize := defaultHubBufferSize
+
+	if raw := os.Getenv(hubBufferSizeEnvName); len(raw) != 0 {
+		size, err := strconv.Atoi(raw)
+		if err != nil || size <= 0 {
+			return nil, errors.New("hub buffer size must be a positive integer")
+		}
+		bufferSize = size
+	}
+
+	return &hubConfig{
+		bufferSize: bufferSize,
+	}, nil
+}
+
+func (cfg *hubConfig) BufferSize() int {
+	return cfg.bufferSize
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	pinsMaxPerChatEnvName = "PINS_MAX_PER_CHAT"
+
+	defaultPinsMaxPerChat = 10
+)
+
+// PinsConfig config for pinned messages
+type PinsConfig interface {
+	MaxPerChat() int
+}
+
+type pinsConfig struct {
+	maxPerChat int
+}
+
+// NewPinsConfig initializes a pinned messages configuration.
+func NewPinsConfig() (PinsConfig, error) {
+	maxPerChat := defaultPinsMaxPerChat
+
+	if raw := os.Getenv(pinsMaxPerChatEnvName); len(raw) != 0 {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit <= 0 {
+			return nil, errors.New("max pins per chat must be a positive integer")
+		}
+		maxPerChat = limit
+	}
+
+	return &pinsConfig{
+		maxPerChat: maxPerChat,
+	}, nil
+}
+
+func (cfg *pinsConfig) MaxPerChat() int {
+	return cfg.maxPerChat
+}
@@ -0,0 +1,89 @@
+package config
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	presenceAwayAfterEnvName    = "PRESENCE_AWAY_AFTER"
+	presenceOfflineAfterEnvName = "PRESENCE_OFFLINE_AFTER"
+	presenceForgetAfterEnvName  = "PRESENCE_FORGET_AFTER"
+
+	defaultPresenceAwayAfter    = time.Minute
+	defaultPresenceOfflineAfter = 5 * time.Minute
+	defaultPresenceForgetAfter  = 30 * 24 * time.Hour
+)
+
+// PresenceConfig config for presence tracking
+type PresenceConfig interface {
+	AwayAfter() time.Duration
+	OfflineAfter() time.Duration
+	ForgetAfter() time.Duration
+}
+
+type presenceConfig struct {
+	awayAfter    time.Duration
+	offlineAfter time.Duration
+	forgetAfter  time.Duration
+}
+
+// NewPresenceConfig initializes a presence configuration.
+// A user without heartbeats turns away after PRESENCE_AWAY_AFTER and offline
+// after PRESENCE_OFFLINE_AFTER, both durations such as "90s". The last seen
+// time of a user is kept for PRESENCE_FORGET_AFTER, such as "720h".
+func NewPresenceConfig() (PresenceConfig, error) {
+	cfg := &presenceConfig{
+		awayAfter:    defaultPresenceAwayAfter,
+		offlineAfter: defaultPresenceOfflineAfter,
+		forgetAfter:  defaultPresenceForgetAfter,
+	}
+
+	if raw := os.Getenv(presenceAwayAfterEnvName); len(raw) != 0 {
+		awayAfter, err := time.ParseDuration(raw)
+		if err != nil || awayAfter <= 0 {
+			return nil, errors.New("presence away timeout must be a positive duration")
+		}
+		cfg.awayAfter = awayAfter
+	}
+
+	if raw := os.Getenv(presenceOfflineAfterEnvName); len(raw) != 0 {
+		offlineAfter, err := time.ParseDuration(raw)
+		if err != nil || offlineAfter <= 0 {
+			return nil, errors.New("presence offline timeout must be a positive duration")
+		}
+		cfg.offlineAfter = offlineAfter
+	}
+
+	if raw := os.Getenv(presenceForgetAfterEnvName); len(raw) != 0 {
+		forgetAfter, err := time.ParseDuration(raw)
+		if err != nil || forgetAfter <= 0 {
+			return nil, errors.New("presence forget timeout must be a positive duration")
+		}
+		cfg.forgetAfter = forgetAfter
+	}
+
+	if cfg.offlineAfter <= cfg.awayAfter {
+		return nil, errors.New("presence offline timeout must be longer than the away timeout")
+	}
+
+	if cfg.forgetAfter <= cfg.offlineAfter {
+		return nil, errors.New("presence forget timeout must be longer than the offline timeout")
+	}
+
+	return cfg, nil
+}
+
+func (cfg *presenceConfig) AwayAfter() time.Duration {
+	return cfg.awayAfter
+}
+
+func (cfg *presenceConfig) OfflineAfter() time.Duration {
+	return cfg.offlineAfter
+}
+
+func (cfg *presenceConfig) ForgetAfter() time.Duration {
+	return cfg.forgetAfter
+}
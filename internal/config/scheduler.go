@@ -0,0 +1,63 @@
+package config
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	schedulerIntervalEnvName = "SCHEDULER_INTERVAL"
+	reaperIntervalEnvName    = "REAPER_INTERVAL"
+
+	defaultSchedulerInterval = time.Second
+	defaultReaperInterval    = 10 * time.Second
+)
+
+// SchedulerConfig config for background jobs
+type SchedulerConfig interface {
+	Interval() time.Duration
+	ReaperInterval() time.Duration
+}
+
+type schedulerConfig struct {
+	interval       time.Duration
+	reaperInterval time.Duration
+}
+
+// NewSchedulerConfig initializes a background jobs configuration.
+// Due scheduled messages are looked for every SCHEDULER_INTERVAL and expired
+// messages every REAPER_INTERVAL, both durations such as "5s".
+func NewSchedulerConfig() (SchedulerConfig, error) {
+	cfg := &schedulerConfig{
+		interval:       defaultSchedulerInterval,
+		reaperInterval: defaultReaperInterval,
+	}
+
+	if raw := os.Getenv(schedulerIntervalEnvName); len(raw) != 0 {
+		interval, err := time.ParseDuration(raw)
+		if err != nil || interval <= 0 {
+			return nil, errors.New("scheduler interval must be a positive duration")
+		}
+		cfg.interval = interval
+	}
+
+	if raw := os.Getenv(reaperIntervalEnvName); len(raw) != 0 {
+		interval, err := time.ParseDuration(raw)
+		if err != nil || interval <= 0 {
+			return nil, errors.New("reaper interval must be a positive duration")
+		}
+		cfg.reaperInterval = interval
+	}
+
+	return cfg, nil
+}
+
+func (cfg *schedulerConfig) Interval() time.Duration {
+	return cfg.interval
+}
+
+func (cfg *schedulerConfig) ReaperInterval() time.Duration {
+	return cfg.reaperInterval
+}
@@ -0,0 +1,81 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	typingExpiryEnvName = "TYPING_EXPIRY"
+	typingRateEnvName   = "TYPING_RATE"
+	typingBurstEnvName  = "TYPING_BURST"
+
+	defaultTypingExpiry = 5 * time.Second
+	defaultTypingRate   = 1
+	defaultTypingBurst  = 5
+)
+
+// TypingConfig config for typing indicators
+type TypingConfig interface {
+	Expiry() time.Duration
+	Rate() float64
+	Burst() int
+}
+
+type typingConfig struct {
+	expiry time.Duration
+	rate   float64
+	burst  int
+}
+
+// NewTypingConfig initializes a typing indicator configuration.
+// TYPING_EXPIRY is a duration such as "5s", TYPING_RATE is the number of
+// updates a user may send per second once TYPING_BURST is used up.
+func NewTypingConfig() (TypingConfig, error) {
+	cfg := &typingConfig{
+		expiry: defaultTypingExpiry,
+		rate:   defaultTypingRate,
+		burst:  defaultTypingBurst,
+	}
+
+	if raw := os.Getenv(typingExpiryEnvName); len(raw) != 0 {
+		expiry, err := time.ParseDuration(raw)
+		if err != nil || expiry <= 0 {
+			return nil, errors.New("typing expiry must be a positive duration")
+		}
+		cfg.expiry = expiry
+	}
+
+	if raw := os.Getenv(typingRateEnvName); len(raw) != 0 {
+		rate, err := strconv.ParseFloat(raw, 64)
+		if err != nil || rate <= 0 {
+			return nil, errors.New("typing rate must be a positive number")
+		}
+		cfg.rate = rate
+	}
+
+	if raw := os.Getenv(typingBurstEnvName); len(raw) != 0 {
+		burst, err := strconv.Atoi(raw)
+		if err != nil || burst <= 0 {
+			return nil, errors.New("typing burst must be a positive integer")
+		}
+		cfg.burst = burst
+	}
+
+	return cfg, nil
+}
+
+func (cfg *typingConfig) Expiry() time.Duration {
+	return cfg.expiry
+}
+
+func (cfg *typingConfig) Rate() float64 {
+	return cfg.rate
+}
+
+func (cfg *typingConfig) Burst() int {
+	return cfg.burst
+}
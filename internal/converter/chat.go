@@ -1,25 +1,505 @@
 package converter
 
 import (
+	"time"
+
 	"github.com/BelyaevEI/microservices_chat/internal/model"
 	desc "github.com/BelyaevEI/microservices_chat/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ToChatCreateFromDesc converts desc.ChatCreate to model.ChatCreate
 func ToChatCreateFromDesc(chatCreate *desc.CreateRequest) *model.ChatCreate {
 	return &model.ChatCreate{
-		Name:   chatCreate.Chatname,
-		UserID: chatCreate.Id,
+		Name:      chatCreate.Chatname,
+		UserID:    chatCreate.Id,
+		Usernames: chatCreate.Usernames,
 	}
 }
 
-// ToMessageCreateFromDesc converts desc.MessageCreate to model.MessageCreate
+// ToMessageCreateFromDesc converts desc.MessageCreate to model.MessageCreate.
+// The sender is not taken from the request, the service sets it from the caller identity.
 func ToMessageCreateFromDesc(messageCreate *desc.SendMessageRequest) *model.MessageCreate {
 	return &model.MessageCreate{
 		Info: model.MessageInfo{
 			ChatID: messageCreate.ToChatId,
-			UserID: messageCreate.FromUserId,
 			Text:   messageCreate.Text,
 		},
+		ReplyToID:     messageCreate.ReplyToMessageId,
+		AttachmentIDs: messageCreate.AttachmentIds,
+		TTL:           messageCreate.GetTtl().AsDuration(),
+	}
+}
+
+// ToChatFromService converts model.Chat to desc.Chat
+func ToChatFromService(chat *model.Chat) *desc.Chat {
+	var messageTTL *durationpb.Duration
+	if chat.MessageTTL > 0 {
+		messageTTL = durationpb.New(chat.MessageTTL)
+	}
+
+	return &desc.Chat{
+		Id:             chat.ID,
+		Name:           chat.Name,
+		UserIds:        chat.UserID,
+		CreatedAt:      timestamppb.New(chat.CreatedAt),
+		LastActivityAt: timestamppb.New(chat.LastActivityAt),
+		UnreadCount:    chat.UnreadCount,
+		MessageTtl:     messageTTL,
+	}
+}
+
+// ToChatsFromService converts a list of model.Chat to a list of desc.Chat
+func ToChatsFromService(chats []*model.Chat) []*desc.Chat {
+	res := make([]*desc.Chat, 0, len(chats))
+	for _, chat := range chats {
+		res = append(res, ToChatFromService(chat))
+	}
+
+	return res
+}
+
+// ToMessageListQueryFromDesc converts desc.ListMessagesRequest to model.MessageListQuery
+func ToMessageListQueryFromDesc(req *desc.ListMessagesRequest) *model.MessageListQuery {
+	return &model.MessageListQuery{
+		ChatID: req.ChatId,
+		Before: req.Before,
+		After:  req.After,
+		Limit:  req.Limit,
+	}
+}
+
+// ToThreadQueryFromDesc converts desc.ListThreadRequest to model.ThreadQuery
+func ToThreadQueryFromDesc(req *desc.ListThreadRequest) *model.ThreadQuery {
+	return &model.ThreadQuery{
+		ChatID: req.ChatId,
+		RootID: req.RootMessageId,
+		After:  req.After,
+		Limit:  req.Limit,
+	}
+}
+
+// ToSearchQueryFromDesc converts desc.SearchMessagesRequest to model.SearchQuery
+func ToSearchQueryFromDesc(req *desc.SearchMessagesRequest) *model.SearchQuery {
+	query := &model.SearchQuery{
+		UserID:    req.UserId,
+		Query:     req.Query,
+		ChatID:    req.ChatId,
+		SenderID:  req.SenderId,
+		PageSize:  req.PageSize,
+		PageToken: req.PageToken,
+	}
+
+	if req.From != nil {
+		from := req.From.AsTime()
+		query.From = &from
+	}
+	if req.To != nil {
+		to := req.To.AsTime()
+		query.To = &to
+	}
+
+	return query
+}
+
+// ToSearchResultsFromService converts a list of model.SearchResult to a list of desc.SearchResult
+func ToSearchResultsFromService(results []*model.SearchResult) []*desc.SearchResult {
+	res := make([]*desc.SearchResult, 0, len(results))
+	for _, result := range results {
+		res = append(res, &desc.SearchResult{
+			Message: ToMessageFromService(result.Message),
+			Snippet: result.Snippet,
+			Rank:    result.Rank,
+		})
+	}
+
+	return res
+}
+
+// ToMessageFromService converts model.Message to desc.Message
+func ToMessageFromService(message *model.Message) *desc.Message {
+	var editedAt, deletedAt, expiresAt *timestamppb.Timestamp
+	if message.EditedAt != nil {
+		editedAt = timestamppb.New(*message.EditedAt)
+	}
+	if message.DeletedAt != nil {
+		deletedAt = timestamppb.New(*message.DeletedAt)
+	}
+	if message.ExpiresAt != nil {
+		expiresAt = timestamppb.New(*message.ExpiresAt)
+	}
+
+	return &desc.Message{
+		Id:               message.ID,
+		Seq:              message.Seq,
+		ChatId:           message.Info.ChatID,
+		UserId:           message.Info.UserID,
+		Text:             message.Info.Text,
+		CreatedAt:        timestamppb.New(message.CreatedAt),
+		EditedAt:         editedAt,
+		DeletedAt:        deletedAt,
+		ReplyToMessageId: message.ReplyToID,
+		ReplyCount:       message.ReplyCount,
+		Reactions:        toReactionCounts(message.Reactions),
+		SystemEvent:      toSystemEvent(message.SystemEvent),
+		SubjectMessageId: message.SubjectID,
+		Mentions:         toMentions(message.Mentions),
+		Attachments:      toAttachments(message.Attachments),
+		ForwardedFrom:    toForwardedFrom(message.ForwardedFrom),
+		ExpiresAt:        expiresAt,
+		Poll:             ToPollFromService(message.Poll),
+	}
+}
+
+func toSystemEvent(event string) desc.SystemEvent {
+	switch event {
+	case model.SystemEventMessagePinned:
+		return desc.SystemEvent_SYSTEM_EVENT_MESSAGE_PINNED
+	case model.SystemEventMessageUnpinned:
+		return desc.SystemEvent_SYSTEM_EVENT_MESSAGE_UNPINNED
+	case model.SystemEventUserJoined:
+		return desc.SystemEvent_SYSTEM_EVENT_USER_JOINED
+	default:
+		return desc.SystemEvent_SYSTEM_EVENT_UNSPECIFIED
+	}
+}
+
+func toForwardedFrom(origin *model.ForwardedFrom) *desc.ForwardedFrom {
+	if origin == nil {
+		return nil
+	}
+
+	return &desc.ForwardedFrom{
+		UserId:    origin.UserID,
+		ChatId:    origin.ChatID,
+		MessageId: origin.MessageID,
+	}
+}
+
+func toAttachments(attachments []*model.Attachment) []*desc.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	res := make([]*desc.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		res = append(res, ToAttachmentFromService(attachment))
+	}
+
+	return res
+}
+
+// ToAttachmentFromService converts model.Attachment to desc.Attachment
+func ToAttachmentFromService(attachment *model.Attachment) *desc.Attachment {
+	return &desc.Attachment{
+		Id:         attachment.ID,
+		ChatId:     attachment.ChatID,
+		UploaderId: attachment.UploaderID,
+		MessageId:  attachment.MessageID,
+		FileName:   attachment.FileName,
+		MimeType:   attachment.MimeType,
+		Size:       attachment.Size,
+		Sha256:     attachment.SHA256,
+		CreatedAt:  timestamppb.New(attachment.CreatedAt),
+	}
+}
+
+// ToAttachmentUploadFromDesc converts desc.AttachmentInfo to model.AttachmentUpload
+func ToAttachmentUploadFromDesc(info *desc.AttachmentInfo) *model.AttachmentUpload {
+	return &model.AttachmentUpload{
+		ChatID:   info.ChatId,
+		FileName: info.FileName,
+		MimeType: info.MimeType,
+	}
+}
+
+func toMentions(mentions []*model.MessageMention) []*desc.Mention {
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	res := make([]*desc.Mention, 0, len(mentions))
+	for _, mention := range mentions {
+		res = append(res, &desc.Mention{
+			UserId: mention.UserID,
+			All:    mention.All,
+			Offset: int32(mention.Offset),
+			Length: int32(mention.Length),
+		})
+	}
+
+	return res
+}
+
+func toReactionCounts(counts []*model.ReactionCount) []*desc.ReactionCount {
+	if len(counts) == 0 {
+		return nil
+	}
+
+	res := make([]*desc.ReactionCount, 0, len(counts))
+	for _, count := range counts {
+		res = append(res, &desc.ReactionCount{
+			Emoji: count.Emoji,
+			Count: count.Count,
+		})
+	}
+
+	return res
+}
+
+// ToMessagesFromService converts a list of model.Message to a list of desc.Message
+func ToMessagesFromService(messages []*model.Message) []*desc.Message {
+	res := make([]*desc.Message, 0, len(messages))
+	for _, message := range messages {
+		res = append(res, ToMessageFromService(message))
+	}
+
+	return res
+}
+
+// ToMessageEditFromDesc converts desc.EditMessageRequest to model.MessageEdit
+func ToMessageEditFromDesc(req *desc.EditMessageRequest) *model.MessageEdit {
+	return &model.MessageEdit{
+		MessageID: req.MessageId,
+		ChatID:    req.ChatId,
+		Text:      req.Text,
+	}
+}
+
+// ToMessageRevisionsFromService converts a list of model.MessageRevision to a list of desc.MessageRevision
+func ToMessageRevisionsFromService(revisions []*model.MessageRevision) []*desc.MessageRevision {
+	res := make([]*desc.MessageRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		res = append(res, &desc.MessageRevision{
+			Text:     revision.Text,
+			EditedAt: timestamppb.New(revision.EditedAt),
+		})
+	}
+
+	return res
+}
+
+// ToMessageDeleteFromDesc converts desc.DeleteMessageRequest to model.MessageDelete
+func ToMessageDeleteFromDesc(req *desc.DeleteMessageRequest) *model.MessageDelete {
+	return &model.MessageDelete{
+		MessageID:   req.MessageId,
+		ChatID:      req.ChatId,
+		ForEveryone: req.Mode == desc.DeleteMode_DELETE_MODE_FOR_EVERYONE,
+	}
+}
+
+// ToReadStatesFromService converts a list of model.ReadState to a list of desc.ReadState
+func ToReadStatesFromService(states []*model.ReadState) []*desc.ReadState {
+	res := make([]*desc.ReadState, 0, len(states))
+	for _, state := range states {
+		res = append(res, &desc.ReadState{
+			UserId:      state.UserID,
+			LastReadSeq: state.LastReadSeq,
+		})
+	}
+
+	return res
+}
+
+// ToTypingEventFromService converts model.TypingEvent to desc.TypingEvent
+func ToTypingEventFromService(event *model.TypingEvent) *desc.TypingEvent {
+	return &desc.TypingEvent{
+		ChatId:    event.ChatID,
+		UserId:    event.UserID,
+		IsTyping:  event.IsTyping,
+		ExpiresAt: timestamppb.New(event.ExpiresAt),
+	}
+}
+
+// ToPresencesFromService converts a list of model.Presence to a list of desc.Presence
+func ToPresencesFromService(presences []*model.Presence) []*desc.Presence {
+	res := make([]*desc.Presence, 0, len(presences))
+	for _, presence := range presences {
+		res = append(res, ToPresenceFromService(presence))
+	}
+
+	return res
+}
+
+// ToPresenceFromService converts model.Presence to desc.Presence
+func ToPresenceFromService(presence *model.Presence) *desc.Presence {
+	res := &desc.Presence{
+		UserId: presence.UserID,
+		Status: toPresenceStatus(presence.Status),
+	}
+
+	if !presence.LastSeenAt.IsZero() {
+		res.LastSeenAt = timestamppb.New(presence.LastSeenAt)
+	}
+
+	return res
+}
+
+func toPresenceStatus(status model.PresenceStatus) desc.PresenceStatus {
+	switch status {
+	case model.PresenceOnline:
+		return desc.PresenceStatus_PRESENCE_STATUS_ONLINE
+	case model.PresenceAway:
+		return desc.PresenceStatus_PRESENCE_STATUS_AWAY
+	default:
+		return desc.PresenceStatus_PRESENCE_STATUS_OFFLINE
+	}
+}
+
+// ToReactionFromDesc converts desc.AddReactionRequest to model.Reaction
+func ToReactionFromDesc(req *desc.AddReactionRequest) *model.Reaction {
+	return &model.Reaction{
+		MessageID: req.MessageId,
+		ChatID:    req.ChatId,
+		Emoji:     req.Emoji,
+	}
+}
+
+// ToReactionFromRemoveDesc converts desc.RemoveReactionRequest to model.Reaction
+func ToReactionFromRemoveDesc(req *desc.RemoveReactionRequest) *model.Reaction {
+	return &model.Reaction{
+		MessageID: req.MessageId,
+		ChatID:    req.ChatId,
+		Emoji:     req.Emoji,
+	}
+}
+
+// ToPinnedMessagesFromService converts a list of model.PinnedMessage to a list of desc.PinnedMessage
+func ToPinnedMessagesFromService(pins []*model.PinnedMessage) []*desc.PinnedMessage {
+	res := make([]*desc.PinnedMessage, 0, len(pins))
+	for _, pin := range pins {
+		res = append(res, &desc.PinnedMessage{
+			Message:  ToMessageFromService(pin.Message),
+			PinnedBy: pin.PinnedBy,
+			PinnedAt: timestamppb.New(pin.PinnedAt),
+		})
+	}
+
+	return res
+}
+
+// ToScheduledMessageFromService converts model.ScheduledMessage to desc.ScheduledMessage
+func ToScheduledMessageFromService(scheduled *model.ScheduledMessage) *desc.ScheduledMessage {
+	return &desc.ScheduledMessage{
+		Id:        scheduled.ID,
+		ChatId:    scheduled.Info.ChatID,
+		UserId:    scheduled.Info.UserID,
+		Text:      scheduled.Info.Text,
+		SendAt:    timestamppb.New(scheduled.SendAt),
+		CreatedAt: timestamppb.New(scheduled.CreatedAt),
+		Failure:   scheduled.Failure,
+	}
+}
+
+// ToScheduledMessagesFromService converts a list of model.ScheduledMessage to a list of desc.ScheduledMessage
+func ToScheduledMessagesFromService(scheduled []*model.ScheduledMessage) []*desc.ScheduledMessage {
+	res := make([]*desc.ScheduledMessage, 0, len(scheduled))
+	for _, s := range scheduled {
+		res = append(res, ToScheduledMessageFromService(s))
+	}
+
+	return res
+}
+
+// ToPollCreateFromDesc converts desc.CreatePollRequest to model.PollCreate
+func ToPollCreateFromDesc(req *desc.CreatePollRequest) *model.PollCreate {
+	var closesAt *time.Time
+	if req.ClosesAt != nil {
+		t := req.ClosesAt.AsTime()
+		closesAt = &t
+	}
+
+	return &model.PollCreate{
+		ChatID:      req.ChatId,
+		Question:    req.Question,
+		Options:     req.Options,
+		MultiChoice: req.MultiChoice,
+		Anonymous:   req.Anonymous,
+		ClosesAt:    closesAt,
+	}
+}
+
+// ToPollVoteFromDesc converts desc.VoteRequest to model.PollVote
+func ToPollVoteFromDesc(req *desc.VoteRequest) *model.PollVote {
+	return &model.PollVote{
+		ChatID:    req.ChatId,
+		MessageID: req.MessageId,
+		Options:   req.OptionIndexes,
+	}
+}
+
+// ToPollFromService converts model.Poll to desc.Poll, nil stays nil
+func ToPollFromService(poll *model.Poll) *desc.Poll {
+	if poll == nil {
+		return nil
+	}
+
+	var closesAt, closedAt *timestamppb.Timestamp
+	if poll.ClosesAt != nil {
+		closesAt = timestamppb.New(*poll.ClosesAt)
+	}
+	if poll.ClosedAt != nil {
+		closedAt = timestamppb.New(*poll.ClosedAt)
+	}
+
+	options := make([]*desc.PollOption, 0, len(poll.Options))
+	for _, option := range poll.Options {
+		options = append(options, &desc.PollOption{
+			Index:    option.Index,
+			Text:     option.Text,
+			Votes:    option.Votes,
+			VoterIds: option.VoterIDs,
+		})
+	}
+
+	return &desc.Poll{
+		MessageId:   poll.MessageID,
+		ChatId:      poll.ChatID,
+		Question:    poll.Question,
+		Options:     options,
+		MultiChoice: poll.MultiChoice,
+		Anonymous:   poll.Anonymous,
+		ClosesAt:    closesAt,
+		ClosedAt:    closedAt,
+		TotalVoters: poll.TotalVoters,
+	}
+}
+
+// ToMemberRoleFromDesc converts desc.MemberRole to a model member role,
+// unknown roles become empty
+func ToMemberRoleFromDesc(role desc.MemberRole) string {
+	switch role {
+	case desc.MemberRole_MEMBER_ROLE_OWNER:
+		return model.MemberRoleOwner
+	case desc.MemberRole_MEMBER_ROLE_ADMIN:
+		return model.MemberRoleAdmin
+	case desc.MemberRole_MEMBER_ROLE_MEMBER:
+		return model.MemberRoleMember
+	default:
+		return ""
+	}
+}
+
+// ToInviteLinkFromService converts model.InviteLink to desc.InviteLink
+func ToInviteLinkFromService(invite *model.InviteLink) *desc.InviteLink {
+	var expiresAt, revokedAt *timestamppb.Timestamp
+	if invite.ExpiresAt != nil {
+		expiresAt = timestamppb.New(*invite.ExpiresAt)
+	}
+	if invite.RevokedAt != nil {
+		revokedAt = timestamppb.New(*invite.RevokedAt)
+	}
+
+	return &desc.InviteLink{
+		Id:        invite.ID,
+		ChatId:    invite.ChatID,
+		Token:     invite.Token,
+		CreatedBy: invite.CreatedBy,
+		CreatedAt: timestamppb.New(invite.CreatedAt),
+		ExpiresAt: expiresAt,
+		MaxUses:   invite.MaxUses,
+		Uses:      invite.Uses,
+		RevokedAt: revokedAt,
 	}
 }
@@ -0,0 +1,132 @@
+package hub
+
+import (
+	"slices"
+	"sync"
+)
+
+const defaultBufferSize = 64
+
+// Hub fans out chat events (messages, typing indicators) to the clients connected to that chat.
+type Hub[T any] interface {
+	Subscribe(chatID, userID int64) *Subscription[T]
+	Publish(chatID int64, event T, exclude ...int64)
+	// Kick closes every subscription of the user to the chat
+	Kick(chatID, userID int64)
+}
+
+// Subscription represents a single connected client.
+// C is closed when the subscription is cancelled or when the subscriber falls
+// so far behind that its buffer overflows; such clients are expected to reconnect
+// and backfill messages through ListMessages.
+type Subscription[T any] struct {
+	C <-chan T
+
+	ch     chan T
+	chatID int64
+	userID int64
+	hub    *hub[T]
+}
+
+// Close cancels the subscription. It is safe to call more than once.
+func (s *Subscription[T]) Close() {
+	s.hub.unsubscribe(s)
+}
+
+type hub[T any] struct {
+	mu          sync.Mutex
+	bufferSize  int
+	subscribers map[int64]map[*Subscription[T]]struct{}
+}
+
+// NewHub creates a new in-process hub with the given per-subscriber buffer size.
+func NewHub[T any](bufferSize int) Hub[T] {
+	if bufferSize <= 0 {
+		bufferSize = defaultBufferSize
+	}
+
+	return &hub[T]{
+		bufferSize:  bufferSize,
+		subscribers: make(map[int64]map[*Subscription[T]]struct{}),
+	}
+}
+
+func (h *hub[T]) Subscribe(chatID, userID int64) *Subscription[T] {
+	ch := make(chan T, h.bufferSize)
+	sub := &Subscription[T]{
+		C:      ch,
+		ch:     ch,
+		chatID: chatID,
+		userID: userID,
+		hub:    h,
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	subs, ok := h.subscribers[chatID]
+	if !ok {
+		subs = make(map[*Subscription[T]]struct{})
+		h.subscribers[chatID] = subs
+	}
+	subs[sub] = struct{}{}
+
+	return sub
+}
+
+// Publish delivers the event to every subscriber of the chat except the excluded users
+// (e.g. those who deleted a message for themselves).
+func (h *hub[T]) Publish(chatID int64, event T, exclude ...int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscribers[chatID] {
+		if slices.Contains(exclude, sub.userID) {
+			continue
+		}
+
+		select {
+		case sub.ch <- event:
+		default:
+			// slow consumer, drop it instead of blocking the sender
+			h.remove(sub)
+		}
+	}
+}
+
+func (h *hub[T]) Kick(chatID, userID int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscribers[chatID] {
+		if sub.userID == userID {
+			h.remove(sub)
+		}
+	}
+}
+
+func (h *hub[T]) unsubscribe(sub *Subscription[T]) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.remove(sub)
+}
+
+// remove must be called with h.mu held
+func (h *hub[T]) remove(sub *Subscription[T]) {
+	subs, ok := h.subscribers[sub.chatID]
+	if !ok {
+		return
+	}
+
+	if _, ok = subs[sub]; !ok {
+		return
+	}
+
+	delete(subs, sub)
+	close(sub.ch)
+
+	if len(subs) == 0 {
+		delete(h.subscribers, sub.chatID)
+	}
+}
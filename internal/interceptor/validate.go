@@ -2,20 +2,25 @@ package interceptor
 
 import (
 	"context"
-	"flag"
 	"fmt"
-	"log"
+	"strings"
 
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
 	descAccess "github.com/BelyaevEI/microservices_chat/pkg/access_v1"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
-var accessToken = flag.String("a", "", "access token")
+const (
+	servicePort = 50051
 
-const servicePort = 50051
+	authHeader = "authorization"
+	authPrefix = "Bearer "
+)
 
 type validator interface {
 	Validate() error
@@ -32,25 +37,94 @@ func ValidateInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServ
 	return handler(ctx, req)
 }
 
-// AuthInterceptor interceptor for token validate
+// AuthInterceptor interceptor for token validate.
+// The caller's token is checked by the access service and the caller id from
+// its claims is put into the context.
 func AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	md := metadata.New(map[string]string{"Authorization": "Bearer " + *accessToken})
-	ctx = metadata.NewOutgoingContext(ctx, md)
+	ctx, err := authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// AuthStreamInterceptor interceptor for token validate of streaming requests
+func AuthStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authServerStream{ServerStream: ss, ctx: ctx})
+}
+
+// authenticate checks the caller's access to the method and returns the
+// context carrying the caller id
+func authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(authHeader)) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "authorization header is not provided")
+	}
+
+	authHeaderValue := md.Get(authHeader)[0]
+	if !strings.HasPrefix(authHeaderValue, authPrefix) {
+		return nil, status.Error(codes.Unauthenticated, "invalid authorization header format")
+	}
 
 	conn, err := grpc.NewClient(
 		fmt.Sprintf(":%d", servicePort),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	)
 	if err != nil {
-		log.Printf("failed to dial GRPC client: %v", err)
+		return nil, status.Errorf(codes.Unavailable, "failed to dial access service: %v", err)
 	}
+	defer conn.Close()
+
+	outCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(authHeader, authHeaderValue))
 
 	cl := descAccess.NewAccessV1Client(conn)
-	_, err = cl.Check(ctx, &descAccess.CheckRequest{
-		EndpointAddress: info.FullMethod,
+	_, err = cl.Check(outCtx, &descAccess.CheckRequest{
+		EndpointAddress: fullMethod,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return handler(ctx, req)
+
+	userID, err := auth.UserIDFromToken(strings.TrimPrefix(authHeaderValue, authPrefix))
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return auth.WithUserID(ctx, userID), nil
+}
+
+type validateServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *validateServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if val, ok := m.(validator); ok {
+		return val.Validate()
+	}
+
+	return nil
+}
+
+// ValidateStreamInterceptor interceptor for proto validate of streaming requests
+func ValidateStreamInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &validateServerStream{ServerStream: ss})
 }
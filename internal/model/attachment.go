@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// Attachment represents a file uploaded to a chat.
+// MessageID is empty until the attachment is sent with a message.
+type Attachment struct {
+	ID         string
+	ChatID     int64
+	UploaderID int64
+	MessageID  string
+	FileName   string
+	MimeType   string
+	Size       int64
+	SHA256     string
+	StorageKey string
+	CreatedAt  time.Time
+}
+
+// AttachmentUpload represents the metadata sent ahead of an attachment content
+type AttachmentUpload struct {
+	ChatID   int64
+	FileName string
+	// MimeType is detected from the content when left empty
+	MimeType string
+}
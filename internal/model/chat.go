@@ -1,14 +1,62 @@
 package model
 
+import "time"
+
+// roles of chat members, each one grants everything the next one does
+const (
+	MemberRoleOwner  = "owner"
+	MemberRoleAdmin  = "admin"
+	MemberRoleMember = "member"
+)
+
 // Chat represents a chat
 type Chat struct {
-	ID     int64
-	Name   string
-	UserID []int64
+	ID             int64
+	Name           string
+	UserID         []int64
+	CreatedAt      time.Time
+	LastActivityAt time.Time
+	// MessageTTL is the time-to-live of new messages, zero keeps them forever
+	MessageTTL time.Duration
+	// UnreadCount is only filled when chats are listed for a member
+	UnreadCount int64
 }
 
 // ChatCreate represents a chat to be created
 type ChatCreate struct {
-	Name   string
-	UserID []int64
+	Name      string
+	UserID    []int64
+	Usernames []string
+}
+
+// ChatMember represents a member of a chat.
+// Username is empty for members that were added by id.
+type ChatMember struct {
+	UserID   int64
+	Username string
+	Role     string
+}
+
+// ChatCursor represents a position in the list of chats ordered by last activity
+type ChatCursor struct {
+	LastActivityAt time.Time
+	ID             int64
+}
+
+// ChatPage represents a page of chats
+type ChatPage struct {
+	Chats         []*Chat
+	NextPageToken string
+}
+
+// ReadState represents how far a member has read a chat
+type ReadState struct {
+	UserID      int64
+	LastReadSeq int64
+}
+
+// ReadMark represents a member's read position after MarkRead
+type ReadMark struct {
+	LastReadSeq int64
+	UnreadCount int64
 }
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// InviteLink represents a link users join a chat by. Its token is only known
+// right after the link is created, just its hash is stored.
+type InviteLink struct {
+	ID        string
+	ChatID    int64
+	Token     string
+	CreatedBy int64
+	CreatedAt time.Time
+	ExpiresAt *time.Time
+	// MaxUses is zero for links that can be used any number of times
+	MaxUses   int32
+	Uses      int32
+	RevokedAt *time.Time
+}
+
+// InviteLinkCreate represents an invite link to be created
+type InviteLinkCreate struct {
+	ChatID    int64
+	CreatedBy int64
+	TokenHash string
+	ExpiresAt *time.Time
+	MaxUses   int32
+}
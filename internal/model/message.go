@@ -1,9 +1,47 @@
 package model
 
+import "time"
+
+// System events recorded in the chat history as messages
+const (
+	SystemEventMessagePinned   = "message_pinned"
+	SystemEventMessageUnpinned = "message_unpinned"
+	SystemEventUserJoined      = "user_joined"
+)
+
 // Message represents a chat message
 type Message struct {
-	ID   int64
-	Info MessageInfo
+	ID        string
+	Seq       int64
+	Info      MessageInfo
+	CreatedAt time.Time
+	EditedAt  *time.Time
+	DeletedAt *time.Time
+	// ReplyToID is the root of the thread the message belongs to, empty for root messages
+	ReplyToID  string
+	ReplyCount int64
+	// Reactions is only filled when messages are listed
+	Reactions []*ReactionCount
+	// SystemEvent is set for messages generated by the service, SubjectID then
+	// refers to the message the event is about
+	SystemEvent string
+	SubjectID   string
+	Mentions    []*MessageMention
+	Attachments []*Attachment
+	// ForwardedFrom is set for copies made by forwarding
+	ForwardedFrom *ForwardedFrom
+	// ExpiresAt is set for messages with a time-to-live
+	ExpiresAt *time.Time
+	// Poll is set for poll messages, the message text is its question
+	Poll *Poll
+}
+
+// ForwardedFrom refers to the original of a forwarded message.
+// Forwarding a forwarded message keeps referring to the very first original.
+type ForwardedFrom struct {
+	UserID    int64
+	ChatID    int64
+	MessageID string
 }
 
 // MessageInfo represents a chat message info
@@ -15,5 +53,152 @@ type MessageInfo struct {
 
 // MessageCreate represents a chat message to be created
 type MessageCreate struct {
-	Info MessageInfo
+	Info        MessageInfo
+	ReplyToID   string
+	SystemEvent string
+	SubjectID   string
+	Mentions    []*MessageMention
+	// AttachmentIDs refer to attachments uploaded by the sender to the chat
+	AttachmentIDs []string
+	ForwardedFrom *ForwardedFrom
+	// TTL overrides the time-to-live of the chat, zero means the chat's one
+	TTL time.Duration
+}
+
+// MessageCursor represents a position in the chat history
+type MessageCursor struct {
+	Seq int64
+}
+
+// MessageListQuery represents a request for a page of chat history
+type MessageListQuery struct {
+	ChatID int64
+	Before string
+	After  string
+	Limit  int32
+}
+
+// ThreadQuery represents a request for a page of thread replies
+type ThreadQuery struct {
+	ChatID int64
+	RootID string
+	After  string
+	Limit  int32
+}
+
+// ThreadPage represents a page of thread replies in chronological order
+type ThreadPage struct {
+	Root       *Message
+	Replies    []*Message
+	NextCursor string
+	HasMore    bool
+}
+
+// MessagePage represents a page of chat history in chronological order
+type MessagePage struct {
+	Messages   []*Message
+	PrevCursor string
+	NextCursor string
+	HasMore    bool
+}
+
+// MessageEdit represents a change of a message text by its author
+type MessageEdit struct {
+	MessageID string
+	ChatID    int64
+	Text      string
+}
+
+// MessageRevision represents a previous text of an edited message
+type MessageRevision struct {
+	MessageID string
+	ChatID    int64
+	Text      string
+	EditedAt  time.Time
+}
+
+// MessageDelete represents a request to delete a message.
+// A message deleted for everyone is kept as a tombstone, otherwise it is only
+// hidden from the caller.
+type MessageDelete struct {
+	MessageID   string
+	ChatID      int64
+	ForEveryone bool
+}
+
+// Reaction represents an emoji reaction of a user to a message
+type Reaction struct {
+	MessageID string
+	ChatID    int64
+	UserID    int64
+	Emoji     string
+}
+
+// ReactionCount represents how many users reacted to a message with an emoji
+type ReactionCount struct {
+	Emoji string
+	Count int64
+}
+
+// PinnedMessage represents a message pinned in a chat
+type PinnedMessage struct {
+	Message  *Message
+	PinnedBy int64
+	PinnedAt time.Time
+}
+
+// MessageMention represents a resolved @username or @all in a message text.
+// Offset and Length are counted in unicode code points and cover the '@'.
+type MessageMention struct {
+	UserID int64
+	All    bool
+	Offset int
+	Length int
+}
+
+// MentionCursor represents a position in the list of messages mentioning a user
+type MentionCursor struct {
+	CreatedAt time.Time
+	MessageID string
+}
+
+// MentionPage represents a page of messages mentioning a user, newest first
+type MentionPage struct {
+	Messages      []*Message
+	NextPageToken string
+}
+
+// SearchQuery represents a full-text search over the chats of a user.
+// Zero ChatID, SenderID, From and To mean no filter.
+type SearchQuery struct {
+	UserID    int64
+	Query     string
+	ChatID    int64
+	SenderID  int64
+	From      *time.Time
+	To        *time.Time
+	PageSize  int32
+	PageToken string
+}
+
+// SnippetMatchStart and SnippetMatchStop delimit the matches in the snippets
+// the repository returns. They are private use characters, so they can't be
+// confused with markup in the text.
+const (
+	SnippetMatchStart = "\uE000"
+	SnippetMatchStop  = "\uE001"
+)
+
+// SearchResult represents a message matching a search query
+type SearchResult struct {
+	Message *Message
+	// Snippet is a fragment of the text with the matches highlighted
+	Snippet string
+	Rank    float32
+}
+
+// SearchPage represents a page of search results, best matches first
+type SearchPage struct {
+	Results       []*SearchResult
+	NextPageToken string
 }
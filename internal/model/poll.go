@@ -0,0 +1,44 @@
+package model
+
+import "time"
+
+// Poll represents a poll attached to a chat message, the question is the
+// message text. Voters are only listed for polls that are not anonymous.
+type Poll struct {
+	MessageID   string
+	ChatID      int64
+	Question    string
+	Options     []*PollOption
+	MultiChoice bool
+	Anonymous   bool
+	ClosesAt    *time.Time
+	ClosedAt    *time.Time
+	// TotalVoters counts the users who voted for at least one option
+	TotalVoters int64
+}
+
+// PollOption represents a poll answer with its live tally
+type PollOption struct {
+	Index    int32
+	Text     string
+	Votes    int64
+	VoterIDs []int64
+}
+
+// PollCreate represents a poll to be created
+type PollCreate struct {
+	ChatID      int64
+	Question    string
+	Options     []string
+	MultiChoice bool
+	Anonymous   bool
+	ClosesAt    *time.Time
+}
+
+// PollVote represents the options a user picks in a poll, no options retract the vote
+type PollVote struct {
+	ChatID    int64
+	MessageID string
+	UserID    int64
+	Options   []int32
+}
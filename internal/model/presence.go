@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// PresenceStatus represents whether a user is around
+type PresenceStatus int
+
+const (
+	// PresenceOffline means no heartbeat has been received for a long time
+	PresenceOffline PresenceStatus = iota
+	// PresenceAway means the heartbeats stopped recently
+	PresenceAway
+	// PresenceOnline means the user sends heartbeats
+	PresenceOnline
+)
+
+// Presence represents the presence state of a user.
+// LastSeenAt is zero for users that have not been seen since the service started.
+type Presence struct {
+	UserID     int64
+	Status     PresenceStatus
+	LastSeenAt time.Time
+}
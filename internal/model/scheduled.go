@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// ScheduledMessage represents a message waiting to be sent on behalf of its
+// author once SendAt has come. Failure explains why the delivery was given up,
+// it is empty while the message is pending. Attempts counts the deliveries
+// that failed for a transient reason.
+type ScheduledMessage struct {
+	ID        string
+	Info      MessageInfo
+	SendAt    time.Time
+	CreatedAt time.Time
+	Failure   string
+	Attempts  int
+}
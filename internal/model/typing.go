@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// TypingEvent represents a member starting or stopping to type in a chat
+type TypingEvent struct {
+	ChatID    int64
+	UserID    int64
+	IsTyping  bool
+	ExpiresAt time.Time
+}
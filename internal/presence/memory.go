@@ -0,0 +1,162 @@
+package presence
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/hub"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+)
+
+type entry struct {
+	lastSeenAt time.Time
+	status     model.PresenceStatus
+}
+
+type memoryTracker struct {
+	hub          hub.Hub[*model.Presence]
+	awayAfter    time.Duration
+	offlineAfter time.Duration
+	forgetAfter  time.Duration
+
+	mu      sync.Mutex
+	entries map[int64]*entry
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewMemoryTracker creates an in-process presence tracker. A user is away once
+// no heartbeat came for awayAfter and offline after offlineAfter. Status changes
+// caused by missing heartbeats are detected in the background until Close is called.
+// Users are forgotten once no heartbeat came for forgetAfter, from then on
+// they are reported offline without the last seen time.
+func NewMemoryTracker(h hub.Hub[*model.Presence], awayAfter, offlineAfter, forgetAfter time.Duration) Tracker {
+	t := &memoryTracker{
+		hub:          h,
+		awayAfter:    awayAfter,
+		offlineAfter: offlineAfter,
+		forgetAfter:  forgetAfter,
+		entries:      make(map[int64]*entry),
+		done:         make(chan struct{}),
+	}
+
+	go t.run(awayAfter / 4)
+
+	return t
+}
+
+func (t *memoryTracker) Heartbeat(_ context.Context, userID int64) error {
+	now := time.Now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	e, ok := t.entries[userID]
+	if !ok {
+		e = &entry{}
+		t.entries[userID] = e
+	}
+
+	e.lastSeenAt = now
+	if e.status != model.PresenceOnline {
+		e.status = model.PresenceOnline
+		t.publish(userID, e)
+	}
+
+	return nil
+}
+
+func (t *memoryTracker) Get(_ context.Context, userIDs []int64) ([]*model.Presence, error) {
+	now := time.Now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	presences := make([]*model.Presence, 0, len(userIDs))
+	for _, userID := range userIDs {
+		presence := &model.Presence{
+			UserID: userID,
+			Status: model.PresenceOffline,
+		}
+
+		if e, ok := t.entries[userID]; ok {
+			presence.Status = t.statusAt(e, now)
+			presence.LastSeenAt = e.lastSeenAt
+		}
+
+		presences = append(presences, presence)
+	}
+
+	return presences, nil
+}
+
+// Subscribe uses the watched user as the hub key, so changes only reach the
+// watchers of that user
+func (t *memoryTracker) Subscribe(userID, watcherID int64) *hub.Subscription[*model.Presence] {
+	return t.hub.Subscribe(userID, watcherID)
+}
+
+func (t *memoryTracker) Close() error {
+	t.closeOnce.Do(func() {
+		close(t.done)
+	})
+
+	return nil
+}
+
+func (t *memoryTracker) run(interval time.Duration) {
+	ticker := time.NewTicker(max(interval, time.Second))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.done:
+			return
+		case now := <-ticker.C:
+			t.sweep(now)
+		}
+	}
+}
+
+// sweep announces users that turned away or offline since the last run and
+// forgets the users not seen for forgetAfter
+func (t *memoryTracker) sweep(now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for userID, e := range t.entries {
+		status := t.statusAt(e, now)
+		if status != e.status {
+			e.status = status
+			t.publish(userID, e)
+		}
+
+		if now.Sub(e.lastSeenAt) >= t.forgetAfter {
+			delete(t.entries, userID)
+		}
+	}
+}
+
+func (t *memoryTracker) statusAt(e *entry, now time.Time) model.PresenceStatus {
+	idle := now.Sub(e.lastSeenAt)
+
+	switch {
+	case idle >= t.offlineAfter:
+		return model.PresenceOffline
+	case idle >= t.awayAfter:
+		return model.PresenceAway
+	default:
+		return model.PresenceOnline
+	}
+}
+
+// publish must be called with t.mu held
+func (t *memoryTracker) publish(userID int64, e *entry) {
+	t.hub.Publish(userID, &model.Presence{
+		UserID:     userID,
+		Status:     e.status,
+		LastSeenAt: e.lastSeenAt,
+	})
+}
@@ -0,0 +1,20 @@
+package presence
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/hub"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+)
+
+// Tracker keeps heartbeat based presence of users.
+// Implementations may keep the state in process or in a shared store such as Redis.
+type Tracker interface {
+	// Heartbeat marks the user as online
+	Heartbeat(ctx context.Context, userID int64) error
+	// Get returns the presence of every requested user, in the requested order
+	Get(ctx context.Context, userIDs []int64) ([]*model.Presence, error)
+	// Subscribe streams presence changes of the user to the watcher
+	Subscribe(userID, watcherID int64) *hub.Subscription[*model.Presence]
+	Close() error
+}
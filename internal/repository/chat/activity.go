@@ -0,0 +1,63 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+func (r *repo) UpdateLastActivity(ctx context.Context, chatID int64) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(lastActivityAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{idColumn: chatID})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.UpdateLastActivity",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+// NextMessageSeq allocates the next message sequence number of the chat.
+// The chat row stays locked until the surrounding transaction ends, so numbers
+// are handed out in commit order and a rollback leaves no gap.
+func (r *repo) NextMessageSeq(ctx context.Context, chatID int64) (int64, error) {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(lastSeqColumn, sq.Expr(lastSeqColumn+" + 1")).
+		Where(sq.Eq{idColumn: chatID}).
+		Suffix("RETURNING " + lastSeqColumn)
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.NextMessageSeq",
+		QueryRaw: query,
+	}
+
+	var seq int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&seq)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repository.ErrChatNotFound
+		}
+		return 0, err
+	}
+
+	return seq, nil
+}
@@ -0,0 +1,367 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+// attachmentColumns lists the columns read by scanAttachment, in order
+var attachmentColumns = []string{
+	idColumn,
+	chatIDColumn,
+	uploaderIDColumn,
+	messageIDColumn,
+	fileNameColumn,
+	mimeTypeColumn,
+	sizeColumn,
+	sha256Column,
+	storageKeyColumn,
+	createdAtColumn,
+}
+
+func scanAttachment(row pgx.Row) (*model.Attachment, error) {
+	var (
+		attachment model.Attachment
+		messageID  *string
+	)
+
+	err := row.Scan(
+		&attachment.ID,
+		&attachment.ChatID,
+		&attachment.UploaderID,
+		&messageID,
+		&attachment.FileName,
+		&attachment.MimeType,
+		&attachment.Size,
+		&attachment.SHA256,
+		&attachment.StorageKey,
+		&attachment.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if messageID != nil {
+		attachment.MessageID = *messageID
+	}
+
+	return &attachment, nil
+}
+
+func (r *repo) CreateAttachment(ctx context.Context, attachment *model.Attachment) (*model.Attachment, error) {
+	builderInsert := sq.Insert(tableNameAttachments).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, uploaderIDColumn, fileNameColumn, mimeTypeColumn, sizeColumn, sha256Column, storageKeyColumn).
+		Values(
+			attachment.ChatID,
+			attachment.UploaderID,
+			attachment.FileName,
+			attachment.MimeType,
+			attachment.Size,
+			attachment.SHA256,
+			attachment.StorageKey,
+		).
+		Suffix("RETURNING " + strings.Join(attachmentColumns, ", "))
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "attachment_repository.Create",
+		QueryRaw: query,
+	}
+
+	return scanAttachment(r.db.DB().QueryRowContext(ctx, q, args...))
+}
+
+func (r *repo) GetAttachment(ctx context.Context, attachmentID string) (*model.Attachment, error) {
+	builderSelect := sq.Select(attachmentColumns...).
+		From(tableNameAttachments).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: attachmentID})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "attachment_repository.Get",
+		QueryRaw: query,
+	}
+
+	attachment, err := scanAttachment(r.db.DB().QueryRowContext(ctx, q, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrAttachmentNotFound
+		}
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// LinkAttachments attaches the uploads to the message. Only the uploader's
+// attachments of the same chat that were not sent yet are linked, the caller
+// compares the result with the requested ids.
+func (r *repo) LinkAttachments(ctx context.Context, chatID int64, messageID string, uploaderID int64, attachmentIDs []string) ([]*model.Attachment, error) {
+	if len(attachmentIDs) == 0 {
+		return nil, nil
+	}
+
+	builderUpdate := sq.Update(tableNameAttachments).
+		PlaceholderFormat(sq.Dollar).
+		Set(messageIDColumn, messageID).
+		Where(sq.Eq{
+			idColumn:         attachmentIDs,
+			chatIDColumn:     chatID,
+			uploaderIDColumn: uploaderID,
+			messageIDColumn:  nil,
+		}).
+		Suffix("RETURNING " + strings.Join(attachmentColumns, ", "))
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "attachment_repository.Link",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	attachments := make([]*model.Attachment, 0, len(attachmentIDs))
+	for rows.Next() {
+		attachment, errScan := scanAttachment(rows)
+		if errScan != nil {
+			return nil, errScan
+		}
+
+		attachments = append(attachments, attachment)
+	}
+
+	return attachments, rows.Err()
+}
+
+// GetAttachments returns the attachments of the given messages in upload order
+func (r *repo) GetAttachments(ctx context.Context, chatID int64, messageIDs []string) (map[string][]*model.Attachment, error) {
+	attachments := make(map[string][]*model.Attachment)
+	if len(messageIDs) == 0 {
+		return attachments, nil
+	}
+
+	builderSelect := sq.Select(attachmentColumns...).
+		From(tableNameAttachments).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID, messageIDColumn: messageIDs}).
+		OrderBy(createdAtColumn, idColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "attachment_repository.GetByMessages",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		attachment, errScan := scanAttachment(rows)
+		if errScan != nil {
+			return nil, errScan
+		}
+
+		attachments[attachment.MessageID] = append(attachments[attachment.MessageID], attachment)
+	}
+
+	return attachments, rows.Err()
+}
+
+// CopyAttachments attaches copies of the source message attachments to the
+// target message. The copies share the stored blobs with the originals.
+func (r *repo) CopyAttachments(ctx context.Context, source, target *model.Message) ([]*model.Attachment, error) {
+	originals := sq.Select().
+		Column(sq.Expr("?", target.Info.ChatID)).
+		Column(sq.Expr("?", target.Info.UserID)).
+		Column(sq.Expr("?::uuid", target.ID)).
+		Columns(fileNameColumn, mimeTypeColumn, sizeColumn, sha256Column, storageKeyColumn).
+		From(tableNameAttachments).
+		Where(sq.Eq{chatIDColumn: source.Info.ChatID, messageIDColumn: source.ID}).
+		OrderBy(createdAtColumn, idColumn)
+
+	builderInsert := sq.Insert(tableNameAttachments).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, uploaderIDColumn, messageIDColumn, fileNameColumn, mimeTypeColumn, sizeColumn, sha256Column, storageKeyColumn).
+		Select(originals).
+		Suffix("RETURNING " + strings.Join(attachmentColumns, ", "))
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "attachment_repository.Copy",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attachments []*model.Attachment
+	for rows.Next() {
+		attachment, errScan := scanAttachment(rows)
+		if errScan != nil {
+			return nil, errScan
+		}
+
+		attachments = append(attachments, attachment)
+	}
+
+	return attachments, rows.Err()
+}
+
+// DeleteMessageAttachments removes the attachments of the message and returns
+// the storage keys they referred to
+func (r *repo) DeleteMessageAttachments(ctx context.Context, chatID int64, messageID string) ([]string, error) {
+	builderDelete := sq.Delete(tableNameAttachments).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID, messageIDColumn: messageID}).
+		Suffix("RETURNING " + storageKeyColumn)
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "attachment_repository.DeleteByMessage",
+		QueryRaw: query,
+	}
+
+	return r.queryStorageKeys(ctx, q, args...)
+}
+
+// DeleteStaleUploads removes up to limit attachments uploaded before the given
+// time and never sent, and returns the storage keys they referred to
+func (r *repo) DeleteStaleUploads(ctx context.Context, uploadedBefore time.Time, limit uint64) ([]string, error) {
+	stale := sq.Select(idColumn).
+		From(tableNameAttachments).
+		Where(sq.Eq{messageIDColumn: nil}).
+		Where(sq.Lt{createdAtColumn: uploadedBefore}).
+		OrderBy(createdAtColumn).
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	builderDelete := sq.Delete(tableNameAttachments).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Expr(idColumn+" IN (?)", stale)).
+		Suffix("RETURNING " + storageKeyColumn)
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "attachment_repository.DeleteStaleUploads",
+		QueryRaw: query,
+	}
+
+	return r.queryStorageKeys(ctx, q, args...)
+}
+
+// GetChatStorageKeys returns the storage keys of every attachment of the chat
+func (r *repo) GetChatStorageKeys(ctx context.Context, chatID int64) ([]string, error) {
+	builderSelect := sq.Select(storageKeyColumn).
+		Distinct().
+		From(tableNameAttachments).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "attachment_repository.GetChatStorageKeys",
+		QueryRaw: query,
+	}
+
+	return r.queryStorageKeys(ctx, q, args...)
+}
+
+// GetUsedStorageKeys returns the given storage keys that attachments still
+// refer to. Forwarded copies share the keys of the originals.
+func (r *repo) GetUsedStorageKeys(ctx context.Context, keys []string) ([]string, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	builderSelect := sq.Select(storageKeyColumn).
+		Distinct().
+		From(tableNameAttachments).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{storageKeyColumn: keys})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "attachment_repository.GetUsedStorageKeys",
+		QueryRaw: query,
+	}
+
+	return r.queryStorageKeys(ctx, q, args...)
+}
+
+// queryStorageKeys runs a query returning a single column of storage keys
+func (r *repo) queryStorageKeys(ctx context.Context, q db.Query, args ...any) ([]string, error) {
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []string
+	for rows.Next() {
+		var key string
+		errScan := rows.Scan(&key)
+		if errScan != nil {
+			return nil, errScan
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
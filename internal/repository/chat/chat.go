@@ -0,0 +1,43 @@
+package chat
+
+import (
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/jackc/pgx/v4"
+)
+
+// memberIDsColumn collects the chat members into an array in join order
+const memberIDsColumn = "ARRAY(SELECT " + userIDColumn + " FROM " + tableNameMembers +
+	" WHERE " + chatIDColumn + " = " + tableName + "." + idColumn +
+	" ORDER BY " + joinedAtColumn + ", " + userIDColumn + ")"
+
+// chatColumns lists the columns read by scanChat, in order
+var chatColumns = []string{
+	idColumn,
+	nameColumn,
+	memberIDsColumn,
+	createdAtColumn,
+	lastActivityAtColumn,
+	messageTTLColumn,
+}
+
+// scanChat reads chatColumns, extra receives the columns selected after them
+func scanChat(row pgx.Row, extra ...any) (*model.Chat, error) {
+	var (
+		chat       model.Chat
+		ttlSeconds *int64
+	)
+
+	dest := []any{&chat.ID, &chat.Name, &chat.UserID, &chat.CreatedAt, &chat.LastActivityAt, &ttlSeconds}
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if ttlSeconds != nil {
+		chat.MessageTTL = time.Duration(*ttlSeconds) * time.Second
+	}
+
+	return &chat, nil
+}
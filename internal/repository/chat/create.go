@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
 	"github.com/BelyaevEI/platform_common/pkg/db"
 	sq "github.com/Masterminds/squirrel"
 )
@@ -13,8 +14,8 @@ func (r *repo) CreateChat(ctx context.Context, createChat *model.ChatCreate) (in
 
 	builderInsert := sq.Insert(tableName).
 		PlaceholderFormat(sq.Dollar).
-		Columns(nameColumn, userIDs).
-		Values(createChat.Name, createChat.UserID).
+		Columns(nameColumn).
+		Values(createChat.Name).
 		Suffix("RETURNING id")
 
 	query, args, err := builderInsert.ToSql()
@@ -34,3 +35,32 @@ func (r *repo) CreateChat(ctx context.Context, createChat *model.ChatCreate) (in
 
 	return id, nil
 }
+
+// RenameChat changes the name of the chat
+func (r *repo) RenameChat(ctx context.Context, chatID int64, name string) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(nameColumn, name).
+		Where(sq.Eq{idColumn: chatID})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.Rename",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return repository.ErrChatNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+func (r *repo) DeleteMessageForEveryone(ctx context.Context, chatID int64, messageID string) (*model.Message, error) {
+	builderUpdate := sq.Update(tableNameMessage).
+		PlaceholderFormat(sq.Dollar).
+		Set(textColumn, "").
+		Set(deletedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{idColumn: messageID, chatIDColumn: chatID}).
+		Suffix(returningMessage())
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "message_repository.DeleteForEveryone",
+		QueryRaw: query,
+	}
+
+	message, err := scanMessage(r.db.DB().QueryRowContext(ctx, q, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrMessageNotFound
+		}
+		return nil, err
+	}
+
+	return message, nil
+}
+
+func (r *repo) DeleteMessageRevisions(ctx context.Context, chatID int64, messageID string) error {
+	builderDelete := sq.Delete(tableNameRevision).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{messageIDColumn: messageID, chatIDColumn: chatID})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "message_repository.DeleteRevisions",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+func (r *repo) HideMessage(ctx context.Context, chatID int64, messageID string, userID int64) error {
+	builderInsert := sq.Insert(tableNameHidden).
+		PlaceholderFormat(sq.Dollar).
+		Columns(messageIDColumn, chatIDColumn, userIDColumn).
+		Values(messageID, chatID, userID).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "message_repository.Hide",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+func (r *repo) ListHiddenFor(ctx context.Context, chatID int64, messageID string) ([]int64, error) {
+	builderSelect := sq.Select(userIDColumn).
+		From(tableNameHidden).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{messageIDColumn: messageID, chatIDColumn: chatID})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "message_repository.ListHiddenFor",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs []int64
+	for rows.Next() {
+		var userID int64
+		if err = rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, rows.Err()
+}
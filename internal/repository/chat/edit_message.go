@@ -0,0 +1,41 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+func (r *repo) UpdateMessageText(ctx context.Context, chatID int64, messageID, text string) (*model.Message, error) {
+	builderUpdate := sq.Update(tableNameMessage).
+		PlaceholderFormat(sq.Dollar).
+		Set(textColumn, text).
+		Set(editedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{idColumn: messageID, chatIDColumn: chatID}).
+		Suffix(returningMessage())
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "message_repository.UpdateText",
+		QueryRaw: query,
+	}
+
+	message, err := scanMessage(r.db.DB().QueryRowContext(ctx, q, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrMessageNotFound
+		}
+		return nil, err
+	}
+
+	return message, nil
+}
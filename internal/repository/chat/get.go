@@ -0,0 +1,40 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+func (r *repo) GetChat(ctx context.Context, id int64) (*model.Chat, error) {
+	builderSelect := sq.Select(chatColumns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id}).
+		Limit(1)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.Get",
+		QueryRaw: query,
+	}
+
+	chat, err := scanChat(r.db.DB().QueryRowContext(ctx, q, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrChatNotFound
+		}
+		return nil, err
+	}
+
+	return chat, nil
+}
@@ -0,0 +1,55 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+func (r *repo) GetMessage(ctx context.Context, chatID int64, messageID string) (*model.Message, error) {
+	return r.getMessage(ctx, chatID, messageID, false)
+}
+
+// GetMessageForUpdate locks the message row until the surrounding transaction ends.
+func (r *repo) GetMessageForUpdate(ctx context.Context, chatID int64, messageID string) (*model.Message, error) {
+	return r.getMessage(ctx, chatID, messageID, true)
+}
+
+func (r *repo) getMessage(ctx context.Context, chatID int64, messageID string, forUpdate bool) (*model.Message, error) {
+	builderSelect := sq.Select(messageColumns...).
+		From(tableNameMessage).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: messageID, chatIDColumn: chatID}).
+		Where(notExpired(tableNameMessage))
+
+	name := "message_repository.Get"
+	if forUpdate {
+		builderSelect = builderSelect.Suffix("FOR UPDATE")
+		name = "message_repository.GetForUpdate"
+	}
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     name,
+		QueryRaw: query,
+	}
+
+	message, err := scanMessage(r.db.DB().QueryRowContext(ctx, q, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrMessageNotFound
+		}
+		return nil, err
+	}
+
+	return message, nil
+}
@@ -0,0 +1,166 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+// inviteColumns lists the columns read by scanInvite, in order
+var inviteColumns = []string{
+	idColumn,
+	chatIDColumn,
+	createdByColumn,
+	createdAtColumn,
+	expiresAtColumn,
+	maxUsesColumn,
+	usesColumn,
+	revokedAtColumn,
+}
+
+func scanInvite(row pgx.Row) (*model.InviteLink, error) {
+	var (
+		invite  model.InviteLink
+		maxUses *int32
+	)
+
+	err := row.Scan(
+		&invite.ID,
+		&invite.ChatID,
+		&invite.CreatedBy,
+		&invite.CreatedAt,
+		&invite.ExpiresAt,
+		&maxUses,
+		&invite.Uses,
+		&invite.RevokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxUses != nil {
+		invite.MaxUses = *maxUses
+	}
+
+	return &invite, nil
+}
+
+func (r *repo) CreateInviteLink(ctx context.Context, create *model.InviteLinkCreate) (*model.InviteLink, error) {
+	var expiresAt *time.Time
+	if create.ExpiresAt != nil {
+		utc := create.ExpiresAt.UTC()
+		expiresAt = &utc
+	}
+
+	var maxUses *int32
+	if create.MaxUses > 0 {
+		maxUses = &create.MaxUses
+	}
+
+	builderInsert := sq.Insert(tableNameInvites).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, tokenHashColumn, createdByColumn, expiresAtColumn, maxUsesColumn).
+		Values(create.ChatID, create.TokenHash, create.CreatedBy, expiresAt, maxUses).
+		Suffix("RETURNING " + strings.Join(inviteColumns, ", "))
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "invite_repository.Create",
+		QueryRaw: query,
+	}
+
+	return scanInvite(r.db.DB().QueryRowContext(ctx, q, args...))
+}
+
+// RevokeInviteLink stops the invite link of the chat from being used,
+// revoking it again changes nothing
+func (r *repo) RevokeInviteLink(ctx context.Context, chatID int64, inviteID string) error {
+	builderUpdate := sq.Update(tableNameInvites).
+		PlaceholderFormat(sq.Dollar).
+		Set(revokedAtColumn, sq.Expr("COALESCE("+revokedAtColumn+", now())")).
+		Where(sq.Eq{idColumn: inviteID, chatIDColumn: chatID})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "invite_repository.Revoke",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return repository.ErrInviteLinkNotFound
+	}
+
+	return nil
+}
+
+// GetInviteLinkForUpdate returns the invite link with the given token hash and
+// locks it until the surrounding transaction ends, so that concurrent joins
+// can't use it more often than allowed
+func (r *repo) GetInviteLinkForUpdate(ctx context.Context, tokenHash string) (*model.InviteLink, error) {
+	builderSelect := sq.Select(inviteColumns...).
+		From(tableNameInvites).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{tokenHashColumn: tokenHash}).
+		Suffix("FOR UPDATE")
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "invite_repository.GetForUpdate",
+		QueryRaw: query,
+	}
+
+	invite, err := scanInvite(r.db.DB().QueryRowContext(ctx, q, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrInviteLinkNotFound
+		}
+		return nil, err
+	}
+
+	return invite, nil
+}
+
+// CountInviteUse records one more use of the invite link
+func (r *repo) CountInviteUse(ctx context.Context, inviteID string) error {
+	builderUpdate := sq.Update(tableNameInvites).
+		PlaceholderFormat(sq.Dollar).
+		Set(usesColumn, sq.Expr(usesColumn+" + 1")).
+		Where(sq.Eq{idColumn: inviteID})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "invite_repository.CountUse",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
@@ -0,0 +1,66 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+)
+
+func (r *repo) ListChats(ctx context.Context, userID int64, cursor *model.ChatCursor, limit uint64) ([]*model.Chat, error) {
+	member := sq.Select("1").
+		From(tableNameMembers + " m").
+		Where("m." + chatIDColumn + " = " + tableName + "." + idColumn).
+		Where(sq.Eq{"m." + userIDColumn: userID})
+
+	// how many messages arrived after the member's read position
+	unread := sq.Select(tableName + "." + lastSeqColumn + " - m." + lastReadSeqColumn).
+		From(tableNameMembers + " m").
+		Where("m." + chatIDColumn + " = " + tableName + "." + idColumn).
+		Where(sq.Eq{"m." + userIDColumn: userID})
+
+	builderSelect := sq.Select(chatColumns...).
+		Column(sq.Expr("(?)", unread)).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Expr("EXISTS (?)", member)).
+		OrderBy(lastActivityAtColumn+" DESC", idColumn+" DESC").
+		Limit(limit)
+
+	if cursor != nil {
+		builderSelect = builderSelect.Where(
+			sq.Expr("("+lastActivityAtColumn+", "+idColumn+") < (?, ?)", cursor.LastActivityAt, cursor.ID),
+		)
+	}
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.List",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	chats := make([]*model.Chat, 0, limit)
+	for rows.Next() {
+		var unreadCount int64
+		chat, errScan := scanChat(rows, &unreadCount)
+		if errScan != nil {
+			return nil, errScan
+		}
+		chat.UnreadCount = unreadCount
+
+		chats = append(chats, chat)
+	}
+
+	return chats, rows.Err()
+}
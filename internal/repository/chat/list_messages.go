@@ -0,0 +1,79 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+)
+
+func (r *repo) ListMessages(ctx context.Context, chatID, userID int64, before, after *model.MessageCursor, limit uint64) ([]*model.Message, error) {
+	// messages the user deleted "for me" are skipped
+	hidden := sq.Select("1").
+		From(tableNameHidden + " h").
+		Where("h." + messageIDColumn + " = " + tableNameMessage + "." + idColumn).
+		Where("h." + chatIDColumn + " = " + tableNameMessage + "." + chatIDColumn).
+		Where(sq.Eq{"h." + userIDColumn: userID})
+
+	builderSelect := sq.Select(messageColumns...).
+		From(tableNameMessage).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID}).
+		Where(sq.Expr("NOT EXISTS (?)", hidden)).
+		Where(notExpired(tableNameMessage)).
+		Limit(limit)
+
+	if before != nil {
+		builderSelect = builderSelect.Where(sq.Lt{seqColumn: before.Seq})
+	}
+
+	// scrolling forward reads in ascending order, otherwise the newest rows are taken
+	// and reversed afterwards
+	ascending := after != nil
+	if ascending {
+		builderSelect = builderSelect.
+			Where(sq.Gt{seqColumn: after.Seq}).
+			OrderBy(seqColumn + " ASC")
+	} else {
+		builderSelect = builderSelect.OrderBy(seqColumn + " DESC")
+	}
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "message_repository.List",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := make([]*model.Message, 0, limit)
+	for rows.Next() {
+		message, errScan := scanMessage(rows)
+		if errScan != nil {
+			return nil, errScan
+		}
+
+		messages = append(messages, message)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if !ascending {
+		for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+			messages[i], messages[j] = messages[j], messages[i]
+		}
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,174 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+// LockChat locks the chat row until the surrounding transaction ends.
+func (r *repo) LockChat(ctx context.Context, chatID int64) error {
+	builderSelect := sq.Select(idColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: chatID}).
+		Suffix("FOR UPDATE")
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.Lock",
+		QueryRaw: query,
+	}
+
+	var id int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.ErrChatNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *repo) AddMembers(ctx context.Context, chatID int64, members []*model.ChatMember) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	// re-adding a member by username fills in a username that was not known
+	// before, the role of an existing member stays as it is
+	builderInsert := sq.Insert(tableNameMembers).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, userIDColumn, usernameColumn, roleColumn).
+		Suffix("ON CONFLICT (" + chatIDColumn + ", " + userIDColumn + ") DO UPDATE SET " +
+			usernameColumn + " = COALESCE(EXCLUDED." + usernameColumn + ", " + tableNameMembers + "." + usernameColumn + ")")
+
+	for _, member := range members {
+		builderInsert = builderInsert.Values(chatID, member.UserID, sq.Expr("NULLIF(?, '')", member.Username), member.Role)
+	}
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.AddMembers",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+func (r *repo) RemoveMembers(ctx context.Context, chatID int64, userIDs []int64) (int64, error) {
+	builderDelete := sq.Delete(tableNameMembers).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID, userIDColumn: userIDs})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.RemoveMembers",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// IsMember reports whether the user belongs to the chat.
+// ErrChatNotFound is returned when the chat itself does not exist.
+func (r *repo) IsMember(ctx context.Context, chatID, userID int64) (bool, error) {
+	member := sq.Select("1").
+		From(tableNameMembers + " m").
+		Where("m." + chatIDColumn + " = " + tableName + "." + idColumn).
+		Where(sq.Eq{"m." + userIDColumn: userID})
+
+	builderSelect := sq.Select().
+		Column(sq.Expr("EXISTS (?)", member)).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: chatID})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.IsMember",
+		QueryRaw: query,
+	}
+
+	var isMember bool
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&isMember)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, repository.ErrChatNotFound
+		}
+		return false, err
+	}
+
+	return isMember, nil
+}
+
+// ListContacts returns the users sharing at least one chat with the user
+func (r *repo) ListContacts(ctx context.Context, userID int64) ([]int64, error) {
+	chats := sq.Select(chatIDColumn).
+		From(tableNameMembers).
+		Where(sq.Eq{userIDColumn: userID})
+
+	builderSelect := sq.Select("DISTINCT m." + userIDColumn).
+		From(tableNameMembers + " m").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Expr("m."+chatIDColumn+" IN (?)", chats)).
+		Where(sq.NotEq{"m." + userIDColumn: userID})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.ListContacts",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs []int64
+	for rows.Next() {
+		var id int64
+		errScan := rows.Scan(&id)
+		if errScan != nil {
+			return nil, errScan
+		}
+
+		userIDs = append(userIDs, id)
+	}
+
+	return userIDs, rows.Err()
+}
@@ -0,0 +1,184 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+)
+
+// AddMentions stores the mentions of the message, @all is stored without a user
+func (r *repo) AddMentions(ctx context.Context, chatID int64, messageID string, mentions []*model.MessageMention) error {
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	builderInsert := sq.Insert(tableNameMentions).
+		PlaceholderFormat(sq.Dollar).
+		Columns(messageIDColumn, chatIDColumn, userIDColumn, startOffsetColumn, lengthColumn)
+
+	for _, mention := range mentions {
+		var userID *int64
+		if !mention.All {
+			userID = &mention.UserID
+		}
+
+		builderInsert = builderInsert.Values(messageID, chatID, userID, mention.Offset, mention.Length)
+	}
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "mention_repository.Add",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+func (r *repo) DeleteMentions(ctx context.Context, chatID int64, messageID string) error {
+	builderDelete := sq.Delete(tableNameMentions).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{messageIDColumn: messageID, chatIDColumn: chatID})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "mention_repository.Delete",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+// GetMentions returns the mentions of the given messages in text order
+func (r *repo) GetMentions(ctx context.Context, chatID int64, messageIDs []string) (map[string][]*model.MessageMention, error) {
+	mentions := make(map[string][]*model.MessageMention)
+	if len(messageIDs) == 0 {
+		return mentions, nil
+	}
+
+	builderSelect := sq.Select(messageIDColumn, userIDColumn, startOffsetColumn, lengthColumn).
+		From(tableNameMentions).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID, messageIDColumn: messageIDs}).
+		OrderBy(messageIDColumn, startOffsetColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "mention_repository.Get",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			messageID string
+			userID    *int64
+			mention   model.MessageMention
+		)
+		errScan := rows.Scan(&messageID, &userID, &mention.Offset, &mention.Length)
+		if errScan != nil {
+			return nil, errScan
+		}
+
+		if userID != nil {
+			mention.UserID = *userID
+		} else {
+			mention.All = true
+		}
+
+		mentions[messageID] = append(mentions[messageID], &mention)
+	}
+
+	return mentions, rows.Err()
+}
+
+// ListMentions returns the messages mentioning the user by name or with @all,
+// newest first. Only chats the user is still a member of are looked at, and
+// the user's own, deleted, hidden and expired messages are skipped.
+func (r *repo) ListMentions(ctx context.Context, userID int64, cursor *model.MentionCursor, limit uint64) ([]*model.Message, error) {
+	member := sq.Select("1").
+		From(tableNameMembers + " m").
+		Where("m." + chatIDColumn + " = " + tableNameMessage + "." + chatIDColumn).
+		Where(sq.Eq{"m." + userIDColumn: userID})
+
+	mentioned := sq.Select("1").
+		From(tableNameMentions + " mm").
+		Where("mm." + messageIDColumn + " = " + tableNameMessage + "." + idColumn).
+		Where("mm." + chatIDColumn + " = " + tableNameMessage + "." + chatIDColumn).
+		Where(sq.Or{
+			sq.Eq{"mm." + userIDColumn: userID},
+			sq.Eq{"mm." + userIDColumn: nil},
+		})
+
+	hidden := sq.Select("1").
+		From(tableNameHidden + " h").
+		Where("h." + messageIDColumn + " = " + tableNameMessage + "." + idColumn).
+		Where("h." + chatIDColumn + " = " + tableNameMessage + "." + chatIDColumn).
+		Where(sq.Eq{"h." + userIDColumn: userID})
+
+	builderSelect := sq.Select(messageColumns...).
+		From(tableNameMessage).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{deletedAtColumn: nil}).
+		Where(sq.NotEq{userIDColumn: userID}).
+		Where(sq.Expr("EXISTS (?)", member)).
+		Where(sq.Expr("EXISTS (?)", mentioned)).
+		Where(sq.Expr("NOT EXISTS (?)", hidden)).
+		Where(notExpired(tableNameMessage)).
+		OrderBy(messageCreatedAtColumn+" DESC", idColumn+" DESC").
+		Limit(limit)
+
+	if cursor != nil {
+		builderSelect = builderSelect.Where(
+			sq.Expr("("+messageCreatedAtColumn+", "+idColumn+") < (?, ?)", cursor.CreatedAt, cursor.MessageID),
+		)
+	}
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "mention_repository.List",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := make([]*model.Message, 0, limit)
+	for rows.Next() {
+		message, errScan := scanMessage(rows)
+		if errScan != nil {
+			return nil, errScan
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages, rows.Err()
+}
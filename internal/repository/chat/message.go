@@ -0,0 +1,96 @@
+package chat
+
+import (
+	"strings"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+// messageColumns lists the columns read by scanMessage, in order
+var messageColumns = []string{
+	idColumn,
+	seqColumn,
+	chatIDColumn,
+	userIDColumn,
+	textColumn,
+	messageCreatedAtColumn,
+	editedAtColumn,
+	deletedAtColumn,
+	replyToIDColumn,
+	replyCountColumn,
+	systemEventColumn,
+	subjectIDColumn,
+	forwardedFromUserIDColumn,
+	forwardedFromChatIDColumn,
+	forwardedFromMessageIDColumn,
+	expiresAtColumn,
+}
+
+func returningMessage() string {
+	return "RETURNING " + strings.Join(messageColumns, ", ")
+}
+
+// notExpired skips messages whose time-to-live is over but that were not
+// purged yet, table qualifies the column in queries joining other tables
+func notExpired(table string) sq.Sqlizer {
+	column := table + "." + expiresAtColumn
+	return sq.Expr("(" + column + " IS NULL OR " + column + " > now())")
+}
+
+// scanMessage reads messageColumns, extra receives the columns selected after them
+func scanMessage(row pgx.Row, extra ...any) (*model.Message, error) {
+	var (
+		message     model.Message
+		replyToID   *string
+		systemEvent *string
+		subjectID   *string
+
+		forwardedFromUserID    *int64
+		forwardedFromChatID    *int64
+		forwardedFromMessageID *string
+	)
+	dest := []any{
+		&message.ID,
+		&message.Seq,
+		&message.Info.ChatID,
+		&message.Info.UserID,
+		&message.Info.Text,
+		&message.CreatedAt,
+		&message.EditedAt,
+		&message.DeletedAt,
+		&replyToID,
+		&message.ReplyCount,
+		&systemEvent,
+		&subjectID,
+		&forwardedFromUserID,
+		&forwardedFromChatID,
+		&forwardedFromMessageID,
+		&message.ExpiresAt,
+	}
+
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if replyToID != nil {
+		message.ReplyToID = *replyToID
+	}
+	if systemEvent != nil {
+		message.SystemEvent = *systemEvent
+	}
+	if subjectID != nil {
+		message.SubjectID = *subjectID
+	}
+	if forwardedFromChatID != nil && forwardedFromUserID != nil && forwardedFromMessageID != nil {
+		message.ForwardedFrom = &model.ForwardedFrom{
+			UserID:    *forwardedFromUserID,
+			ChatID:    *forwardedFromChatID,
+			MessageID: *forwardedFromMessageID,
+		}
+	}
+
+	return &message, nil
+}
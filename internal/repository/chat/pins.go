@@ -0,0 +1,131 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+)
+
+func (r *repo) CountPins(ctx context.Context, chatID int64) (int, error) {
+	builderSelect := sq.Select("count(*)").
+		From(tableNamePins).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "pin_repository.Count",
+		QueryRaw: query,
+	}
+
+	var count int
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// PinMessage pins the message and reports whether it was not pinned before
+func (r *repo) PinMessage(ctx context.Context, chatID int64, messageID string, userID int64) (bool, error) {
+	builderInsert := sq.Insert(tableNamePins).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, messageIDColumn, pinnedByColumn).
+		Values(chatID, messageID, userID).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "pin_repository.Pin",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// UnpinMessage unpins the message and reports whether it was pinned
+func (r *repo) UnpinMessage(ctx context.Context, chatID int64, messageID string) (bool, error) {
+	builderDelete := sq.Delete(tableNamePins).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID, messageIDColumn: messageID})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "pin_repository.Unpin",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// ListPinned returns the pinned messages of the chat, most recently pinned first
+func (r *repo) ListPinned(ctx context.Context, chatID int64) ([]*model.PinnedMessage, error) {
+	columns := make([]string, 0, len(messageColumns)+2)
+	for _, column := range messageColumns {
+		columns = append(columns, tableNameMessage+"."+column)
+	}
+	columns = append(columns, "p."+pinnedByColumn, "p."+pinnedAtColumn)
+
+	builderSelect := sq.Select(columns...).
+		From(tableNamePins+" p").
+		Join(tableNameMessage+" ON "+tableNameMessage+"."+idColumn+" = p."+messageIDColumn+
+			" AND "+tableNameMessage+"."+chatIDColumn+" = p."+chatIDColumn).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"p." + chatIDColumn: chatID}).
+		Where(notExpired(tableNameMessage)).
+		OrderBy("p."+pinnedAtColumn+" DESC", "p."+messageIDColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "pin_repository.List",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pins []*model.PinnedMessage
+	for rows.Next() {
+		var pin model.PinnedMessage
+		pin.Message, err = scanMessage(rows, &pin.PinnedBy, &pin.PinnedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		pins = append(pins, &pin)
+	}
+
+	return pins, rows.Err()
+}
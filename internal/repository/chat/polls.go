@@ -0,0 +1,267 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+// pollOptionTextsColumn collects the option texts of a poll in display order
+const pollOptionTextsColumn = "ARRAY(SELECT o." + textColumn + " FROM " + tableNamePollOption + " o" +
+	" WHERE o." + messageIDColumn + " = " + tableNamePolls + "." + messageIDColumn +
+	" AND o." + chatIDColumn + " = " + tableNamePolls + "." + chatIDColumn +
+	" ORDER BY o." + optionIndexColumn + ")"
+
+// CreatePoll stores the poll of the message with its options.
+// It must run inside a transaction.
+func (r *repo) CreatePoll(ctx context.Context, messageID string, create *model.PollCreate) error {
+	var closesAt *time.Time
+	if create.ClosesAt != nil {
+		utc := create.ClosesAt.UTC()
+		closesAt = &utc
+	}
+
+	builderInsert := sq.Insert(tableNamePolls).
+		PlaceholderFormat(sq.Dollar).
+		Columns(messageIDColumn, chatIDColumn, multiChoiceColumn, anonymousColumn, closesAtColumn).
+		Values(messageID, create.ChatID, create.MultiChoice, create.Anonymous, closesAt)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "poll_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	builderInsert = sq.Insert(tableNamePollOption).
+		PlaceholderFormat(sq.Dollar).
+		Columns(messageIDColumn, chatIDColumn, optionIndexColumn, textColumn)
+
+	for i, option := range create.Options {
+		builderInsert = builderInsert.Values(messageID, create.ChatID, i, option)
+	}
+
+	query, args, err = builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q = db.Query{
+		Name:     "poll_repository.CreateOptions",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+// GetPollForUpdate returns the poll with its options but without votes and
+// locks it until the surrounding transaction ends
+func (r *repo) GetPollForUpdate(ctx context.Context, chatID int64, messageID string) (*model.Poll, error) {
+	builderSelect := sq.Select(multiChoiceColumn, anonymousColumn, closesAtColumn, closedAtColumn, pollOptionTextsColumn).
+		From(tableNamePolls).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{messageIDColumn: messageID, chatIDColumn: chatID}).
+		Suffix("FOR UPDATE")
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "poll_repository.GetForUpdate",
+		QueryRaw: query,
+	}
+
+	poll := &model.Poll{
+		MessageID: messageID,
+		ChatID:    chatID,
+	}
+
+	var texts []string
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&poll.MultiChoice, &poll.Anonymous, &poll.ClosesAt, &poll.ClosedAt, &texts)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrPollNotFound
+		}
+		return nil, err
+	}
+
+	for i, text := range texts {
+		poll.Options = append(poll.Options, &model.PollOption{Index: int32(i), Text: text})
+	}
+
+	return poll, nil
+}
+
+// ReplacePollVotes swaps the user's previous choice for the new one.
+// It must run inside a transaction.
+func (r *repo) ReplacePollVotes(ctx context.Context, vote *model.PollVote) error {
+	builderDelete := sq.Delete(tableNamePollVotes).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{messageIDColumn: vote.MessageID, chatIDColumn: vote.ChatID, userIDColumn: vote.UserID})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "poll_repository.DeleteVotes",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil || len(vote.Options) == 0 {
+		return err
+	}
+
+	builderInsert := sq.Insert(tableNamePollVotes).
+		PlaceholderFormat(sq.Dollar).
+		Columns(messageIDColumn, chatIDColumn, optionIndexColumn, userIDColumn)
+
+	for _, option := range vote.Options {
+		builderInsert = builderInsert.Values(vote.MessageID, vote.ChatID, option, vote.UserID)
+	}
+
+	query, args, err = builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q = db.Query{
+		Name:     "poll_repository.AddVotes",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+// ClosePoll stops the poll from accepting votes, closing it again changes nothing
+func (r *repo) ClosePoll(ctx context.Context, chatID int64, messageID string) error {
+	builderUpdate := sq.Update(tableNamePolls).
+		PlaceholderFormat(sq.Dollar).
+		Set(closedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{messageIDColumn: messageID, chatIDColumn: chatID, closedAtColumn: nil})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "poll_repository.Close",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+// GetPolls returns the polls of the given messages with live tallies.
+// Voters are left out for anonymous polls.
+func (r *repo) GetPolls(ctx context.Context, chatID int64, messageIDs []string) (map[string]*model.Poll, error) {
+	polls := make(map[string]*model.Poll)
+	if len(messageIDs) == 0 {
+		return polls, nil
+	}
+
+	totalVoters := sq.Select("count(DISTINCT tv." + userIDColumn + ")").
+		From(tableNamePollVotes + " tv").
+		Where("tv." + messageIDColumn + " = p." + messageIDColumn).
+		Where("tv." + chatIDColumn + " = p." + chatIDColumn)
+
+	builderSelect := sq.Select(
+		"p."+messageIDColumn,
+		"p."+multiChoiceColumn,
+		"p."+anonymousColumn,
+		"p."+closesAtColumn,
+		"p."+closedAtColumn,
+		"o."+optionIndexColumn,
+		"o."+textColumn,
+		"count(v."+userIDColumn+")",
+		"array_remove(array_agg(v."+userIDColumn+" ORDER BY v."+createdAtColumn+", v."+userIDColumn+"), NULL)",
+	).
+		Column(sq.Expr("(?)", totalVoters)).
+		From(tableNamePolls+" p").
+		Join(tableNamePollOption+" o ON o."+messageIDColumn+" = p."+messageIDColumn+
+			" AND o."+chatIDColumn+" = p."+chatIDColumn).
+		LeftJoin(tableNamePollVotes+" v ON v."+messageIDColumn+" = o."+messageIDColumn+
+			" AND v."+chatIDColumn+" = o."+chatIDColumn+
+			" AND v."+optionIndexColumn+" = o."+optionIndexColumn).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"p." + chatIDColumn: chatID, "p." + messageIDColumn: messageIDs}).
+		GroupBy("p."+messageIDColumn, "p."+chatIDColumn, "o."+optionIndexColumn, "o."+textColumn).
+		OrderBy("p."+messageIDColumn, "o."+optionIndexColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "poll_repository.GetByMessages",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			messageID string
+			poll      model.Poll
+			option    model.PollOption
+		)
+		errScan := rows.Scan(
+			&messageID,
+			&poll.MultiChoice,
+			&poll.Anonymous,
+			&poll.ClosesAt,
+			&poll.ClosedAt,
+			&option.Index,
+			&option.Text,
+			&option.Votes,
+			&option.VoterIDs,
+			&poll.TotalVoters,
+		)
+		if errScan != nil {
+			return nil, errScan
+		}
+
+		existing, ok := polls[messageID]
+		if !ok {
+			poll.MessageID = messageID
+			poll.ChatID = chatID
+			existing = &poll
+			polls[messageID] = existing
+		}
+
+		if existing.Anonymous {
+			option.VoterIDs = nil
+		}
+
+		existing.Options = append(existing.Options, &option)
+	}
+
+	return polls, rows.Err()
+}
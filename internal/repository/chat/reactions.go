@@ -0,0 +1,102 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+)
+
+// AddReaction stores the reaction, reacting twice with the same emoji is a no-op
+func (r *repo) AddReaction(ctx context.Context, reaction *model.Reaction) error {
+	builderInsert := sq.Insert(tableNameReactions).
+		PlaceholderFormat(sq.Dollar).
+		Columns(messageIDColumn, chatIDColumn, userIDColumn, emojiColumn).
+		Values(reaction.MessageID, reaction.ChatID, reaction.UserID, reaction.Emoji).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "reaction_repository.Add",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+func (r *repo) RemoveReaction(ctx context.Context, reaction *model.Reaction) error {
+	builderDelete := sq.Delete(tableNameReactions).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{
+			messageIDColumn: reaction.MessageID,
+			chatIDColumn:    reaction.ChatID,
+			userIDColumn:    reaction.UserID,
+			emojiColumn:     reaction.Emoji,
+		})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "reaction_repository.Remove",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+// CountReactions aggregates the reactions of the given messages by emoji,
+// in the order the emojis were first used
+func (r *repo) CountReactions(ctx context.Context, chatID int64, messageIDs []string) (map[string][]*model.ReactionCount, error) {
+	counts := make(map[string][]*model.ReactionCount)
+	if len(messageIDs) == 0 {
+		return counts, nil
+	}
+
+	builderSelect := sq.Select(messageIDColumn, emojiColumn, "count(*)").
+		From(tableNameReactions).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID, messageIDColumn: messageIDs}).
+		GroupBy(messageIDColumn, emojiColumn).
+		OrderBy(messageIDColumn, "min("+createdAtColumn+")", emojiColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "reaction_repository.Count",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			messageID string
+			count     model.ReactionCount
+		)
+		errScan := rows.Scan(&messageID, &count.Emoji, &count.Count)
+		if errScan != nil {
+			return nil, errScan
+		}
+
+		counts[messageID] = append(counts[messageID], &count)
+	}
+
+	return counts, rows.Err()
+}
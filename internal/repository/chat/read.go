@@ -0,0 +1,82 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+// MarkRead moves the member's read position forward to seq.
+// The position never goes back and never passes the last message of the chat.
+func (r *repo) MarkRead(ctx context.Context, chatID, userID, seq int64) (*model.ReadMark, error) {
+	builderUpdate := sq.Update(tableNameMembers+" m").
+		PlaceholderFormat(sq.Dollar).
+		Set(lastReadSeqColumn, sq.Expr("GREATEST(m."+lastReadSeqColumn+", LEAST(?, c."+lastSeqColumn+"))", seq)).
+		From(tableName + " c").
+		Where("c." + idColumn + " = m." + chatIDColumn).
+		Where(sq.Eq{"m." + chatIDColumn: chatID, "m." + userIDColumn: userID}).
+		Suffix("RETURNING m." + lastReadSeqColumn + ", c." + lastSeqColumn + " - m." + lastReadSeqColumn)
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.MarkRead",
+		QueryRaw: query,
+	}
+
+	var mark model.ReadMark
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&mark.LastReadSeq, &mark.UnreadCount)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrMemberNotFound
+		}
+		return nil, err
+	}
+
+	return &mark, nil
+}
+
+func (r *repo) ListReadStates(ctx context.Context, chatID int64) ([]*model.ReadState, error) {
+	builderSelect := sq.Select(userIDColumn, lastReadSeqColumn).
+		From(tableNameMembers).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID}).
+		OrderBy(joinedAtColumn, userIDColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.ListReadStates",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var states []*model.ReadState
+	for rows.Next() {
+		var state model.ReadState
+		errScan := rows.Scan(&state.UserID, &state.LastReadSeq)
+		if errScan != nil {
+			return nil, errScan
+		}
+
+		states = append(states, &state)
+	}
+
+	return states, rows.Err()
+}
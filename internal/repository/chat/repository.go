@@ -6,15 +6,84 @@ import (
 )
 
 const (
-	tableName  = "chat"
-	idColumn   = "id"
-	nameColumn = "name"
-	userIDs    = "user_ids"
-
-	tableNameMessage = "message"
-	chatIDColumn     = "chat_id"
-	userIDColumn     = "user_id"
-	textColumn       = "text"
+	tableName            = "chats"
+	idColumn             = "id"
+	nameColumn           = "name"
+	createdAtColumn      = "created_at"
+	lastActivityAtColumn = "last_activity_at"
+	lastSeqColumn        = "last_seq"
+	messageTTLColumn     = "message_ttl_seconds"
+
+	tableNameMessage       = "message"
+	chatIDColumn           = "chat_id"
+	userIDColumn           = "user_id"
+	textColumn             = "text"
+	messageCreatedAtColumn = "created_at"
+	editedAtColumn         = "edited_at"
+	deletedAtColumn        = "deleted_at"
+	seqColumn              = "seq"
+	replyToIDColumn        = "reply_to_id"
+	replyCountColumn       = "reply_count"
+	systemEventColumn      = "system_event"
+	subjectIDColumn        = "subject_id"
+	textTSVColumn          = "text_tsv"
+	expiresAtColumn        = "expires_at"
+
+	forwardedFromUserIDColumn    = "forwarded_from_user_id"
+	forwardedFromChatIDColumn    = "forwarded_from_chat_id"
+	forwardedFromMessageIDColumn = "forwarded_from_message_id"
+
+	tableNameRevision = "message_revisions"
+	messageIDColumn   = "message_id"
+
+	tableNameHidden = "message_hidden"
+
+	tableNameReactions = "message_reactions"
+	emojiColumn        = "emoji"
+
+	tableNamePins  = "message_pins"
+	pinnedByColumn = "pinned_by"
+	pinnedAtColumn = "pinned_at"
+
+	tableNameMentions = "message_mentions"
+	startOffsetColumn = "start_offset"
+	lengthColumn      = "length"
+
+	tableNameAttachments = "message_attachments"
+	uploaderIDColumn     = "uploader_id"
+	fileNameColumn       = "file_name"
+	mimeTypeColumn       = "mime_type"
+	sizeColumn           = "size"
+	sha256Column         = "sha256"
+	storageKeyColumn     = "storage_key"
+
+	tableNameScheduled = "scheduled_messages"
+	sendAtColumn       = "send_at"
+	failureColumn      = "failure"
+	attemptsColumn     = "attempts"
+	retryAtColumn      = "retry_at"
+
+	tableNamePolls      = "polls"
+	multiChoiceColumn   = "multi_choice"
+	anonymousColumn     = "anonymous"
+	closesAtColumn      = "closes_at"
+	closedAtColumn      = "closed_at"
+	tableNamePollOption = "poll_options"
+	optionIndexColumn   = "option_index"
+	tableNamePollVotes  = "poll_votes"
+
+	tableNameInvites = "chat_invites"
+	tokenHashColumn  = "token_hash"
+	createdByColumn  = "created_by"
+	maxUsesColumn    = "max_uses"
+	usesColumn       = "uses"
+	revokedAtColumn  = "revoked_at"
+
+	tableNameMembers  = "chat_members"
+	joinedAtColumn    = "joined_at"
+	roleColumn        = "role"
+	usernameColumn    = "username"
+	lastReadSeqColumn = "last_read_seq"
 )
 
 type repo struct {
@@ -0,0 +1,66 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+)
+
+func (r *repo) CreateMessageRevision(ctx context.Context, revision *model.MessageRevision) error {
+	builderInsert := sq.Insert(tableNameRevision).
+		PlaceholderFormat(sq.Dollar).
+		Columns(messageIDColumn, chatIDColumn, textColumn).
+		Values(revision.MessageID, revision.ChatID, revision.Text)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "message_repository.CreateRevision",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+func (r *repo) ListMessageRevisions(ctx context.Context, chatID int64, messageID string) ([]*model.MessageRevision, error) {
+	builderSelect := sq.Select(messageIDColumn, chatIDColumn, textColumn, editedAtColumn).
+		From(tableNameRevision).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{messageIDColumn: messageID, chatIDColumn: chatID}).
+		OrderBy(idColumn + " ASC")
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "message_repository.ListRevisions",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []*model.MessageRevision
+	for rows.Next() {
+		var revision model.MessageRevision
+		err = rows.Scan(&revision.MessageID, &revision.ChatID, &revision.Text, &revision.EditedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		revisions = append(revisions, &revision)
+	}
+
+	return revisions, rows.Err()
+}
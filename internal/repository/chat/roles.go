@@ -0,0 +1,128 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+// GetMemberRole returns the role of the user in the chat.
+// ErrChatNotFound is returned when the chat does not exist and
+// ErrMemberNotFound when the user is not its member.
+func (r *repo) GetMemberRole(ctx context.Context, chatID, userID int64) (string, error) {
+	member := sq.Select("m." + roleColumn).
+		From(tableNameMembers + " m").
+		Where("m." + chatIDColumn + " = " + tableName + "." + idColumn).
+		Where(sq.Eq{"m." + userIDColumn: userID})
+
+	builderSelect := sq.Select().
+		Column(sq.Expr("(?)", member)).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: chatID})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.GetMemberRole",
+		QueryRaw: query,
+	}
+
+	var role *string
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", repository.ErrChatNotFound
+		}
+		return "", err
+	}
+
+	if role == nil {
+		return "", repository.ErrMemberNotFound
+	}
+
+	return *role, nil
+}
+
+// GetMemberRoles maps the given users to their roles in the chat.
+// Users who are not members are left out of the result.
+func (r *repo) GetMemberRoles(ctx context.Context, chatID int64, userIDs []int64) (map[int64]string, error) {
+	roles := make(map[int64]string, len(userIDs))
+	if len(userIDs) == 0 {
+		return roles, nil
+	}
+
+	builderSelect := sq.Select(userIDColumn, roleColumn).
+		From(tableNameMembers).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID, userIDColumn: userIDs})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.GetMemberRoles",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id   int64
+			role string
+		)
+		errScan := rows.Scan(&id, &role)
+		if errScan != nil {
+			return nil, errScan
+		}
+
+		roles[id] = role
+	}
+
+	return roles, rows.Err()
+}
+
+// SetMemberRole changes the role of a chat member.
+// A chat has a single owner, so the current one must be demoted before
+// another member is made the owner.
+func (r *repo) SetMemberRole(ctx context.Context, chatID, userID int64, role string) error {
+	builderUpdate := sq.Update(tableNameMembers).
+		PlaceholderFormat(sq.Dollar).
+		Set(roleColumn, role).
+		Where(sq.Eq{chatIDColumn: chatID, userIDColumn: userID})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.SetMemberRole",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return repository.ErrMemberNotFound
+	}
+
+	return nil
+}
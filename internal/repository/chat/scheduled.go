@@ -0,0 +1,215 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+// scheduledColumns lists the columns read by scanScheduled, in order
+var scheduledColumns = []string{
+	idColumn,
+	chatIDColumn,
+	userIDColumn,
+	textColumn,
+	sendAtColumn,
+	createdAtColumn,
+	failureColumn,
+	attemptsColumn,
+}
+
+func scanScheduled(row pgx.Row) (*model.ScheduledMessage, error) {
+	var (
+		scheduled model.ScheduledMessage
+		failure   *string
+	)
+
+	err := row.Scan(
+		&scheduled.ID,
+		&scheduled.Info.ChatID,
+		&scheduled.Info.UserID,
+		&scheduled.Info.Text,
+		&scheduled.SendAt,
+		&scheduled.CreatedAt,
+		&failure,
+		&scheduled.Attempts,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if failure != nil {
+		scheduled.Failure = *failure
+	}
+
+	return &scheduled, nil
+}
+
+func (r *repo) CreateScheduledMessage(ctx context.Context, scheduled *model.ScheduledMessage) (*model.ScheduledMessage, error) {
+	builderInsert := sq.Insert(tableNameScheduled).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, userIDColumn, textColumn, sendAtColumn).
+		Values(scheduled.Info.ChatID, scheduled.Info.UserID, scheduled.Info.Text, scheduled.SendAt.UTC()).
+		Suffix("RETURNING " + strings.Join(scheduledColumns, ", "))
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "scheduled_repository.Create",
+		QueryRaw: query,
+	}
+
+	return scanScheduled(r.db.DB().QueryRowContext(ctx, q, args...))
+}
+
+// ListScheduledMessages returns the user's scheduled messages of the chat that
+// were not delivered yet, the ones due first come first
+func (r *repo) ListScheduledMessages(ctx context.Context, chatID, userID int64) ([]*model.ScheduledMessage, error) {
+	builderSelect := sq.Select(scheduledColumns...).
+		From(tableNameScheduled).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID, userIDColumn: userID}).
+		OrderBy(sendAtColumn, idColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "scheduled_repository.List",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var scheduled []*model.ScheduledMessage
+	for rows.Next() {
+		s, errScan := scanScheduled(rows)
+		if errScan != nil {
+			return nil, errScan
+		}
+
+		scheduled = append(scheduled, s)
+	}
+
+	return scheduled, rows.Err()
+}
+
+// DeleteScheduledMessage removes the user's scheduled message and reports whether it existed
+func (r *repo) DeleteScheduledMessage(ctx context.Context, chatID int64, scheduledID string, userID int64) (bool, error) {
+	builderDelete := sq.Delete(tableNameScheduled).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: scheduledID, chatIDColumn: chatID, userIDColumn: userID})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "scheduled_repository.Delete",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// ClaimDueScheduledMessage locks the pending scheduled message that is due the
+// longest, leaving out the ones whose retry is not due yet. Rows locked by other
+// transactions are skipped, so concurrent dispatchers never pick the same
+// message. It must run inside a transaction.
+func (r *repo) ClaimDueScheduledMessage(ctx context.Context, now time.Time) (*model.ScheduledMessage, error) {
+	builderSelect := sq.Select(scheduledColumns...).
+		From(tableNameScheduled).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.LtOrEq{sendAtColumn: now.UTC()}).
+		Where(sq.Eq{failureColumn: nil}).
+		Where(sq.Or{sq.Eq{retryAtColumn: nil}, sq.LtOrEq{retryAtColumn: now.UTC()}}).
+		OrderBy(sendAtColumn, idColumn).
+		Limit(1).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "scheduled_repository.ClaimDue",
+		QueryRaw: query,
+	}
+
+	scheduled, err := scanScheduled(r.db.DB().QueryRowContext(ctx, q, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrScheduledMessageNotFound
+		}
+		return nil, err
+	}
+
+	return scheduled, nil
+}
+
+// FailScheduledMessage gives up the delivery of a pending scheduled message
+func (r *repo) FailScheduledMessage(ctx context.Context, scheduledID, failure string) error {
+	builderUpdate := sq.Update(tableNameScheduled).
+		PlaceholderFormat(sq.Dollar).
+		Set(failureColumn, failure).
+		Where(sq.Eq{idColumn: scheduledID, failureColumn: nil})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "scheduled_repository.Fail",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+// RetryScheduledMessage counts a failed delivery attempt of a pending scheduled
+// message and postpones the next one to retryAt
+func (r *repo) RetryScheduledMessage(ctx context.Context, scheduledID string, retryAt time.Time) error {
+	builderUpdate := sq.Update(tableNameScheduled).
+		PlaceholderFormat(sq.Dollar).
+		Set(attemptsColumn, sq.Expr(attemptsColumn+" + 1")).
+		Set(retryAtColumn, retryAt.UTC()).
+		Where(sq.Eq{idColumn: scheduledID, failureColumn: nil})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "scheduled_repository.Retry",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
@@ -0,0 +1,95 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+)
+
+const (
+	// searchConfig has to match the configuration text_tsv is built with
+	searchConfig = "'simple'"
+	// headlineOptions delimit the matches with plain characters, the text is
+	// not escaped so the snippet is turned into HTML by the service
+	headlineOptions = `StartSel="` + model.SnippetMatchStart + `", StopSel="` + model.SnippetMatchStop + `", MaxWords=35, MinWords=15, MaxFragments=2`
+
+	searchRankAlias = "search_rank"
+)
+
+// SearchMessages returns the messages matching the query, best matches first.
+// Only chats the user is a member of are searched; deleted, hidden, expired and
+// system messages are skipped.
+func (r *repo) SearchMessages(ctx context.Context, query *model.SearchQuery, offset, limit uint64) ([]*model.SearchResult, error) {
+	tsQuery := "websearch_to_tsquery(" + searchConfig + ", ?)"
+
+	member := sq.Select("1").
+		From(tableNameMembers + " m").
+		Where("m." + chatIDColumn + " = " + tableNameMessage + "." + chatIDColumn).
+		Where(sq.Eq{"m." + userIDColumn: query.UserID})
+
+	hidden := sq.Select("1").
+		From(tableNameHidden + " h").
+		Where("h." + messageIDColumn + " = " + tableNameMessage + "." + idColumn).
+		Where("h." + chatIDColumn + " = " + tableNameMessage + "." + chatIDColumn).
+		Where(sq.Eq{"h." + userIDColumn: query.UserID})
+
+	builderSelect := sq.Select(messageColumns...).
+		Column(sq.Expr("ts_headline("+searchConfig+", "+textColumn+", "+tsQuery+", ?)", query.Query, headlineOptions)).
+		Column(sq.Alias(sq.Expr("ts_rank("+textTSVColumn+", "+tsQuery+")", query.Query), searchRankAlias)).
+		From(tableNameMessage).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Expr(textTSVColumn+" @@ "+tsQuery, query.Query)).
+		Where(sq.Eq{deletedAtColumn: nil, systemEventColumn: nil}).
+		Where(sq.Expr("EXISTS (?)", member)).
+		Where(sq.Expr("NOT EXISTS (?)", hidden)).
+		Where(notExpired(tableNameMessage)).
+		// ties are broken by recency so that pages stay stable
+		OrderBy(searchRankAlias+" DESC", messageCreatedAtColumn+" DESC", idColumn+" DESC").
+		Offset(offset).
+		Limit(limit)
+
+	if query.ChatID != 0 {
+		builderSelect = builderSelect.Where(sq.Eq{chatIDColumn: query.ChatID})
+	}
+	if query.SenderID != 0 {
+		builderSelect = builderSelect.Where(sq.Eq{userIDColumn: query.SenderID})
+	}
+	if query.From != nil {
+		builderSelect = builderSelect.Where(sq.GtOrEq{messageCreatedAtColumn: *query.From})
+	}
+	if query.To != nil {
+		builderSelect = builderSelect.Where(sq.Lt{messageCreatedAtColumn: *query.To})
+	}
+
+	sqlQuery, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "message_repository.Search",
+		QueryRaw: sqlQuery,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := make([]*model.SearchResult, 0, limit)
+	for rows.Next() {
+		var result model.SearchResult
+
+		result.Message, err = scanMessage(rows, &result.Snippet, &result.Rank)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, &result)
+	}
+
+	return results, rows.Err()
+}
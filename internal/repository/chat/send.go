@@ -2,22 +2,76 @@ package chat
 
 import (
 	"context"
+	"time"
 
 	"github.com/BelyaevEI/microservices_chat/internal/model"
 	"github.com/BelyaevEI/platform_common/pkg/db"
 	sq "github.com/Masterminds/squirrel"
 )
 
-func (r *repo) SendMessage(ctx context.Context, createMessage *model.MessageCreate) (string, error) {
+// SendMessage stores the message. A reply also bumps the reply counter of its
+// thread root, so it has to run inside a transaction.
+func (r *repo) SendMessage(ctx context.Context, createMessage *model.MessageCreate, seq int64) (*model.Message, error) {
+	var (
+		forwardedFromUserID    *int64
+		forwardedFromChatID    *int64
+		forwardedFromMessageID *string
+	)
+	if origin := createMessage.ForwardedFrom; origin != nil {
+		forwardedFromUserID = &origin.UserID
+		forwardedFromChatID = &origin.ChatID
+		forwardedFromMessageID = &origin.MessageID
+	}
+
+	var ttlSeconds *int64
+	if createMessage.TTL > 0 {
+		seconds := int64(createMessage.TTL / time.Second)
+		ttlSeconds = &seconds
+	}
+
+	// the message's own time-to-live wins over the chat's one, and a reply
+	// never outlives its thread root; LEAST ignores a root without expiry
+	expiresAt := sq.Expr(
+		"LEAST(now() + make_interval(secs => COALESCE(?::bigint, ("+
+			"SELECT "+messageTTLColumn+" FROM "+tableName+" WHERE "+idColumn+" = ?))), ("+
+			"SELECT "+expiresAtColumn+" FROM "+tableNameMessage+
+			" WHERE "+idColumn+" = NULLIF(?, '')::uuid AND "+chatIDColumn+" = ?))",
+		ttlSeconds, createMessage.Info.ChatID, createMessage.ReplyToID, createMessage.Info.ChatID,
+	)
+
 	builderInsert := sq.Insert(tableNameMessage).
 		PlaceholderFormat(sq.Dollar).
-		Columns(chatIDColumn, userIDColumn, textColumn).
-		Values(createMessage.Info.ChatID, createMessage.Info.UserID, createMessage.Info.Text).
-		Suffix("RETURNING id")
+		Columns(
+			chatIDColumn,
+			seqColumn,
+			userIDColumn,
+			textColumn,
+			replyToIDColumn,
+			systemEventColumn,
+			subjectIDColumn,
+			forwardedFromUserIDColumn,
+			forwardedFromChatIDColumn,
+			forwardedFromMessageIDColumn,
+			expiresAtColumn,
+		).
+		Values(
+			createMessage.Info.ChatID,
+			seq,
+			createMessage.Info.UserID,
+			createMessage.Info.Text,
+			sq.Expr("NULLIF(?, '')::uuid", createMessage.ReplyToID),
+			sq.Expr("NULLIF(?, '')", createMessage.SystemEvent),
+			sq.Expr("NULLIF(?, '')::uuid", createMessage.SubjectID),
+			forwardedFromUserID,
+			forwardedFromChatID,
+			forwardedFromMessageID,
+			expiresAt,
+		).
+		Suffix("RETURNING " + idColumn + ", " + messageCreatedAtColumn + ", " + expiresAtColumn)
 
 	query, args, err := builderInsert.ToSql()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	q := db.Query{
@@ -25,11 +79,43 @@ func (r *repo) SendMessage(ctx context.Context, createMessage *model.MessageCrea
 		QueryRaw: query,
 	}
 
-	var id string
-	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id)
+	message := &model.Message{
+		Seq:         seq,
+		Info:        createMessage.Info,
+		ReplyToID:   createMessage.ReplyToID,
+		SystemEvent: createMessage.SystemEvent,
+		SubjectID:   createMessage.SubjectID,
+
+		ForwardedFrom: createMessage.ForwardedFrom,
+	}
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&message.ID, &message.CreatedAt, &message.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if createMessage.ReplyToID == "" {
+		return message, nil
+	}
+
+	builderUpdate := sq.Update(tableNameMessage).
+		PlaceholderFormat(sq.Dollar).
+		Set(replyCountColumn, sq.Expr(replyCountColumn+" + 1")).
+		Where(sq.Eq{idColumn: createMessage.ReplyToID, chatIDColumn: createMessage.Info.ChatID})
+
+	query, args, err = builderUpdate.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q = db.Query{
+		Name:     "message_repository.IncrementReplyCount",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return id, nil
+	return message, nil
 }
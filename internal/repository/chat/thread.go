@@ -0,0 +1,60 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+)
+
+// ListThread returns the replies to the root message in ascending order,
+// skipping the ones the user deleted for themselves.
+func (r *repo) ListThread(ctx context.Context, chatID int64, rootID string, userID int64, after *model.MessageCursor, limit uint64) ([]*model.Message, error) {
+	hidden := sq.Select("1").
+		From(tableNameHidden + " h").
+		Where("h." + messageIDColumn + " = " + tableNameMessage + "." + idColumn).
+		Where("h." + chatIDColumn + " = " + tableNameMessage + "." + chatIDColumn).
+		Where(sq.Eq{"h." + userIDColumn: userID})
+
+	builderSelect := sq.Select(messageColumns...).
+		From(tableNameMessage).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID, replyToIDColumn: rootID}).
+		Where(sq.Expr("NOT EXISTS (?)", hidden)).
+		Where(notExpired(tableNameMessage)).
+		OrderBy(seqColumn + " ASC").
+		Limit(limit)
+
+	if after != nil {
+		builderSelect = builderSelect.Where(sq.Gt{seqColumn: after.Seq})
+	}
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "message_repository.ListThread",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := make([]*model.Message, 0, limit)
+	for rows.Next() {
+		message, errScan := scanMessage(rows)
+		if errScan != nil {
+			return nil, errScan
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages, rows.Err()
+}
@@ -0,0 +1,141 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+)
+
+// SetMessageTTL sets the time-to-live of messages sent to the chat from now on,
+// zero keeps them forever
+func (r *repo) SetMessageTTL(ctx context.Context, chatID int64, ttl time.Duration) error {
+	var ttlSeconds *int64
+	if ttl > 0 {
+		seconds := int64(ttl / time.Second)
+		ttlSeconds = &seconds
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(messageTTLColumn, ttlSeconds).
+		Where(sq.Eq{idColumn: chatID})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.SetMessageTTL",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return repository.ErrChatNotFound
+	}
+
+	return nil
+}
+
+// DeleteExpiredMessages purges up to limit messages whose time-to-live is over
+// and returns them with the storage keys of the attachments removed along,
+// including those of replies taken by the cascade. Replies go before their
+// root when both expire together, so that the cascade does not remove them
+// unnoticed. Rows locked by other transactions are left for the next run.
+func (r *repo) DeleteExpiredMessages(ctx context.Context, limit uint64) ([]*model.Message, []string, error) {
+	expired := sq.Select(idColumn, chatIDColumn).
+		From(tableNameMessage).
+		Where(expiresAtColumn+" <= now()").
+		OrderBy(expiresAtColumn, replyToIDColumn+" IS NULL").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	// the statement still sees the attachments its cascade removes
+	purgedMessages := sq.Select(idColumn).
+		From(tableNameMessage + " AS purged").
+		Where("purged." + chatIDColumn + " = " + tableNameMessage + "." + chatIDColumn).
+		Where("(purged." + idColumn + " = " + tableNameMessage + "." + idColumn +
+			" OR purged." + replyToIDColumn + " = " + tableNameMessage + "." + idColumn + ")")
+
+	storageKeys := sq.Select(storageKeyColumn).
+		From(tableNameAttachments).
+		Where(tableNameAttachments + "." + chatIDColumn + " = " + tableNameMessage + "." + chatIDColumn).
+		Where(sq.Expr(tableNameAttachments+"."+messageIDColumn+" IN (?)", purgedMessages))
+
+	storageKeysQuery, _, err := storageKeys.ToSql()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	builderDelete := sq.Delete(tableNameMessage).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Expr("("+idColumn+", "+chatIDColumn+") IN (?)", expired)).
+		Suffix(returningMessage() + ", ARRAY(" + storageKeysQuery + ")")
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := db.Query{
+		Name:     "message_repository.DeleteExpired",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var (
+		messages []*model.Message
+		keys     []string
+	)
+	for rows.Next() {
+		var messageKeys []string
+		message, errScan := scanMessage(rows, &messageKeys)
+		if errScan != nil {
+			return nil, nil, errScan
+		}
+
+		messages = append(messages, message)
+		keys = append(keys, messageKeys...)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return messages, keys, nil
+}
+
+// DecrementReplyCount takes purged replies off the reply counter of their thread root
+func (r *repo) DecrementReplyCount(ctx context.Context, chatID int64, rootID string, count int64) error {
+	builderUpdate := sq.Update(tableNameMessage).
+		PlaceholderFormat(sq.Dollar).
+		Set(replyCountColumn, sq.Expr("GREATEST("+replyCountColumn+" - ?, 0)", count)).
+		Where(sq.Eq{idColumn: rootID, chatIDColumn: chatID})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "message_repository.DecrementReplyCount",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
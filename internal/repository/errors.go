@@ -0,0 +1,20 @@
+package repository
+
+import "errors"
+
+var (
+	// ErrChatNotFound is returned when the requested chat does not exist.
+	ErrChatNotFound = errors.New("chat not found")
+	// ErrMessageNotFound is returned when the requested message does not exist in the chat.
+	ErrMessageNotFound = errors.New("message not found")
+	// ErrMemberNotFound is returned when the user is not a member of the chat.
+	ErrMemberNotFound = errors.New("member not found")
+	// ErrAttachmentNotFound is returned when the requested attachment does not exist.
+	ErrAttachmentNotFound = errors.New("attachment not found")
+	// ErrScheduledMessageNotFound is returned when the requested scheduled message does not exist.
+	ErrScheduledMessageNotFound = errors.New("scheduled message not found")
+	// ErrPollNotFound is returned when the requested message has no poll.
+	ErrPollNotFound = errors.New("poll not found")
+	// ErrInviteLinkNotFound is returned when the requested invite link does not exist.
+	ErrInviteLinkNotFound = errors.New("invite link not found")
+)
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/BelyaevEI/microservices_chat/internal/model"
 )
@@ -9,6 +10,71 @@ import (
 // ChatRepository represents a chat repository.
 type ChatRepository interface {
 	CreateChat(ctx context.Context, createChat *model.ChatCreate) (int64, error)
-	SendMessage(ctx context.Context, createMessage *model.MessageCreate) (string, error)
+	SendMessage(ctx context.Context, createMessage *model.MessageCreate, seq int64) (*model.Message, error)
 	DeleteChat(ctx context.Context, id int64) error
+	RenameChat(ctx context.Context, chatID int64, name string) error
+	GetChat(ctx context.Context, id int64) (*model.Chat, error)
+	ListChats(ctx context.Context, userID int64, cursor *model.ChatCursor, limit uint64) ([]*model.Chat, error)
+	UpdateLastActivity(ctx context.Context, chatID int64) error
+	SetMessageTTL(ctx context.Context, chatID int64, ttl time.Duration) error
+	NextMessageSeq(ctx context.Context, chatID int64) (int64, error)
+	ListMessages(ctx context.Context, chatID, userID int64, before, after *model.MessageCursor, limit uint64) ([]*model.Message, error)
+	ListThread(ctx context.Context, chatID int64, rootID string, userID int64, after *model.MessageCursor, limit uint64) ([]*model.Message, error)
+	SearchMessages(ctx context.Context, query *model.SearchQuery, offset, limit uint64) ([]*model.SearchResult, error)
+	GetMessage(ctx context.Context, chatID int64, messageID string) (*model.Message, error)
+	GetMessageForUpdate(ctx context.Context, chatID int64, messageID string) (*model.Message, error)
+	UpdateMessageText(ctx context.Context, chatID int64, messageID, text string) (*model.Message, error)
+	CreateMessageRevision(ctx context.Context, revision *model.MessageRevision) error
+	ListMessageRevisions(ctx context.Context, chatID int64, messageID string) ([]*model.MessageRevision, error)
+	DeleteMessageForEveryone(ctx context.Context, chatID int64, messageID string) (*model.Message, error)
+	DeleteMessageRevisions(ctx context.Context, chatID int64, messageID string) error
+	DeleteExpiredMessages(ctx context.Context, limit uint64) ([]*model.Message, []string, error)
+	DecrementReplyCount(ctx context.Context, chatID int64, rootID string, count int64) error
+	HideMessage(ctx context.Context, chatID int64, messageID string, userID int64) error
+	ListHiddenFor(ctx context.Context, chatID int64, messageID string) ([]int64, error)
+	AddReaction(ctx context.Context, reaction *model.Reaction) error
+	RemoveReaction(ctx context.Context, reaction *model.Reaction) error
+	CountReactions(ctx context.Context, chatID int64, messageIDs []string) (map[string][]*model.ReactionCount, error)
+	AddMentions(ctx context.Context, chatID int64, messageID string, mentions []*model.MessageMention) error
+	DeleteMentions(ctx context.Context, chatID int64, messageID string) error
+	GetMentions(ctx context.Context, chatID int64, messageIDs []string) (map[string][]*model.MessageMention, error)
+	ListMentions(ctx context.Context, userID int64, cursor *model.MentionCursor, limit uint64) ([]*model.Message, error)
+	CreateAttachment(ctx context.Context, attachment *model.Attachment) (*model.Attachment, error)
+	GetAttachment(ctx context.Context, attachmentID string) (*model.Attachment, error)
+	LinkAttachments(ctx context.Context, chatID int64, messageID string, uploaderID int64, attachmentIDs []string) ([]*model.Attachment, error)
+	CopyAttachments(ctx context.Context, source, target *model.Message) ([]*model.Attachment, error)
+	GetAttachments(ctx context.Context, chatID int64, messageIDs []string) (map[string][]*model.Attachment, error)
+	DeleteMessageAttachments(ctx context.Context, chatID int64, messageID string) ([]string, error)
+	DeleteStaleUploads(ctx context.Context, uploadedBefore time.Time, limit uint64) ([]string, error)
+	GetChatStorageKeys(ctx context.Context, chatID int64) ([]string, error)
+	GetUsedStorageKeys(ctx context.Context, keys []string) ([]string, error)
+	CreatePoll(ctx context.Context, messageID string, create *model.PollCreate) error
+	GetPollForUpdate(ctx context.Context, chatID int64, messageID string) (*model.Poll, error)
+	ReplacePollVotes(ctx context.Context, vote *model.PollVote) error
+	ClosePoll(ctx context.Context, chatID int64, messageID string) error
+	GetPolls(ctx context.Context, chatID int64, messageIDs []string) (map[string]*model.Poll, error)
+	CountPins(ctx context.Context, chatID int64) (int, error)
+	PinMessage(ctx context.Context, chatID int64, messageID string, userID int64) (bool, error)
+	UnpinMessage(ctx context.Context, chatID int64, messageID string) (bool, error)
+	ListPinned(ctx context.Context, chatID int64) ([]*model.PinnedMessage, error)
+	CreateScheduledMessage(ctx context.Context, scheduled *model.ScheduledMessage) (*model.ScheduledMessage, error)
+	ListScheduledMessages(ctx context.Context, chatID, userID int64) ([]*model.ScheduledMessage, error)
+	DeleteScheduledMessage(ctx context.Context, chatID int64, scheduledID string, userID int64) (bool, error)
+	ClaimDueScheduledMessage(ctx context.Context, now time.Time) (*model.ScheduledMessage, error)
+	FailScheduledMessage(ctx context.Context, scheduledID, failure string) error
+	RetryScheduledMessage(ctx context.Context, scheduledID string, retryAt time.Time) error
+	LockChat(ctx context.Context, chatID int64) error
+	AddMembers(ctx context.Context, chatID int64, members []*model.ChatMember) error
+	RemoveMembers(ctx context.Context, chatID int64, userIDs []int64) (int64, error)
+	IsMember(ctx context.Context, chatID, userID int64) (bool, error)
+	GetMemberRole(ctx context.Context, chatID, userID int64) (string, error)
+	GetMemberRoles(ctx context.Context, chatID int64, userIDs []int64) (map[int64]string, error)
+	SetMemberRole(ctx context.Context, chatID, userID int64, role string) error
+	CreateInviteLink(ctx context.Context, create *model.InviteLinkCreate) (*model.InviteLink, error)
+	RevokeInviteLink(ctx context.Context, chatID int64, inviteID string) error
+	GetInviteLinkForUpdate(ctx context.Context, tokenHash string) (*model.InviteLink, error)
+	CountInviteUse(ctx context.Context, inviteID string) error
+	ListContacts(ctx context.Context, userID int64) ([]int64, error)
+	MarkRead(ctx context.Context, chatID, userID, seq int64) (*model.ReadMark, error)
+	ListReadStates(ctx context.Context, chatID int64) ([]*model.ReadState, error)
 }
@@ -0,0 +1,165 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxDeliveryAttempts bounds how often a message failing for a transient
+	// reason is retried before it is marked as failed
+	maxDeliveryAttempts = 10
+	// maxRetryDelay caps the delay between two attempts
+	maxRetryDelay = time.Hour
+)
+
+// Dispatcher sends scheduled messages once they are due.
+//
+// A message is stored through ChatService.StoreMessage inside the transaction
+// that claims and removes its scheduled row, so it lands in the chat history
+// exactly once even with several dispatchers running or a crash in between.
+// It is published to subscribers only after that transaction commits, a crash
+// right after the commit leaves it in the history without a live delivery.
+// Messages that came due while the app was down are sent on start.
+type Dispatcher struct {
+	*worker
+
+	chatRepository repository.ChatRepository
+	txManager      db.TxManager
+	chatService    service.ChatService
+}
+
+// NewDispatcher creates a dispatcher looking for due messages every interval.
+// It does nothing until Run is called.
+func NewDispatcher(
+	chatRepository repository.ChatRepository,
+	txManager db.TxManager,
+	chatService service.ChatService,
+	interval time.Duration,
+) *Dispatcher {
+	return &Dispatcher{
+		worker:         newWorker(interval),
+		chatRepository: chatRepository,
+		txManager:      txManager,
+		chatService:    chatService,
+	}
+}
+
+// Run delivers due messages until Close is called. A delivery in progress
+// when the dispatcher is closed is rolled back and retried on the next start.
+func (d *Dispatcher) Run() {
+	d.run(d.dispatch)
+}
+
+// dispatch sends every message that is due by now
+func (d *Dispatcher) dispatch(ctx context.Context) {
+	for ctx.Err() == nil {
+		delivered, err := d.deliverNext(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("failed to deliver scheduled message: %v", err)
+			}
+			return
+		}
+
+		if !delivered {
+			return
+		}
+	}
+}
+
+// deliverNext sends the message that is due the longest and reports whether
+// there was one. Messages StoreMessage refuses are marked as failed instead of
+// being retried forever, any other error postpones the message so it does not
+// hold back the ones due after it.
+func (d *Dispatcher) deliverNext(ctx context.Context) (bool, error) {
+	var (
+		scheduled *model.ScheduledMessage
+		message   *model.Message
+		errSend   error
+	)
+
+	err := d.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		scheduled, errTx = d.chatRepository.ClaimDueScheduledMessage(ctx, time.Now())
+		if errTx != nil {
+			return errTx
+		}
+
+		_, errTx = d.chatRepository.DeleteScheduledMessage(ctx, scheduled.Info.ChatID, scheduled.ID, scheduled.Info.UserID)
+		if errTx != nil {
+			return errTx
+		}
+
+		// StoreMessage joins the transaction carried by ctx
+		message, errSend = d.chatService.StoreMessage(auth.WithUserID(ctx, scheduled.Info.UserID), &model.MessageCreate{
+			Info: model.MessageInfo{
+				ChatID: scheduled.Info.ChatID,
+				Text:   scheduled.Info.Text,
+			},
+		})
+		return errSend
+	})
+	if err == nil {
+		d.chatService.PublishMessage(message)
+		return true, nil
+	}
+
+	if errors.Is(err, repository.ErrScheduledMessageNotFound) {
+		return false, nil
+	}
+
+	// nothing was claimed or the dispatcher is closing, the message stays due
+	if scheduled == nil || ctx.Err() != nil {
+		return false, err
+	}
+
+	// StoreMessage reports refusals as statuses and storage failures as is
+	st, refused := status.FromError(errSend)
+	if errSend == nil || !refused {
+		return d.retry(ctx, scheduled, err)
+	}
+
+	log.Printf("scheduled message %s can't be delivered: %s", scheduled.ID, st.Message())
+
+	err = d.chatRepository.FailScheduledMessage(ctx, scheduled.ID, st.Message())
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// retry postpones a message whose delivery failed for a transient reason. The
+// delay doubles with every attempt and the message is marked as failed once
+// it ran out of attempts.
+func (d *Dispatcher) retry(ctx context.Context, scheduled *model.ScheduledMessage, cause error) (bool, error) {
+	log.Printf("failed to deliver scheduled message %s: %v", scheduled.ID, cause)
+
+	if scheduled.Attempts+1 >= maxDeliveryAttempts {
+		err := d.chatRepository.FailScheduledMessage(ctx, scheduled.ID, "message could not be delivered")
+		if err != nil {
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	delay := min(d.interval<<scheduled.Attempts, maxRetryDelay)
+
+	err := d.chatRepository.RetryScheduledMessage(ctx, scheduled.ID, time.Now().Add(delay))
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
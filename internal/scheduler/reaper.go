@@ -0,0 +1,67 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+)
+
+// reapBatchSize bounds how many messages or uploads one transaction purges
+const reapBatchSize = 500
+
+// Reaper purges messages whose time-to-live is over and uploads that were
+// never sent. Reads skip expired messages on their own, so the reaper only has
+// to catch up eventually.
+type Reaper struct {
+	*worker
+
+	chatService service.ChatService
+	uploadTTL   time.Duration
+}
+
+// NewReaper creates a reaper looking for expired messages and uploads older
+// than uploadTTL every interval. It does nothing until Run is called.
+func NewReaper(chatService service.ChatService, interval, uploadTTL time.Duration) *Reaper {
+	return &Reaper{
+		worker:      newWorker(interval),
+		chatService: chatService,
+		uploadTTL:   uploadTTL,
+	}
+}
+
+// Run purges expired messages and stale uploads until Close is called.
+func (r *Reaper) Run() {
+	r.run(r.reap)
+}
+
+// reap purges every message that is expired by now and every upload that
+// was not sent in time
+func (r *Reaper) reap(ctx context.Context) {
+	drain(ctx, "expired messages", func(ctx context.Context) (int, error) {
+		return r.chatService.PurgeExpiredMessages(ctx, reapBatchSize)
+	})
+
+	uploadedBefore := time.Now().Add(-r.uploadTTL)
+	drain(ctx, "stale uploads", func(ctx context.Context) (int, error) {
+		return r.chatService.PurgeStaleUploads(ctx, uploadedBefore, reapBatchSize)
+	})
+}
+
+// drain repeats purge until it removes less than a full batch
+func drain(ctx context.Context, what string, purge func(ctx context.Context) (int, error)) {
+	for ctx.Err() == nil {
+		purged, err := purge(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("failed to purge %s: %v", what, err)
+			}
+			return
+		}
+
+		if purged < reapBatchSize {
+			return
+		}
+	}
+}
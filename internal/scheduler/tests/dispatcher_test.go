@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	repositoryMocks "github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/BelyaevEI/microservices_chat/internal/scheduler"
+	serviceMocks "github.com/BelyaevEI/microservices_chat/internal/service/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// claimResult is what one ClaimDueScheduledMessage call returns
+type claimResult struct {
+	scheduled *model.ScheduledMessage
+	err       error
+}
+
+func TestDispatcher(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID      = gofakeit.Int64()
+		userID      = gofakeit.Int64()
+		scheduledID = gofakeit.UUID()
+		messageID   = gofakeit.UUID()
+		text        = gofakeit.Sentence(5)
+
+		info    = model.MessageInfo{ChatID: chatID, UserID: userID, Text: text}
+		message = &model.Message{ID: messageID, Info: info}
+
+		nothingDue = claimResult{err: repository.ErrScheduledMessageNotFound}
+		due        = claimResult{scheduled: &model.ScheduledMessage{ID: scheduledID, Info: info}}
+		retried    = claimResult{scheduled: &model.ScheduledMessage{ID: scheduledID, Info: info, Attempts: 2}}
+		exhausted  = claimResult{scheduled: &model.ScheduledMessage{ID: scheduledID, Info: info, Attempts: 9}}
+
+		refusal = status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID)
+
+		claimed   = "claim in tx true"
+		deleted   = fmt.Sprintf("delete %s of user %d in chat %d in tx true", scheduledID, userID, chatID)
+		stored    = fmt.Sprintf("store %q as user %d in chat %d in tx true", text, userID, chatID)
+		published = "publish " + messageID
+	)
+
+	tests := []struct {
+		name    string
+		claims  []claimResult
+		sent    *model.Message
+		sendErr error
+		want    []string
+	}{
+		{
+			name:   "success case",
+			claims: []claimResult{due, nothingDue},
+			sent:   message,
+			want: []string{
+				"begin", claimed, deleted, stored, "commit", published,
+				"begin", claimed, "rollback",
+			},
+		},
+		{
+			name:   "nothing due case",
+			claims: []claimResult{nothingDue},
+			want:   []string{"begin", claimed, "rollback"},
+		},
+		{
+			// the dispatcher gives up until the next tick
+			name:   "claim error case",
+			claims: []claimResult{{err: errors.New("connection refused")}},
+			want:   []string{"begin", claimed, "rollback"},
+		},
+		{
+			name:    "refused case",
+			claims:  []claimResult{due, nothingDue},
+			sendErr: refusal,
+			want: []string{
+				"begin", claimed, deleted, stored, "rollback", "fail " + scheduledID + ": " + status.Convert(refusal).Message(),
+				"begin", claimed, "rollback",
+			},
+		},
+		{
+			// the message is postponed and the dispatcher moves on to the next one
+			name:    "transient error case",
+			claims:  []claimResult{retried, due, nothingDue},
+			sent:    message,
+			sendErr: errors.New("connection reset"),
+			want: []string{
+				"begin", claimed, deleted, stored, "rollback", "retry " + scheduledID + " in 4m0s",
+				"begin", claimed, deleted, stored, "rollback", "retry " + scheduledID + " in 1m0s",
+				"begin", claimed, "rollback",
+			},
+		},
+		{
+			name:    "out of attempts case",
+			claims:  []claimResult{exhausted, nothingDue},
+			sendErr: errors.New("connection reset"),
+			want: []string{
+				"begin", claimed, deleted, stored, "rollback", "fail " + scheduledID + ": message could not be delivered",
+				"begin", claimed, "rollback",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				rec   = &recorder{}
+				done  = make(chan struct{})
+				calls int
+			)
+
+			chatRepositoryMock := repositoryMocks.NewChatRepositoryMock(mc)
+			chatRepositoryMock.ClaimDueScheduledMessageMock.Set(func(ctx context.Context, _ time.Time) (*model.ScheduledMessage, error) {
+				rec.add("claim in tx %t", inTx(ctx))
+
+				result := test.claims[calls]
+				calls++
+				if calls == len(test.claims) {
+					close(done)
+				}
+
+				return result.scheduled, result.err
+			})
+			chatRepositoryMock.DeleteScheduledMessageMock.Optional().Set(func(ctx context.Context, chatID int64, scheduledID string, userID int64) (bool, error) {
+				rec.add("delete %s of user %d in chat %d in tx %t", scheduledID, userID, chatID, inTx(ctx))
+				return true, nil
+			})
+			chatRepositoryMock.FailScheduledMessageMock.Optional().Set(func(_ context.Context, scheduledID, failure string) error {
+				rec.add("fail %s: %s", scheduledID, failure)
+				return nil
+			})
+			chatRepositoryMock.RetryScheduledMessageMock.Optional().Set(func(_ context.Context, scheduledID string, retryAt time.Time) error {
+				rec.add("retry %s in %s", scheduledID, time.Until(retryAt).Round(time.Minute))
+				return nil
+			})
+
+			chatServiceMock := serviceMocks.NewChatServiceMock(mc)
+			chatServiceMock.StoreMessageMock.Optional().Set(func(ctx context.Context, create *model.MessageCreate) (*model.Message, error) {
+				callerID, _ := auth.UserIDFromContext(ctx)
+				rec.add("store %q as user %d in chat %d in tx %t", create.Info.Text, callerID, create.Info.ChatID, inTx(ctx))
+				return test.sent, test.sendErr
+			})
+			chatServiceMock.PublishMessageMock.Optional().Set(func(message *model.Message) {
+				rec.add("publish %s", message.ID)
+			})
+
+			dispatcher := scheduler.NewDispatcher(chatRepositoryMock, txManagerStub{recorder: rec}, chatServiceMock, time.Minute)
+			go dispatcher.Run()
+
+			<-done
+			require.NoError(t, dispatcher.Close())
+			require.Equal(t, test.want, rec.list())
+		})
+	}
+}
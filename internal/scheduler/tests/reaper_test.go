@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/scheduler"
+	serviceMocks "github.com/BelyaevEI/microservices_chat/internal/service/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReaper(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		uploadTTL = 24 * time.Hour
+	)
+
+	tests := []struct {
+		name    string
+		expired []int
+		err     error
+		uploads []int
+		want    []string
+	}{
+		{
+			// a full batch means there may be more
+			name:    "success case",
+			expired: []int{500, 3},
+			uploads: []int{500, 500, 0},
+			want: []string{
+				"purge 500 expired messages", "purge 3 expired messages",
+				"purge 500 uploads older than 24h0m0s", "purge 500 uploads older than 24h0m0s", "purge 0 uploads older than 24h0m0s",
+			},
+		},
+		{
+			// a failure ends the expired messages for this run, the uploads still go
+			name:    "error case",
+			expired: []int{0},
+			err:     errors.New("connection refused"),
+			uploads: []int{1},
+			want: []string{
+				"purge 0 expired messages",
+				"purge 1 uploads older than 24h0m0s",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				rec          = &recorder{}
+				done         = make(chan struct{})
+				expiredCalls int
+				uploadCalls  int
+			)
+
+			chatServiceMock := serviceMocks.NewChatServiceMock(mc)
+			chatServiceMock.PurgeExpiredMessagesMock.Set(func(_ context.Context, _ uint64) (int, error) {
+				purged := test.expired[expiredCalls]
+				expiredCalls++
+				rec.add("purge %d expired messages", purged)
+
+				return purged, test.err
+			})
+			chatServiceMock.PurgeStaleUploadsMock.Set(func(_ context.Context, uploadedBefore time.Time, _ uint64) (int, error) {
+				purged := test.uploads[uploadCalls]
+				uploadCalls++
+				rec.add("purge %d uploads older than %s", purged, time.Since(uploadedBefore).Round(time.Minute))
+
+				if uploadCalls == len(test.uploads) {
+					close(done)
+				}
+
+				return purged, nil
+			})
+
+			reaper := scheduler.NewReaper(chatServiceMock, time.Hour, uploadTTL)
+			go reaper.Run()
+
+			<-done
+			require.NoError(t, reaper.Close())
+			require.Equal(t, test.want, rec.list())
+		})
+	}
+}
+
+func TestReaperInterval(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		runs atomic.Int64
+		done = make(chan struct{})
+	)
+
+	chatServiceMock := serviceMocks.NewChatServiceMock(mc)
+	chatServiceMock.PurgeExpiredMessagesMock.Return(0, nil)
+	chatServiceMock.PurgeStaleUploadsMock.Set(func(_ context.Context, _ time.Time, _ uint64) (int, error) {
+		if runs.Add(1) == 3 {
+			close(done)
+		}
+
+		return 0, nil
+	})
+
+	reaper := scheduler.NewReaper(chatServiceMock, 10*time.Millisecond, time.Hour)
+	go reaper.Run()
+
+	<-done
+	require.NoError(t, reaper.Close())
+
+	// nothing runs once Close returned
+	stopped := runs.Load()
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, stopped, runs.Load())
+}
@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/BelyaevEI/platform_common/pkg/db"
+)
+
+// recorder collects what the workers did, in order
+type recorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *recorder) add(format string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) list() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]string(nil), r.events...)
+}
+
+// txKey marks the contexts of the transactions run by txManagerStub
+type txKey struct{}
+
+// txManagerStub runs the handler without a real transaction and records
+// whether it committed
+type txManagerStub struct {
+	recorder *recorder
+}
+
+func (s txManagerStub) ReadCommitted(ctx context.Context, f db.Handler) error {
+	s.recorder.add("begin")
+
+	err := f(context.WithValue(ctx, txKey{}, true))
+	if err != nil {
+		s.recorder.add("rollback")
+		return err
+	}
+
+	s.recorder.add("commit")
+	return nil
+}
+
+// inTx reports whether ctx was handed out by txManagerStub
+func inTx(ctx context.Context) bool {
+	_, ok := ctx.Value(txKey{}).(bool)
+	return ok
+}
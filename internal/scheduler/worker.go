@@ -0,0 +1,57 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// worker runs a job right away and then every interval until it is closed
+type worker struct {
+	interval time.Duration
+
+	ctx       context.Context
+	cancel    context.CancelFunc
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newWorker(interval time.Duration) *worker {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &worker{
+		interval: interval,
+		ctx:      ctx,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+}
+
+// run blocks until Close is called, job gets a context cancelled by Close
+func (w *worker) run(job func(ctx context.Context)) {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		job(w.ctx)
+
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Close stops the worker and waits for its run to return, so it must have been
+// started. A job in progress sees its context cancelled.
+func (w *worker) Close() error {
+	w.closeOnce.Do(func() {
+		w.cancel()
+		<-w.done
+	})
+
+	return nil
+}
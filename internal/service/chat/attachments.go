@@ -0,0 +1,246 @@
+package chat
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sniffLen is how much of the content http.DetectContentType looks at
+const sniffLen = 512
+
+var errAttachmentTooLarge = errors.New("attachment is too large")
+
+// UploadAttachment stores the content and registers it as an attachment of
+// the chat. It stays visible to the uploader only until it is sent with a
+// message.
+func (s *serv) UploadAttachment(ctx context.Context, upload *model.AttachmentUpload, content io.Reader) (*model.Attachment, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkMember(ctx, upload.ChatID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := bufio.NewReaderSize(content, sniffLen)
+
+	mimeType := upload.MimeType
+	if mimeType == "" {
+		head, errPeek := reader.Peek(sniffLen)
+		if errPeek != nil && !errors.Is(errPeek, io.EOF) {
+			return nil, errPeek
+		}
+		mimeType = http.DetectContentType(head)
+	}
+
+	key, err := newBlobKey()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.New()
+	size, err := s.blobStore.Put(ctx, key, &sizeLimitReader{
+		reader:    io.TeeReader(reader, hash),
+		remaining: s.maxAttachmentSize,
+	})
+	if err != nil {
+		if errors.Is(err, errAttachmentTooLarge) {
+			return nil, status.Errorf(codes.InvalidArgument, "attachment exceeds %d bytes", s.maxAttachmentSize)
+		}
+		return nil, err
+	}
+
+	if size == 0 {
+		s.deleteBlob(ctx, key)
+		return nil, status.Error(codes.InvalidArgument, "attachment is empty")
+	}
+
+	attachment, err := s.chatRepository.CreateAttachment(ctx, &model.Attachment{
+		ChatID:     upload.ChatID,
+		UploaderID: userID,
+		FileName:   upload.FileName,
+		MimeType:   mimeType,
+		Size:       size,
+		SHA256:     hex.EncodeToString(hash.Sum(nil)),
+		StorageKey: key,
+	})
+	if err != nil {
+		s.deleteBlob(ctx, key)
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// OpenAttachment returns the attachment with a reader of its content, the
+// caller must close the reader
+func (s *serv) OpenAttachment(ctx context.Context, attachmentID string) (*model.Attachment, io.ReadCloser, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attachment, err := s.chatRepository.GetAttachment(ctx, attachmentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrAttachmentNotFound) {
+			return nil, nil, status.Errorf(codes.NotFound, "attachment %s not found", attachmentID)
+		}
+		return nil, nil, err
+	}
+
+	err = s.checkMember(ctx, attachment.ChatID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if attachment.MessageID == "" {
+		if attachment.UploaderID != userID {
+			return nil, nil, status.Errorf(codes.NotFound, "attachment %s not found", attachmentID)
+		}
+	} else {
+		message, errMessage := s.chatRepository.GetMessage(ctx, attachment.ChatID, attachment.MessageID)
+		if errMessage != nil {
+			// an expired message not purged yet reads as missing
+			if errors.Is(errMessage, repository.ErrMessageNotFound) {
+				return nil, nil, status.Errorf(codes.NotFound, "attachment %s not found", attachmentID)
+			}
+			return nil, nil, errMessage
+		}
+
+		// a message deleted for everyone takes its attachments along
+		if message.DeletedAt != nil {
+			return nil, nil, status.Errorf(codes.NotFound, "attachment %s not found", attachmentID)
+		}
+	}
+
+	content, err := s.blobStore.Open(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrBlobNotFound) {
+			return nil, nil, status.Errorf(codes.DataLoss, "content of attachment %s is missing", attachmentID)
+		}
+		return nil, nil, err
+	}
+
+	return attachment, content, nil
+}
+
+// attachFiles fills in the attachments of the messages
+func (s *serv) attachFiles(ctx context.Context, chatID int64, messages ...*model.Message) error {
+	ids := make([]string, 0, len(messages))
+	for _, message := range messages {
+		ids = append(ids, message.ID)
+	}
+
+	attachments, err := s.chatRepository.GetAttachments(ctx, chatID, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, message := range messages {
+		message.Attachments = attachments[message.ID]
+	}
+
+	return nil
+}
+
+// PurgeStaleUploads removes up to limit attachments uploaded before the given
+// time and never sent, together with their content. It returns how many
+// attachments were removed.
+func (s *serv) PurgeStaleUploads(ctx context.Context, uploadedBefore time.Time, limit uint64) (int, error) {
+	keys, err := s.chatRepository.DeleteStaleUploads(ctx, uploadedBefore, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	// only sent attachments are forwarded, so nothing else shares these blobs
+	s.deleteBlobs(ctx, keys)
+
+	return len(keys), nil
+}
+
+// unusedBlobKeys returns the keys of the blobs no attachment refers to
+// anymore. It must run inside the transaction that removed the attachments.
+func (s *serv) unusedBlobKeys(ctx context.Context, keys []string) ([]string, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	used, err := s.chatRepository.GetUsedStorageKeys(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	skip := make(map[string]struct{}, len(keys))
+	for _, key := range used {
+		skip[key] = struct{}{}
+	}
+
+	unused := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if _, ok := skip[key]; !ok {
+			skip[key] = struct{}{}
+			unused = append(unused, key)
+		}
+	}
+
+	return unused, nil
+}
+
+// deleteBlobs removes the blobs of deleted attachments, it is called after
+// commit so that a rollback never leaves attachments without content
+func (s *serv) deleteBlobs(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		s.deleteBlob(ctx, key)
+	}
+}
+
+// deleteBlob removes a blob nothing refers to, a leftover blob is only wasted space
+func (s *serv) deleteBlob(ctx context.Context, key string) {
+	err := s.blobStore.Delete(ctx, key)
+	if err != nil {
+		log.Printf("failed to delete blob %s: %v", key, err)
+	}
+}
+
+func newBlobKey() (string, error) {
+	raw := make([]byte, 16)
+	_, err := rand.Read(raw)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(raw), nil
+}
+
+// sizeLimitReader fails the read that goes past the limit, so that an
+// oversized upload is not written out in full before being rejected
+type sizeLimitReader struct {
+	reader    io.Reader
+	remaining int64
+}
+
+func (r *sizeLimitReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.remaining -= int64(n)
+	if r.remaining < 0 {
+		return n, errAttachmentTooLarge
+	}
+
+	return n, err
+}
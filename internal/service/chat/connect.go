@@ -0,0 +1,27 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+)
+
+func (s *serv) ConnectChat(ctx context.Context, chatID int64) (<-chan *model.Message, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkMember(ctx, chatID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := s.hub.Subscribe(chatID, userID)
+	go func() {
+		<-ctx.Done()
+		sub.Close()
+	}()
+
+	return sub.C, nil
+}
@@ -2,17 +2,105 @@ package chat
 
 import (
 	"context"
+	"strings"
 
 	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// CreateChat creates a chat owned by the caller, who becomes its member even
+// when not listed
 func (s *serv) CreateChat(ctx context.Context, createChat *model.ChatCreate) (int64, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	members, err := s.resolveMembers(ctx, createChat)
+	if err != nil {
+		return 0, err
+	}
+
+	members = withOwner(members, userID)
+
 	var id int64
 
-	id, err := s.chatRepository.CreateChat(ctx, createChat)
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		id, errTx = s.chatRepository.CreateChat(ctx, createChat)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.chatRepository.AddMembers(ctx, id, members)
+	})
 	if err != nil {
 		return 0, err
 	}
 
 	return id, nil
 }
+
+// withOwner makes the user the owner among the members, adding them first
+// when they are not listed
+func withOwner(members []*model.ChatMember, userID int64) []*model.ChatMember {
+	for _, member := range members {
+		if member.UserID == userID {
+			member.Role = model.MemberRoleOwner
+			return members
+		}
+	}
+
+	return append([]*model.ChatMember{{UserID: userID, Role: model.MemberRoleOwner}}, members...)
+}
+
+// resolveMembers merges the explicit member ids with the ids the user directory
+// returns for the requested usernames
+func (s *serv) resolveMembers(ctx context.Context, createChat *model.ChatCreate) ([]*model.ChatMember, error) {
+	members := make([]*model.ChatMember, 0, len(createChat.UserID)+len(createChat.Usernames))
+	byID := make(map[int64]*model.ChatMember, cap(members))
+
+	add := func(userID int64, username string) {
+		if member, ok := byID[userID]; ok {
+			if len(member.Username) == 0 {
+				member.Username = username
+			}
+			return
+		}
+
+		member := &model.ChatMember{UserID: userID, Username: username, Role: model.MemberRoleMember}
+		byID[userID] = member
+		members = append(members, member)
+	}
+
+	for _, userID := range createChat.UserID {
+		add(userID, "")
+	}
+
+	if len(createChat.Usernames) == 0 {
+		return members, nil
+	}
+
+	resolved, err := s.userDirectory.ResolveUsernames(ctx, createChat.Usernames)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to resolve usernames: %v", err)
+	}
+
+	var unknown []string
+	for _, username := range createChat.Usernames {
+		userID, ok := resolved[username]
+		if !ok {
+			unknown = append(unknown, username)
+			continue
+		}
+
+		add(userID, username)
+	}
+
+	if len(unknown) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown usernames: %s", strings.Join(unknown, ", "))
+	}
+
+	return members, nil
+}
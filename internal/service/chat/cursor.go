@@ -0,0 +1,29 @@
+package chat
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+var errInvalidCursor = errors.New("invalid page token")
+
+// encodeCursor packs a keyset position into an opaque token
+func encodeCursor(fields ...string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join(fields, ":")))
+}
+
+// decodeCursor unpacks a token produced by encodeCursor with n fields
+func decodeCursor(token string, n int) ([]string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	fields := strings.Split(string(raw), ":")
+	if len(fields) != n {
+		return nil, errInvalidCursor
+	}
+
+	return fields, nil
+}
@@ -2,13 +2,49 @@ package chat
 
 import (
 	"context"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
 )
 
+// DeleteChat deletes the chat with its history, only its owner can do it
 func (s *serv) DeleteChat(ctx context.Context, id int64) error {
-	err := s.chatRepository.DeleteChat(ctx, id)
+	userID, err := callerID(ctx)
 	if err != nil {
 		return err
 	}
 
+	var blobKeys []string
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		_, errTx := s.requireRole(ctx, id, userID, model.MemberRoleOwner)
+		if errTx != nil {
+			return errTx
+		}
+
+		// no attachment can be added to the chat while its blobs are collected
+		errTx = s.chatRepository.LockChat(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		blobKeys, errTx = s.chatRepository.GetChatStorageKeys(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.chatRepository.DeleteChat(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		blobKeys, errTx = s.unusedBlobKeys(ctx, blobKeys)
+		return errTx
+	})
+	if err != nil {
+		return roleError(err, id, userID, "delete")
+	}
+
+	s.deleteBlobs(ctx, blobKeys)
+
 	return nil
 }
@@ -0,0 +1,98 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeleteMessage hides the message from the caller, or deletes it for everyone
+// when the caller is its author or a chat admin
+func (s *serv) DeleteMessage(ctx context.Context, del *model.MessageDelete) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.checkMember(ctx, del.ChatID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !del.ForEveryone {
+		_, err = s.chatRepository.GetMessage(ctx, del.ChatID, del.MessageID)
+		if err != nil {
+			if errors.Is(err, repository.ErrMessageNotFound) {
+				return status.Errorf(codes.NotFound, "message %s not found in chat %d", del.MessageID, del.ChatID)
+			}
+			return err
+		}
+
+		return s.chatRepository.HideMessage(ctx, del.ChatID, del.MessageID, userID)
+	}
+
+	var (
+		message  *model.Message
+		blobKeys []string
+	)
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		current, errTx := s.chatRepository.GetMessageForUpdate(ctx, del.ChatID, del.MessageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if current.Info.UserID != userID {
+			_, errTx = s.requireRole(ctx, del.ChatID, userID, model.MemberRoleAdmin)
+			if errors.Is(errTx, errNotPermitted) {
+				return errNotAuthor
+			}
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		if current.DeletedAt != nil {
+			message = current
+			return nil
+		}
+
+		// the old texts must not outlive the message
+		errTx = s.chatRepository.DeleteMessageRevisions(ctx, del.ChatID, del.MessageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		message, errTx = s.chatRepository.DeleteMessageForEveryone(ctx, del.ChatID, del.MessageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		// a message deleted for everyone takes its attachments along
+		blobKeys, errTx = s.chatRepository.DeleteMessageAttachments(ctx, del.ChatID, del.MessageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		blobKeys, errTx = s.unusedBlobKeys(ctx, blobKeys)
+		return errTx
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrMessageNotFound) {
+			return status.Errorf(codes.NotFound, "message %s not found in chat %d", del.MessageID, del.ChatID)
+		}
+		if errors.Is(err, errNotAuthor) {
+			return status.Error(codes.PermissionDenied, "only the author or an admin can delete the message for everyone")
+		}
+		return roleError(err, del.ChatID, userID, "delete messages in")
+	}
+
+	s.deleteBlobs(ctx, blobKeys)
+	s.publish(ctx, message)
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serv) EditMessage(ctx context.Context, edit *model.MessageEdit) (*model.Message, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkMember(ctx, edit.ChatID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var message *model.Message
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		current, errTx := s.chatRepository.GetMessageForUpdate(ctx, edit.ChatID, edit.MessageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if current.Info.UserID != userID {
+			return errNotAuthor
+		}
+
+		if current.DeletedAt != nil {
+			return errMessageDeleted
+		}
+
+		if current.SystemEvent != "" {
+			return errSystemMessage
+		}
+
+		// the votes were cast for the question as it was asked, the lock keeps
+		// new votes out until the edit commits
+		_, errTx = s.chatRepository.GetPollForUpdate(ctx, edit.ChatID, edit.MessageID)
+		switch {
+		case errTx == nil:
+			polls, errTx := s.chatRepository.GetPolls(ctx, edit.ChatID, []string{edit.MessageID})
+			if errTx != nil {
+				return errTx
+			}
+
+			if poll, ok := polls[edit.MessageID]; ok && poll.TotalVoters > 0 {
+				return errPollVoted
+			}
+		case !errors.Is(errTx, repository.ErrPollNotFound):
+			return errTx
+		}
+
+		errTx = s.chatRepository.CreateMessageRevision(ctx, &model.MessageRevision{
+			MessageID: current.ID,
+			ChatID:    current.Info.ChatID,
+			Text:      current.Info.Text,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		message, errTx = s.chatRepository.UpdateMessageText(ctx, edit.ChatID, edit.MessageID, edit.Text)
+		if errTx != nil {
+			return errTx
+		}
+
+		// offsets of the old mentions make no sense for the new text
+		errTx = s.chatRepository.DeleteMentions(ctx, edit.ChatID, edit.MessageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		message.Mentions, errTx = s.resolveMentions(ctx, edit.ChatID, edit.Text)
+		if errTx != nil || len(message.Mentions) == 0 {
+			return errTx
+		}
+
+		return s.chatRepository.AddMentions(ctx, edit.ChatID, edit.MessageID, message.Mentions)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrMessageNotFound) {
+			return nil, status.Errorf(codes.NotFound, "message %s not found in chat %d", edit.MessageID, edit.ChatID)
+		}
+		if errors.Is(err, errNotAuthor) {
+			return nil, status.Error(codes.PermissionDenied, "only the author can edit the message")
+		}
+		if errors.Is(err, errMessageDeleted) {
+			return nil, status.Error(codes.FailedPrecondition, "deleted message can't be edited")
+		}
+		if errors.Is(err, errSystemMessage) {
+			return nil, status.Error(codes.FailedPrecondition, "system message can't be edited")
+		}
+		if errors.Is(err, errPollVoted) {
+			return nil, status.Error(codes.FailedPrecondition, "poll that has votes can't be edited")
+		}
+		return nil, err
+	}
+
+	s.publish(ctx, message)
+
+	return message, nil
+}
+
+func (s *serv) GetMessageHistory(ctx context.Context, chatID int64, messageID string) ([]*model.MessageRevision, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkMember(ctx, chatID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.chatRepository.GetMessage(ctx, chatID, messageID)
+	if err != nil {
+		if errors.Is(err, repository.ErrMessageNotFound) {
+			return nil, status.Errorf(codes.NotFound, "message %s not found in chat %d", messageID, chatID)
+		}
+		return nil, err
+	}
+
+	return s.chatRepository.ListMessageRevisions(ctx, chatID, messageID)
+}
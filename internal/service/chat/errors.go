@@ -0,0 +1,21 @@
+package chat
+
+import "errors"
+
+// errors raised inside transactions; they are mapped to gRPC statuses once the
+// transaction manager has returned, because it wraps everything it gets back
+var (
+	errNotAuthor      = errors.New("user is not the author of the message")
+	errMessageDeleted = errors.New("message is deleted")
+	errNotMember      = errors.New("user is not a member of the chat")
+	errNotPermitted   = errors.New("user's role does not permit the action")
+	errOwnerLeaving   = errors.New("owner can't leave the chat")
+	errSystemMessage  = errors.New("message is generated by the service")
+	errTooManyPins    = errors.New("too many pinned messages")
+	errPollClosed     = errors.New("poll is closed")
+	errInvalidVote    = errors.New("vote does not fit the poll")
+	errPollVoted      = errors.New("poll already has votes")
+	errInviteUnusable = errors.New("invite link can't be used anymore")
+
+	errAttachmentsUnavailable = errors.New("attachments can't be sent with the message")
+)
@@ -0,0 +1,126 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ForwardMessages copies the messages to the end of the target chat on behalf
+// of the caller, all or none of them
+func (s *serv) ForwardMessages(ctx context.Context, sourceChatID int64, messageIDs []string, targetChatID int64) ([]*model.Message, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		forwarded []*model.Message
+		// the chat and the message the transaction failed on
+		failedChatID    int64
+		failedMessageID string
+	)
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		for _, chatID := range []int64{sourceChatID, targetChatID} {
+			failedChatID = chatID
+
+			isMember, errTx := s.chatRepository.IsMember(ctx, chatID, userID)
+			if errTx != nil {
+				return errTx
+			}
+
+			if !isMember {
+				return errNotMember
+			}
+		}
+
+		originals := make([]*model.Message, 0, len(messageIDs))
+		for _, messageID := range messageIDs {
+			failedMessageID = messageID
+
+			original, errTx := s.chatRepository.GetMessage(ctx, sourceChatID, messageID)
+			if errTx != nil {
+				return errTx
+			}
+
+			if original.DeletedAt != nil {
+				return errMessageDeleted
+			}
+
+			if original.SystemEvent != "" {
+				return errSystemMessage
+			}
+
+			originals = append(originals, original)
+		}
+
+		errTx := s.attachFiles(ctx, sourceChatID, originals...)
+		if errTx != nil {
+			return errTx
+		}
+
+		forwarded = make([]*model.Message, 0, len(originals))
+		for _, original := range originals {
+			origin := original.ForwardedFrom
+			if origin == nil {
+				origin = &model.ForwardedFrom{
+					UserID:    original.Info.UserID,
+					ChatID:    original.Info.ChatID,
+					MessageID: original.ID,
+				}
+			}
+
+			message, errAppend := s.appendMessage(ctx, &model.MessageCreate{
+				Info: model.MessageInfo{
+					ChatID: targetChatID,
+					UserID: userID,
+					Text:   original.Info.Text,
+				},
+				ForwardedFrom: origin,
+			})
+			if errAppend != nil {
+				return errAppend
+			}
+
+			if len(original.Attachments) > 0 {
+				message.Attachments, errTx = s.chatRepository.CopyAttachments(ctx, original, message)
+				if errTx != nil {
+					return errTx
+				}
+			}
+
+			forwarded = append(forwarded, message)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrChatNotFound) {
+			return nil, status.Errorf(codes.NotFound, "chat with id %d not found", failedChatID)
+		}
+		if errors.Is(err, errNotMember) {
+			return nil, status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, failedChatID)
+		}
+		if errors.Is(err, repository.ErrMessageNotFound) {
+			return nil, status.Errorf(codes.NotFound, "message %s not found in chat %d", failedMessageID, sourceChatID)
+		}
+		if errors.Is(err, errMessageDeleted) {
+			return nil, status.Error(codes.FailedPrecondition, "deleted message can't be forwarded")
+		}
+		if errors.Is(err, errSystemMessage) {
+			return nil, status.Error(codes.FailedPrecondition, "system message can't be forwarded")
+		}
+		return nil, err
+	}
+
+	for _, message := range forwarded {
+		s.hub.Publish(targetChatID, message)
+	}
+
+	return forwarded, nil
+}
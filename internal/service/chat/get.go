@@ -0,0 +1,33 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serv) GetChat(ctx context.Context, id int64) (*model.Chat, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkMember(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	chat, err := s.chatRepository.GetChat(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrChatNotFound) {
+			return nil, status.Errorf(codes.NotFound, "chat with id %d not found", id)
+		}
+		return nil, err
+	}
+
+	return chat, nil
+}
@@ -0,0 +1,180 @@
+package chat
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// inviteTokenLen is how many random bytes an invite token carries
+const inviteTokenLen = 32
+
+// CreateInviteLink creates a link to join the chat by, admins and the owner
+// can do it. Zero maxUses allows any number of uses and no expiresAt keeps
+// the link valid until it is revoked.
+func (s *serv) CreateInviteLink(ctx context.Context, chatID int64, expiresAt *time.Time, maxUses int32) (*model.InviteLink, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxUses < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invite link uses limit can't be negative")
+	}
+
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "invite link expiry must be in the future")
+	}
+
+	err = s.checkRole(ctx, chatID, userID, model.MemberRoleAdmin, "create invite links to")
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := newInviteToken()
+	if err != nil {
+		return nil, err
+	}
+
+	invite, err := s.chatRepository.CreateInviteLink(ctx, &model.InviteLinkCreate{
+		ChatID:    chatID,
+		CreatedBy: userID,
+		TokenHash: hashInviteToken(token),
+		ExpiresAt: expiresAt,
+		MaxUses:   maxUses,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the token is stored hashed, this is the only time it is handed out
+	invite.Token = token
+
+	return invite, nil
+}
+
+// RevokeInviteLink stops the invite link from being used, admins and the
+// owner can do it. Members who joined by it stay in the chat.
+func (s *serv) RevokeInviteLink(ctx context.Context, chatID int64, inviteID string) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.checkRole(ctx, chatID, userID, model.MemberRoleAdmin, "revoke invite links to")
+	if err != nil {
+		return err
+	}
+
+	err = s.chatRepository.RevokeInviteLink(ctx, chatID, inviteID)
+	if err != nil {
+		if errors.Is(err, repository.ErrInviteLinkNotFound) {
+			return status.Errorf(codes.NotFound, "invite link %s not found in chat %d", inviteID, chatID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// JoinByInvite adds the caller to the chat of the invite link as a plain
+// member and records the event in the chat history. It returns the id of the
+// chat. Members joining again change nothing and don't use the link up.
+func (s *serv) JoinByInvite(ctx context.Context, token string) (int64, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		chatID int64
+		event  *model.Message
+	)
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		invite, errTx := s.chatRepository.GetInviteLinkForUpdate(ctx, hashInviteToken(token))
+		if errTx != nil {
+			return errTx
+		}
+		chatID = invite.ChatID
+
+		if invite.RevokedAt != nil ||
+			(invite.ExpiresAt != nil && !invite.ExpiresAt.After(time.Now())) ||
+			(invite.MaxUses > 0 && invite.Uses >= invite.MaxUses) {
+			return errInviteUnusable
+		}
+
+		errTx = s.chatRepository.LockChat(ctx, chatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		isMember, errTx := s.chatRepository.IsMember(ctx, chatID, userID)
+		if errTx != nil || isMember {
+			return errTx
+		}
+
+		errTx = s.addMembers(ctx, chatID, []int64{userID})
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.chatRepository.CountInviteUse(ctx, invite.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		event, errTx = s.appendMessage(ctx, &model.MessageCreate{
+			Info: model.MessageInfo{
+				ChatID: chatID,
+				UserID: userID,
+			},
+			SystemEvent: model.SystemEventUserJoined,
+		})
+		return errTx
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrInviteLinkNotFound) {
+			return 0, status.Error(codes.NotFound, "invite link not found")
+		}
+		if errors.Is(err, errInviteUnusable) {
+			return 0, status.Error(codes.FailedPrecondition, "invite link is revoked, expired or used up")
+		}
+		if errors.Is(err, repository.ErrChatNotFound) {
+			return 0, status.Errorf(codes.NotFound, "chat with id %d not found", chatID)
+		}
+		return 0, err
+	}
+
+	if event != nil {
+		s.hub.Publish(chatID, event)
+	}
+
+	return chatID, nil
+}
+
+// newInviteToken returns a random token safe to put into a URL
+func newInviteToken() (string, error) {
+	raw := make([]byte, inviteTokenLen)
+	_, err := rand.Read(raw)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// hashInviteToken returns the form an invite token is stored and looked up in
+func hashInviteToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,77 @@
+package chat
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultPageSize = 20
+
+func (s *serv) ListChats(ctx context.Context, userID int64, pageSize int32, pageToken string) (*model.ChatPage, error) {
+	callerUserID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if callerUserID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "user %d cannot list chats of user %d", callerUserID, userID)
+	}
+
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	var cursor *model.ChatCursor
+	if pageToken != "" {
+		c, err := decodeChatCursor(pageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		cursor = c
+	}
+
+	// one extra row tells us whether there is a next page
+	chats, err := s.chatRepository.ListChats(ctx, userID, cursor, uint64(pageSize)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &model.ChatPage{Chats: chats}
+	if len(chats) > int(pageSize) {
+		page.Chats = chats[:pageSize]
+		last := page.Chats[len(page.Chats)-1]
+		page.NextPageToken = encodeCursor(
+			strconv.FormatInt(last.LastActivityAt.UnixNano(), 10),
+			strconv.FormatInt(last.ID, 10),
+		)
+	}
+
+	return page, nil
+}
+
+func decodeChatCursor(token string) (*model.ChatCursor, error) {
+	fields, err := decodeCursor(token, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	nanos, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	chatID, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	return &model.ChatCursor{
+		LastActivityAt: time.Unix(0, nanos).UTC(),
+		ID:             chatID,
+	}, nil
+}
@@ -0,0 +1,89 @@
+package chat
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serv) ListMessages(ctx context.Context, query *model.MessageListQuery) (*model.MessagePage, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkMember(ctx, query.ChatID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.Before != "" && query.After != "" {
+		return nil, status.Error(codes.InvalidArgument, "only one of before and after can be set")
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+
+	before, err := decodeMessageCursor(query.Before)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	after, err := decodeMessageCursor(query.After)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// one extra row tells us whether there is more history in the requested direction
+	messages, err := s.chatRepository.ListMessages(ctx, query.ChatID, userID, before, after, uint64(limit)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &model.MessagePage{}
+	if len(messages) > int(limit) {
+		page.HasMore = true
+		if after != nil {
+			messages = messages[:limit]
+		} else {
+			messages = messages[1:]
+		}
+	}
+
+	err = s.attachDetails(ctx, query.ChatID, messages...)
+	if err != nil {
+		return nil, err
+	}
+
+	page.Messages = messages
+	if len(messages) > 0 {
+		first, last := messages[0], messages[len(messages)-1]
+		page.PrevCursor = encodeCursor(strconv.FormatInt(first.Seq, 10))
+		page.NextCursor = encodeCursor(strconv.FormatInt(last.Seq, 10))
+	}
+
+	return page, nil
+}
+
+func decodeMessageCursor(token string) (*model.MessageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	fields, err := decodeCursor(token, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	seq, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	return &model.MessageCursor{Seq: seq}, nil
+}
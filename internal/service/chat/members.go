@@ -0,0 +1,150 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AddMembers adds users to the chat as plain members, admins and the owner
+// can do it. Users who are members already keep their role.
+func (s *serv) AddMembers(ctx context.Context, chatID int64, userIDs []int64) error {
+	callerUserID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.chatRepository.LockChat(ctx, chatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		_, errTx = s.requireRole(ctx, chatID, callerUserID, model.MemberRoleAdmin)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.addMembers(ctx, chatID, userIDs)
+	})
+	if err != nil {
+		return roleError(err, chatID, callerUserID, "add members to")
+	}
+
+	return nil
+}
+
+// addMembers adds users to the chat as plain members, users who are members
+// already keep their role. It must run inside a transaction holding the lock
+// of the chat.
+func (s *serv) addMembers(ctx context.Context, chatID int64, userIDs []int64) error {
+	members := make([]*model.ChatMember, 0, len(userIDs))
+	for _, userID := range userIDs {
+		members = append(members, &model.ChatMember{UserID: userID, Role: model.MemberRoleMember})
+	}
+
+	return s.chatRepository.AddMembers(ctx, chatID, members)
+}
+
+// RemoveMembers removes users from the chat. Admins can remove plain members
+// and the owner can remove admins too, nobody removes themselves this way.
+func (s *serv) RemoveMembers(ctx context.Context, chatID int64, userIDs []int64) error {
+	callerUserID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.chatRepository.LockChat(ctx, chatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		role, errTx := s.requireRole(ctx, chatID, callerUserID, model.MemberRoleAdmin)
+		if errTx != nil {
+			return errTx
+		}
+
+		roles, errTx := s.chatRepository.GetMemberRoles(ctx, chatID, userIDs)
+		if errTx != nil {
+			return errTx
+		}
+
+		for _, targetRole := range roles {
+			if roleRanks[targetRole] >= roleRanks[role] {
+				return errNotPermitted
+			}
+		}
+
+		_, errTx = s.chatRepository.RemoveMembers(ctx, chatID, userIDs)
+		return errTx
+	})
+	if err != nil {
+		return roleError(err, chatID, callerUserID, "remove these members from")
+	}
+
+	for _, userID := range userIDs {
+		s.kick(chatID, userID)
+	}
+
+	return nil
+}
+
+// LeaveChat removes the caller from the chat. The owner has to transfer the
+// ownership first.
+func (s *serv) LeaveChat(ctx context.Context, chatID, userID int64) error {
+	callerUserID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if userID != callerUserID {
+		return status.Errorf(codes.PermissionDenied, "user %d can't make user %d leave chat %d", callerUserID, userID, chatID)
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.chatRepository.LockChat(ctx, chatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		role, errTx := s.requireRole(ctx, chatID, userID, model.MemberRoleMember)
+		if errTx != nil {
+			return errTx
+		}
+
+		if role == model.MemberRoleOwner {
+			return errOwnerLeaving
+		}
+
+		_, errTx = s.chatRepository.RemoveMembers(ctx, chatID, []int64{userID})
+		return errTx
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrChatNotFound) {
+			return status.Errorf(codes.NotFound, "chat with id %d not found", chatID)
+		}
+		if errors.Is(err, errNotMember) {
+			return status.Errorf(codes.FailedPrecondition, "user %d is not a member of chat %d", userID, chatID)
+		}
+		if errors.Is(err, errOwnerLeaving) {
+			return status.Errorf(codes.FailedPrecondition, "owner of chat %d must transfer the ownership before leaving", chatID)
+		}
+		return err
+	}
+
+	s.kick(chatID, userID)
+
+	return nil
+}
+
+// kick closes the live streams of a user who is no longer a member, they are
+// only checked for membership when opened. It is called after commit.
+func (s *serv) kick(chatID, userID int64) {
+	s.hub.Kick(chatID, userID)
+	s.typingTracker.Kick(chatID, userID)
+}
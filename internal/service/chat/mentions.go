@@ -0,0 +1,200 @@
+package chat
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mentionAll notifies every member of the chat, it takes precedence over a
+// member who happens to be called "all"
+const mentionAll = "all"
+
+// mentionPattern matches @username not glued to a preceding word, so e-mail
+// addresses are not taken for mentions. A trailing dot ends the sentence
+// rather than the username.
+var mentionPattern = regexp.MustCompile(`(?:^|[^A-Za-z0-9_.@])(@([A-Za-z0-9_](?:[A-Za-z0-9_.]*[A-Za-z0-9_])?))`)
+
+type mentionToken struct {
+	username string
+	offset   int
+	length   int
+}
+
+// parseMentions finds the @usernames in the text, offsets and lengths are
+// counted in runes
+func parseMentions(text string) []mentionToken {
+	matches := mentionPattern.FindAllStringSubmatchIndex(text, -1)
+
+	tokens := make([]mentionToken, 0, len(matches))
+	for _, match := range matches {
+		start, end := match[2], match[3]
+		tokens = append(tokens, mentionToken{
+			username: text[match[4]:match[5]],
+			offset:   utf8.RuneCountInString(text[:start]),
+			length:   utf8.RuneCountInString(text[start:end]),
+		})
+	}
+
+	return tokens
+}
+
+// resolveMentions turns the @usernames in the text into mentions of the chat
+// members. Usernames are resolved by the user directory, names it doesn't
+// know and users outside the chat are not mentions.
+func (s *serv) resolveMentions(ctx context.Context, chatID int64, text string) ([]*model.MessageMention, error) {
+	tokens := parseMentions(text)
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	usernames := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if token.username != mentionAll {
+			usernames = append(usernames, token.username)
+		}
+	}
+
+	resolved, err := s.userDirectory.ResolveUsernames(ctx, usernames)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]int64, 0, len(resolved))
+	seen := make(map[int64]struct{}, len(resolved))
+	for _, username := range usernames {
+		id, ok := resolved[username]
+		if !ok {
+			continue
+		}
+
+		if _, ok = seen[id]; !ok {
+			seen[id] = struct{}{}
+			userIDs = append(userIDs, id)
+		}
+	}
+
+	members, err := s.chatRepository.GetMemberRoles(ctx, chatID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var mentions []*model.MessageMention
+	for _, token := range tokens {
+		mention := &model.MessageMention{
+			Offset: token.offset,
+			Length: token.length,
+		}
+
+		if token.username == mentionAll {
+			mention.All = true
+		} else {
+			id := resolved[token.username]
+			if _, ok := members[id]; !ok {
+				continue
+			}
+			mention.UserID = id
+		}
+
+		mentions = append(mentions, mention)
+	}
+
+	return mentions, nil
+}
+
+// attachMentions fills in the mentions of the messages
+func (s *serv) attachMentions(ctx context.Context, chatID int64, messages ...*model.Message) error {
+	ids := make([]string, 0, len(messages))
+	for _, message := range messages {
+		ids = append(ids, message.ID)
+	}
+
+	mentions, err := s.chatRepository.GetMentions(ctx, chatID, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, message := range messages {
+		message.Mentions = mentions[message.ID]
+	}
+
+	return nil
+}
+
+func (s *serv) ListMentions(ctx context.Context, userID int64, pageSize int32, pageToken string) (*model.MentionPage, error) {
+	callerUserID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if callerUserID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "user %d cannot list mentions of user %d", callerUserID, userID)
+	}
+
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	var cursor *model.MentionCursor
+	if pageToken != "" {
+		c, errCursor := decodeMentionCursor(pageToken)
+		if errCursor != nil {
+			return nil, status.Error(codes.InvalidArgument, errCursor.Error())
+		}
+		cursor = c
+	}
+
+	// one extra row tells us whether there is a next page
+	messages, err := s.chatRepository.ListMentions(ctx, userID, cursor, uint64(pageSize)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &model.MentionPage{Messages: messages}
+	if len(messages) > int(pageSize) {
+		page.Messages = messages[:pageSize]
+		last := page.Messages[len(page.Messages)-1]
+		page.NextPageToken = encodeCursor(
+			strconv.FormatInt(last.CreatedAt.UnixNano(), 10),
+			last.ID,
+		)
+	}
+
+	// the page spans chats, so the mentions are looked up chat by chat
+	byChat := make(map[int64][]*model.Message)
+	for _, message := range page.Messages {
+		byChat[message.Info.ChatID] = append(byChat[message.Info.ChatID], message)
+	}
+
+	for chatID, chatMessages := range byChat {
+		err = s.attachMentions(ctx, chatID, chatMessages...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+func decodeMentionCursor(token string) (*model.MentionCursor, error) {
+	fields, err := decodeCursor(token, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	nanos, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	return &model.MentionCursor{
+		CreatedAt: time.Unix(0, nanos).UTC(),
+		MessageID: fields[1],
+	}, nil
+}
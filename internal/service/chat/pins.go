@@ -0,0 +1,162 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PinMessage pins a message and records the event in the chat history.
+// Pinning an already pinned message changes nothing. Admins and the owner
+// can pin messages.
+func (s *serv) PinMessage(ctx context.Context, chatID int64, messageID string) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	var event *model.Message
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		// concurrent pins must not get past the limit together
+		errTx := s.chatRepository.LockChat(ctx, chatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		_, errTx = s.requireRole(ctx, chatID, userID, model.MemberRoleAdmin)
+		if errTx != nil {
+			return errTx
+		}
+
+		message, errTx := s.chatRepository.GetMessage(ctx, chatID, messageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if message.DeletedAt != nil {
+			return errMessageDeleted
+		}
+
+		if message.SystemEvent != "" {
+			return errSystemMessage
+		}
+
+		pinned, errTx := s.chatRepository.PinMessage(ctx, chatID, messageID, userID)
+		if errTx != nil || !pinned {
+			return errTx
+		}
+
+		count, errTx := s.chatRepository.CountPins(ctx, chatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if count > s.maxPins {
+			return errTooManyPins
+		}
+
+		event, errTx = s.appendPinEvent(ctx, chatID, userID, messageID, model.SystemEventMessagePinned)
+		return errTx
+	})
+	if err != nil {
+		if errors.Is(err, errTooManyPins) {
+			return status.Errorf(codes.FailedPrecondition, "chat %d can't have more than %d pinned messages", chatID, s.maxPins)
+		}
+		return pinError(err, chatID, userID, messageID)
+	}
+
+	if event != nil {
+		s.hub.Publish(chatID, event)
+	}
+
+	return nil
+}
+
+// UnpinMessage unpins a message and records the event in the chat history.
+// Unpinning a message that is not pinned changes nothing.
+func (s *serv) UnpinMessage(ctx context.Context, chatID int64, messageID string) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	var event *model.Message
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		_, errTx := s.requireRole(ctx, chatID, userID, model.MemberRoleAdmin)
+		if errTx != nil {
+			return errTx
+		}
+
+		unpinned, errTx := s.chatRepository.UnpinMessage(ctx, chatID, messageID)
+		if errTx != nil || !unpinned {
+			return errTx
+		}
+
+		event, errTx = s.appendPinEvent(ctx, chatID, userID, messageID, model.SystemEventMessageUnpinned)
+		return errTx
+	})
+	if err != nil {
+		return pinError(err, chatID, userID, messageID)
+	}
+
+	if event != nil {
+		s.hub.Publish(chatID, event)
+	}
+
+	return nil
+}
+
+func (s *serv) ListPinned(ctx context.Context, chatID int64) ([]*model.PinnedMessage, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkMember(ctx, chatID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.chatRepository.ListPinned(ctx, chatID)
+}
+
+// appendPinEvent adds a system message telling the members about the (un)pin
+// so that connected clients can refresh the pinned list
+func (s *serv) appendPinEvent(ctx context.Context, chatID, userID int64, messageID, systemEvent string) (*model.Message, error) {
+	return s.appendMessage(ctx, &model.MessageCreate{
+		Info: model.MessageInfo{
+			ChatID: chatID,
+			UserID: userID,
+		},
+		SystemEvent: systemEvent,
+		SubjectID:   messageID,
+	})
+}
+
+func pinError(err error, chatID, userID int64, messageID string) error {
+	if errors.Is(err, repository.ErrChatNotFound) {
+		return status.Errorf(codes.NotFound, "chat with id %d not found", chatID)
+	}
+	if errors.Is(err, errNotMember) {
+		return status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID)
+	}
+	if errors.Is(err, errNotPermitted) {
+		return status.Errorf(codes.PermissionDenied, "user %d may not pin messages in chat %d", userID, chatID)
+	}
+	if errors.Is(err, repository.ErrMessageNotFound) {
+		return status.Errorf(codes.NotFound, "message %s not found in chat %d", messageID, chatID)
+	}
+	if errors.Is(err, errMessageDeleted) {
+		return status.Error(codes.FailedPrecondition, "deleted message can't be pinned")
+	}
+	if errors.Is(err, errSystemMessage) {
+		return status.Error(codes.FailedPrecondition, "system message can't be pinned")
+	}
+	return err
+}
@@ -0,0 +1,305 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	minPollOptions = 2
+	maxPollOptions = 10
+)
+
+// CreatePoll posts a poll message on behalf of the caller, the question
+// becomes the message text
+func (s *serv) CreatePoll(ctx context.Context, create *model.PollCreate) (*model.Message, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validatePoll(create)
+	if err != nil {
+		return nil, err
+	}
+
+	chatID := create.ChatID
+
+	var message *model.Message
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		isMember, errTx := s.chatRepository.IsMember(ctx, chatID, userID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if !isMember {
+			return errNotMember
+		}
+
+		createMessage := &model.MessageCreate{
+			Info: model.MessageInfo{
+				ChatID: chatID,
+				UserID: userID,
+				Text:   create.Question,
+			},
+		}
+
+		createMessage.Mentions, errTx = s.resolveMentions(ctx, chatID, create.Question)
+		if errTx != nil {
+			return errTx
+		}
+
+		message, errTx = s.appendMessage(ctx, createMessage)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.chatRepository.CreatePoll(ctx, message.ID, create)
+		if errTx != nil {
+			return errTx
+		}
+
+		message.Poll = &model.Poll{
+			MessageID:   message.ID,
+			ChatID:      chatID,
+			Question:    create.Question,
+			MultiChoice: create.MultiChoice,
+			Anonymous:   create.Anonymous,
+			ClosesAt:    create.ClosesAt,
+		}
+		for i, option := range create.Options {
+			message.Poll.Options = append(message.Poll.Options, &model.PollOption{Index: int32(i), Text: option})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, pollError(err, chatID, userID, "")
+	}
+
+	s.hub.Publish(chatID, message)
+
+	return message, nil
+}
+
+// Vote replaces the caller's choice in the poll with the given options, no
+// options retract the vote. It returns the poll with the new tallies.
+func (s *serv) Vote(ctx context.Context, vote *model.PollVote) (*model.Poll, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vote.UserID = userID
+
+	var message *model.Message
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		message, errTx = s.getPollMessage(ctx, vote.ChatID, vote.MessageID, userID)
+		if errTx != nil {
+			return errTx
+		}
+
+		// the lock serializes votes and closing of the poll
+		poll, errTx := s.chatRepository.GetPollForUpdate(ctx, vote.ChatID, vote.MessageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if poll.ClosedAt != nil || (poll.ClosesAt != nil && !poll.ClosesAt.After(time.Now())) {
+			return errPollClosed
+		}
+
+		if !validVote(poll, vote.Options) {
+			return errInvalidVote
+		}
+
+		errTx = s.chatRepository.ReplacePollVotes(ctx, vote)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.attachDetails(ctx, vote.ChatID, message)
+	})
+	if err != nil {
+		return nil, pollError(err, vote.ChatID, userID, vote.MessageID)
+	}
+
+	s.publish(ctx, message)
+
+	return message.Poll, nil
+}
+
+// ClosePoll stops the poll from accepting votes. Only the author of the poll
+// can close it, closing it again changes nothing.
+func (s *serv) ClosePoll(ctx context.Context, chatID int64, messageID string) (*model.Poll, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var message *model.Message
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		message, errTx = s.getPollMessage(ctx, chatID, messageID, userID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if message.Info.UserID != userID {
+			return errNotAuthor
+		}
+
+		_, errTx = s.chatRepository.GetPollForUpdate(ctx, chatID, messageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.chatRepository.ClosePoll(ctx, chatID, messageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.attachDetails(ctx, chatID, message)
+	})
+	if err != nil {
+		return nil, pollError(err, chatID, userID, messageID)
+	}
+
+	s.publish(ctx, message)
+
+	return message.Poll, nil
+}
+
+// getPollMessage returns the message of a poll the user takes part in.
+// It must run inside a transaction.
+func (s *serv) getPollMessage(ctx context.Context, chatID int64, messageID string, userID int64) (*model.Message, error) {
+	isMember, err := s.chatRepository.IsMember(ctx, chatID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isMember {
+		return nil, errNotMember
+	}
+
+	message, err := s.chatRepository.GetMessage(ctx, chatID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if message.DeletedAt != nil {
+		return nil, errMessageDeleted
+	}
+
+	return message, nil
+}
+
+// attachPolls fills in the polls of the messages with their live tallies,
+// deleted messages keep no poll
+func (s *serv) attachPolls(ctx context.Context, chatID int64, messages ...*model.Message) error {
+	ids := make([]string, 0, len(messages))
+	for _, message := range messages {
+		ids = append(ids, message.ID)
+	}
+
+	polls, err := s.chatRepository.GetPolls(ctx, chatID, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, message := range messages {
+		poll, ok := polls[message.ID]
+		if !ok || message.DeletedAt != nil {
+			message.Poll = nil
+			continue
+		}
+
+		poll.Question = message.Info.Text
+		message.Poll = poll
+	}
+
+	return nil
+}
+
+func validatePoll(create *model.PollCreate) error {
+	if create.Question == "" {
+		return status.Error(codes.InvalidArgument, "poll must have a question")
+	}
+
+	if len(create.Options) < minPollOptions || len(create.Options) > maxPollOptions {
+		return status.Errorf(codes.InvalidArgument, "poll must have from %d to %d options", minPollOptions, maxPollOptions)
+	}
+
+	seen := make(map[string]struct{}, len(create.Options))
+	for _, option := range create.Options {
+		if option == "" {
+			return status.Error(codes.InvalidArgument, "poll options can't be empty")
+		}
+
+		if _, ok := seen[option]; ok {
+			return status.Errorf(codes.InvalidArgument, "poll option %q is repeated", option)
+		}
+		seen[option] = struct{}{}
+	}
+
+	if create.ClosesAt != nil && !create.ClosesAt.After(time.Now()) {
+		return status.Error(codes.InvalidArgument, "poll closing time must be in the future")
+	}
+
+	return nil
+}
+
+// validVote reports whether the options can be picked together in the poll
+func validVote(poll *model.Poll, options []int32) bool {
+	if len(options) > 1 && !poll.MultiChoice {
+		return false
+	}
+
+	seen := make(map[int32]struct{}, len(options))
+	for _, option := range options {
+		if option < 0 || int(option) >= len(poll.Options) {
+			return false
+		}
+
+		if _, ok := seen[option]; ok {
+			return false
+		}
+		seen[option] = struct{}{}
+	}
+
+	return true
+}
+
+func pollError(err error, chatID, userID int64, messageID string) error {
+	if errors.Is(err, repository.ErrChatNotFound) {
+		return status.Errorf(codes.NotFound, "chat with id %d not found", chatID)
+	}
+	if errors.Is(err, errNotMember) {
+		return status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID)
+	}
+	if errors.Is(err, repository.ErrMessageNotFound) || errors.Is(err, repository.ErrPollNotFound) {
+		return status.Errorf(codes.NotFound, "poll %s not found in chat %d", messageID, chatID)
+	}
+	if errors.Is(err, errMessageDeleted) {
+		return status.Error(codes.FailedPrecondition, "poll is deleted")
+	}
+	if errors.Is(err, errNotAuthor) {
+		return status.Errorf(codes.PermissionDenied, "user %d is not the author of poll %s", userID, messageID)
+	}
+	if errors.Is(err, errPollClosed) {
+		return status.Errorf(codes.FailedPrecondition, "poll %s is closed", messageID)
+	}
+	if errors.Is(err, errInvalidVote) {
+		return status.Error(codes.InvalidArgument, "options must exist in the poll, be distinct and more than one only for multiple choice polls")
+	}
+	return err
+}
@@ -0,0 +1,105 @@
+package chat
+
+import (
+	"context"
+	"sync"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+)
+
+// Heartbeat marks the caller as online
+func (s *serv) Heartbeat(ctx context.Context) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.presence.Heartbeat(ctx, userID)
+}
+
+// GetPresence returns the presence of the given users sharing a chat with the
+// caller, the same users WatchPresence streams. Others are left out.
+func (s *serv) GetPresence(ctx context.Context, userIDs []int64) ([]*model.Presence, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	contactIDs, err := s.chatRepository.ListContacts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make(map[int64]struct{}, len(contactIDs)+1)
+	visible[userID] = struct{}{}
+	for _, contactID := range contactIDs {
+		visible[contactID] = struct{}{}
+	}
+
+	allowedIDs := make([]int64, 0, len(userIDs))
+	for _, id := range userIDs {
+		if _, ok := visible[id]; ok {
+			allowedIDs = append(allowedIDs, id)
+		}
+	}
+
+	return s.presence.Get(ctx, allowedIDs)
+}
+
+// WatchPresence streams presence changes of the users sharing a chat with the
+// caller. The set of users is taken when the stream opens; clients reconnect
+// to pick up membership changes.
+func (s *serv) WatchPresence(ctx context.Context) (<-chan *model.Presence, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	contactIDs, err := s.chatRepository.ListContacts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// one dropped subscription ends the whole watch, the client reconnects
+	ctx, cancel := context.WithCancel(ctx)
+	changes := make(chan *model.Presence)
+
+	var wg sync.WaitGroup
+	for _, contactID := range contactIDs {
+		sub := s.presence.Subscribe(contactID, userID)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer cancel()
+			defer sub.Close()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case change, ok := <-sub.C:
+					if !ok {
+						return
+					}
+
+					select {
+					case changes <- change:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	go func() {
+		defer cancel()
+
+		<-ctx.Done()
+		wg.Wait()
+		close(changes)
+	}()
+
+	return changes, nil
+}
@@ -0,0 +1,20 @@
+package chat
+
+import (
+	"context"
+	"log"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+)
+
+// publish pushes an updated message to connected clients, skipping users
+// who deleted it for themselves
+func (s *serv) publish(ctx context.Context, message *model.Message) {
+	hiddenFor, err := s.chatRepository.ListHiddenFor(ctx, message.Info.ChatID, message.ID)
+	if err != nil {
+		log.Printf("failed to get hidden recipients of message %s: %v", message.ID, err)
+		return
+	}
+
+	s.hub.Publish(message.Info.ChatID, message, hiddenFor...)
+}
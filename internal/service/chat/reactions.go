@@ -0,0 +1,116 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serv) AddReaction(ctx context.Context, reaction *model.Reaction) error {
+	return s.react(ctx, reaction, s.chatRepository.AddReaction)
+}
+
+func (s *serv) RemoveReaction(ctx context.Context, reaction *model.Reaction) error {
+	return s.react(ctx, reaction, s.chatRepository.RemoveReaction)
+}
+
+// react applies the change on behalf of the caller and pushes the message with
+// its new reaction counts to connected clients
+func (s *serv) react(ctx context.Context, reaction *model.Reaction, apply func(context.Context, *model.Reaction) error) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+	reaction.UserID = userID
+
+	var message *model.Message
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		isMember, errTx := s.chatRepository.IsMember(ctx, reaction.ChatID, userID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if !isMember {
+			return errNotMember
+		}
+
+		message, errTx = s.chatRepository.GetMessage(ctx, reaction.ChatID, reaction.MessageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if message.DeletedAt != nil {
+			return errMessageDeleted
+		}
+
+		errTx = apply(ctx, reaction)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.attachDetails(ctx, reaction.ChatID, message)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrChatNotFound) {
+			return status.Errorf(codes.NotFound, "chat with id %d not found", reaction.ChatID)
+		}
+		if errors.Is(err, errNotMember) {
+			return status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, reaction.ChatID)
+		}
+		if errors.Is(err, repository.ErrMessageNotFound) {
+			return status.Errorf(codes.NotFound, "message %s not found in chat %d", reaction.MessageID, reaction.ChatID)
+		}
+		if errors.Is(err, errMessageDeleted) {
+			return status.Error(codes.FailedPrecondition, "deleted message can't be reacted to")
+		}
+		return err
+	}
+
+	s.publish(ctx, message)
+
+	return nil
+}
+
+// attachDetails fills in everything about the messages that is stored apart
+// from the message rows
+func (s *serv) attachDetails(ctx context.Context, chatID int64, messages ...*model.Message) error {
+	attachers := []func(context.Context, int64, ...*model.Message) error{
+		s.attachReactions,
+		s.attachMentions,
+		s.attachFiles,
+		s.attachPolls,
+	}
+
+	for _, attach := range attachers {
+		err := attach(ctx, chatID, messages...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// attachReactions fills in the aggregated reaction counts of the messages
+func (s *serv) attachReactions(ctx context.Context, chatID int64, messages ...*model.Message) error {
+	ids := make([]string, 0, len(messages))
+	for _, message := range messages {
+		ids = append(ids, message.ID)
+	}
+
+	counts, err := s.chatRepository.CountReactions(ctx, chatID, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, message := range messages {
+		message.Reactions = counts[message.ID]
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serv) MarkRead(ctx context.Context, chatID, upToSeq int64) (*model.ReadMark, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var mark *model.ReadMark
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		isMember, errTx := s.chatRepository.IsMember(ctx, chatID, userID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if !isMember {
+			return errNotMember
+		}
+
+		mark, errTx = s.chatRepository.MarkRead(ctx, chatID, userID, upToSeq)
+		return errTx
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrChatNotFound) {
+			return nil, status.Errorf(codes.NotFound, "chat with id %d not found", chatID)
+		}
+		if errors.Is(err, errNotMember) || errors.Is(err, repository.ErrMemberNotFound) {
+			return nil, status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID)
+		}
+		return nil, err
+	}
+
+	return mark, nil
+}
+
+func (s *serv) GetReadState(ctx context.Context, chatID int64) ([]*model.ReadState, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkMember(ctx, chatID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.chatRepository.ListReadStates(ctx, chatID)
+}
@@ -0,0 +1,38 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RenameChat changes the name of the chat, admins and the owner can do it
+func (s *serv) RenameChat(ctx context.Context, chatID int64, name string) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if name == "" {
+		return status.Error(codes.InvalidArgument, "chat name can't be empty")
+	}
+
+	err = s.checkRole(ctx, chatID, userID, model.MemberRoleAdmin, "rename")
+	if err != nil {
+		return err
+	}
+
+	err = s.chatRepository.RenameChat(ctx, chatID, name)
+	if err != nil {
+		if errors.Is(err, repository.ErrChatNotFound) {
+			return status.Errorf(codes.NotFound, "chat with id %d not found", chatID)
+		}
+		return err
+	}
+
+	return nil
+}
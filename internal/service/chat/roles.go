@@ -0,0 +1,143 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// roleRanks orders the member roles by what they are allowed to do
+var roleRanks = map[string]int{
+	model.MemberRoleMember: 1,
+	model.MemberRoleAdmin:  2,
+	model.MemberRoleOwner:  3,
+}
+
+// SetMemberRole makes a member of the chat an admin or a plain member again.
+// Only the owner can change roles, their own one changes by transferring
+// the ownership.
+func (s *serv) SetMemberRole(ctx context.Context, chatID, userID int64, role string) error {
+	callerUserID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if role != model.MemberRoleAdmin && role != model.MemberRoleMember {
+		return status.Errorf(codes.InvalidArgument, "role must be %s or %s", model.MemberRoleAdmin, model.MemberRoleMember)
+	}
+
+	if userID == callerUserID {
+		return status.Error(codes.FailedPrecondition, "owner's role changes only by transferring the ownership")
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		// a concurrent transfer must not leave the target demoted after it
+		// became the owner
+		errTx := s.chatRepository.LockChat(ctx, chatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		_, errTx = s.requireRole(ctx, chatID, callerUserID, model.MemberRoleOwner)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.chatRepository.SetMemberRole(ctx, chatID, userID, role)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrMemberNotFound) {
+			return status.Errorf(codes.NotFound, "user %d is not a member of chat %d", userID, chatID)
+		}
+		return roleError(err, chatID, callerUserID, "change member roles in")
+	}
+
+	return nil
+}
+
+// TransferOwnership makes another member the owner of the chat, the former
+// owner stays as an admin
+func (s *serv) TransferOwnership(ctx context.Context, chatID, userID int64) error {
+	callerUserID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if userID == callerUserID {
+		return status.Errorf(codes.InvalidArgument, "user %d already owns chat %d", userID, chatID)
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		// concurrent transfers must not both see the caller as the owner
+		errTx := s.chatRepository.LockChat(ctx, chatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		_, errTx = s.requireRole(ctx, chatID, callerUserID, model.MemberRoleOwner)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.chatRepository.SetMemberRole(ctx, chatID, callerUserID, model.MemberRoleAdmin)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.chatRepository.SetMemberRole(ctx, chatID, userID, model.MemberRoleOwner)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrMemberNotFound) {
+			return status.Errorf(codes.NotFound, "user %d is not a member of chat %d", userID, chatID)
+		}
+		return roleError(err, chatID, callerUserID, "transfer the ownership of")
+	}
+
+	return nil
+}
+
+// requireRole returns the role of the user in the chat. It fails with
+// errNotMember or errNotPermitted unless the role grants at least least.
+func (s *serv) requireRole(ctx context.Context, chatID, userID int64, least string) (string, error) {
+	role, err := s.chatRepository.GetMemberRole(ctx, chatID, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrMemberNotFound) {
+			return "", errNotMember
+		}
+		return "", err
+	}
+
+	if roleRanks[role] < roleRanks[least] {
+		return "", errNotPermitted
+	}
+
+	return role, nil
+}
+
+// checkRole is requireRole reporting its failures as statuses, action
+// completes "user may not ... chat" in the message
+func (s *serv) checkRole(ctx context.Context, chatID, userID int64, least, action string) error {
+	_, err := s.requireRole(ctx, chatID, userID, least)
+	if err != nil {
+		return roleError(err, chatID, userID, action)
+	}
+
+	return nil
+}
+
+func roleError(err error, chatID, userID int64, action string) error {
+	if errors.Is(err, repository.ErrChatNotFound) {
+		return status.Errorf(codes.NotFound, "chat with id %d not found", chatID)
+	}
+	if errors.Is(err, errNotMember) {
+		return status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID)
+	}
+	if errors.Is(err, errNotPermitted) {
+		return status.Errorf(codes.PermissionDenied, "user %d may not %s chat %d", userID, action, chatID)
+	}
+	return err
+}
@@ -0,0 +1,78 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ScheduleMessage stores a message to be sent on behalf of the caller at sendAt.
+// The message is delivered through SendMessage, so the caller must still be
+// a member of the chat by then.
+func (s *serv) ScheduleMessage(ctx context.Context, chatID int64, text string, sendAt time.Time) (*model.ScheduledMessage, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if text == "" {
+		return nil, status.Error(codes.InvalidArgument, "scheduled message must have text")
+	}
+
+	if !sendAt.After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "send time must be in the future")
+	}
+
+	err = s.checkMember(ctx, chatID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.chatRepository.CreateScheduledMessage(ctx, &model.ScheduledMessage{
+		Info: model.MessageInfo{
+			ChatID: chatID,
+			UserID: userID,
+			Text:   text,
+		},
+		SendAt: sendAt,
+	})
+}
+
+// ListScheduledMessages returns the caller's messages scheduled in the chat
+// that were not delivered yet, including the ones whose delivery failed.
+func (s *serv) ListScheduledMessages(ctx context.Context, chatID int64) ([]*model.ScheduledMessage, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkMember(ctx, chatID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.chatRepository.ListScheduledMessages(ctx, chatID, userID)
+}
+
+// CancelScheduledMessage drops a message the caller scheduled in the chat.
+// A message that is being delivered can't be cancelled anymore.
+func (s *serv) CancelScheduledMessage(ctx context.Context, chatID int64, scheduledID string) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.chatRepository.DeleteScheduledMessage(ctx, chatID, scheduledID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return status.Errorf(codes.NotFound, "scheduled message %s not found in chat %d", scheduledID, chatID)
+	}
+
+	return nil
+}
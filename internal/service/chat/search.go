@@ -0,0 +1,88 @@
+package chat
+
+import (
+	"context"
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// snippetMarker replaces the match delimiters of a snippet with HTML tags
+var snippetMarker = strings.NewReplacer(model.SnippetMatchStart, "<mark>", model.SnippetMatchStop, "</mark>")
+
+func (s *serv) SearchMessages(ctx context.Context, query *model.SearchQuery) (*model.SearchPage, error) {
+	callerUserID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if callerUserID != query.UserID {
+		return nil, status.Errorf(codes.PermissionDenied, "user %d cannot search messages of user %d", callerUserID, query.UserID)
+	}
+
+	if strings.TrimSpace(query.Query) == "" {
+		return nil, status.Error(codes.InvalidArgument, "search query is empty")
+	}
+
+	if query.From != nil && query.To != nil && !query.From.Before(*query.To) {
+		return nil, status.Error(codes.InvalidArgument, "search interval is empty")
+	}
+
+	if query.ChatID != 0 {
+		err = s.checkMember(ctx, query.ChatID, query.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pageSize := query.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	// results are ranked, so pages are addressed by position rather than by key
+	var offset uint64
+	if query.PageToken != "" {
+		offset, err = decodeOffsetCursor(query.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	// one extra row tells us whether there is a next page
+	results, err := s.chatRepository.SearchMessages(ctx, query, offset, uint64(pageSize)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	// the text is escaped first, so <mark></mark> are the only tags in a snippet
+	for _, result := range results {
+		result.Snippet = snippetMarker.Replace(html.EscapeString(result.Snippet))
+	}
+
+	page := &model.SearchPage{Results: results}
+	if len(results) > int(pageSize) {
+		page.Results = results[:pageSize]
+		page.NextPageToken = encodeCursor(strconv.FormatUint(offset+uint64(pageSize), 10))
+	}
+
+	return page, nil
+}
+
+func decodeOffsetCursor(token string) (uint64, error) {
+	fields, err := decodeCursor(token, 1)
+	if err != nil {
+		return 0, err
+	}
+
+	offset, err := strconv.ParseUint(fields[0], 10, 64)
+	if err != nil {
+		return 0, errInvalidCursor
+	}
+
+	return offset, nil
+}
@@ -2,17 +2,156 @@ package chat
 
 import (
 	"context"
+	"errors"
 
 	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func (s *serv) SendMessage(ctx context.Context, createMessage *model.MessageCreate) (string, error) {
-	var id string
+// SendMessage stores the message from the caller and delivers it to the chat
+// subscribers
+func (s *serv) SendMessage(ctx context.Context, createMessage *model.MessageCreate) (*model.Message, error) {
+	message, err := s.StoreMessage(ctx, createMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	// deliver only after commit so subscribers never see a rolled back message
+	s.PublishMessage(message)
+
+	return message, nil
+}
+
+// StoreMessage is SendMessage without the delivery. A caller running it in
+// its own transaction publishes the message once that transaction commits.
+func (s *serv) StoreMessage(ctx context.Context, createMessage *model.MessageCreate) (*model.Message, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	createMessage.Info.UserID = userID
+
+	if createMessage.Info.Text == "" && len(createMessage.AttachmentIDs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "message must have text or attachments")
+	}
+
+	if createMessage.TTL < 0 {
+		return nil, status.Error(codes.InvalidArgument, "message time-to-live can't be negative")
+	}
+
+	chatID := createMessage.Info.ChatID
+
+	var message *model.Message
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		isMember, errTx := s.chatRepository.IsMember(ctx, chatID, userID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if !isMember {
+			return errNotMember
+		}
+
+		if createMessage.ReplyToID != "" {
+			parent, errParent := s.chatRepository.GetMessage(ctx, chatID, createMessage.ReplyToID)
+			if errParent != nil {
+				return errParent
+			}
+
+			if parent.DeletedAt != nil {
+				return errMessageDeleted
+			}
+
+			// threads are one level deep, replying to a reply joins its thread
+			if parent.ReplyToID != "" {
+				createMessage.ReplyToID = parent.ReplyToID
+			}
+		}
+
+		createMessage.Mentions, errTx = s.resolveMentions(ctx, chatID, createMessage.Info.Text)
+		if errTx != nil {
+			return errTx
+		}
+
+		message, errTx = s.appendMessage(ctx, createMessage)
+		if errTx != nil || len(createMessage.AttachmentIDs) == 0 {
+			return errTx
+		}
+
+		message.Attachments, errTx = s.chatRepository.LinkAttachments(ctx, chatID, message.ID, userID, createMessage.AttachmentIDs)
+		if errTx != nil {
+			return errTx
+		}
+
+		if len(message.Attachments) != len(createMessage.AttachmentIDs) {
+			return errAttachmentsUnavailable
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrChatNotFound) {
+			return nil, status.Errorf(codes.NotFound, "chat with id %d not found", chatID)
+		}
+		if errors.Is(err, errNotMember) {
+			return nil, status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID)
+		}
+		if errors.Is(err, repository.ErrMessageNotFound) {
+			return nil, status.Errorf(codes.InvalidArgument, "message %s to reply to not found in chat %d", createMessage.ReplyToID, chatID)
+		}
+		if errors.Is(err, errMessageDeleted) {
+			return nil, status.Error(codes.FailedPrecondition, "deleted message can't be replied to")
+		}
+		if errors.Is(err, errAttachmentsUnavailable) {
+			return nil, status.Errorf(codes.InvalidArgument, "attachments must be uploaded to chat %d by the sender and not sent yet", chatID)
+		}
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// PublishMessage delivers a stored message to the chat subscribers
+func (s *serv) PublishMessage(message *model.Message) {
+	s.hub.Publish(message.Info.ChatID, message)
+}
+
+// appendMessage adds the message to the end of the chat history.
+// It must run inside a transaction.
+func (s *serv) appendMessage(ctx context.Context, createMessage *model.MessageCreate) (*model.Message, error) {
+	chatID := createMessage.Info.ChatID
+
+	seq, err := s.chatRepository.NextMessageSeq(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := s.chatRepository.SendMessage(ctx, createMessage, seq)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(createMessage.Mentions) > 0 {
+		err = s.chatRepository.AddMentions(ctx, chatID, message.ID, createMessage.Mentions)
+		if err != nil {
+			return nil, err
+		}
+		message.Mentions = createMessage.Mentions
+	}
+
+	// the sender has obviously read everything up to their own message
+	_, err = s.chatRepository.MarkRead(ctx, chatID, createMessage.Info.UserID, seq)
+	if err != nil {
+		return nil, err
+	}
 
-	id, err := s.chatRepository.SendMessage(ctx, createMessage)
+	err = s.chatRepository.UpdateLastActivity(ctx, chatID)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return id, nil
+	return message, nil
 }
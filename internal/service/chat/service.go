@@ -1,20 +1,52 @@
 package chat
 
 import (
+	"github.com/BelyaevEI/microservices_chat/internal/client"
+	"github.com/BelyaevEI/microservices_chat/internal/hub"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/presence"
 	"github.com/BelyaevEI/microservices_chat/internal/repository"
 	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/BelyaevEI/microservices_chat/internal/storage"
+	"github.com/BelyaevEI/microservices_chat/internal/typing"
 	"github.com/BelyaevEI/platform_common/pkg/db"
 )
 
 type serv struct {
 	chatRepository repository.ChatRepository
 	txManager      db.TxManager
+	hub            hub.Hub[*model.Message]
+	userDirectory  client.UserDirectory
+	typingTracker  typing.Tracker
+	presence       presence.Tracker
+	maxPins        int
+
+	blobStore         storage.BlobStore
+	maxAttachmentSize int64
 }
 
 // NewService creates a new chat service.
-func NewService(chatRepository repository.ChatRepository, txManager db.TxManager) service.ChatService {
+func NewService(
+	chatRepository repository.ChatRepository,
+	txManager db.TxManager,
+	hub hub.Hub[*model.Message],
+	userDirectory client.UserDirectory,
+	typingTracker typing.Tracker,
+	presenceTracker presence.Tracker,
+	maxPins int,
+	blobStore storage.BlobStore,
+	maxAttachmentSize int64,
+) service.ChatService {
 	return &serv{
 		chatRepository: chatRepository,
 		txManager:      txManager,
+		hub:            hub,
+		userDirectory:  userDirectory,
+		typingTracker:  typingTracker,
+		presence:       presenceTracker,
+		maxPins:        maxPins,
+
+		blobStore:         blobStore,
+		maxAttachmentSize: maxAttachmentSize,
 	}
 }
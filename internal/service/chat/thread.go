@@ -0,0 +1,73 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serv) ListThread(ctx context.Context, query *model.ThreadQuery) (*model.ThreadPage, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkMember(ctx, query.ChatID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+
+	after, err := decodeMessageCursor(query.After)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	root, err := s.chatRepository.GetMessage(ctx, query.ChatID, query.RootID)
+	if err != nil {
+		if errors.Is(err, repository.ErrMessageNotFound) {
+			return nil, status.Errorf(codes.NotFound, "message %s not found in chat %d", query.RootID, query.ChatID)
+		}
+		return nil, err
+	}
+
+	// threads are one level deep, a reply has no replies of its own
+	if root.ReplyToID != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "message %s is a reply, not the root of a thread", query.RootID)
+	}
+
+	// one extra row tells us whether there are more replies
+	replies, err := s.chatRepository.ListThread(ctx, query.ChatID, query.RootID, userID, after, uint64(limit)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &model.ThreadPage{Root: root}
+	if len(replies) > int(limit) {
+		page.HasMore = true
+		replies = replies[:limit]
+	}
+
+	messages := append([]*model.Message{root}, replies...)
+
+	err = s.attachDetails(ctx, query.ChatID, messages...)
+	if err != nil {
+		return nil, err
+	}
+
+	page.Replies = replies
+	if len(replies) > 0 {
+		page.NextCursor = encodeCursor(strconv.FormatInt(replies[len(replies)-1].Seq, 10))
+	}
+
+	return page, nil
+}
@@ -0,0 +1,95 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetMessageTTL sets the time-to-live of messages sent to the chat from now on.
+// Zero keeps new messages forever, messages sent before are not affected.
+// Admins and the owner can change it.
+func (s *serv) SetMessageTTL(ctx context.Context, chatID int64, ttl time.Duration) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if ttl < 0 {
+		return status.Error(codes.InvalidArgument, "message time-to-live can't be negative")
+	}
+
+	err = s.checkRole(ctx, chatID, userID, model.MemberRoleAdmin, "change the settings of")
+	if err != nil {
+		return err
+	}
+
+	err = s.chatRepository.SetMessageTTL(ctx, chatID, ttl)
+	if err != nil {
+		if errors.Is(err, repository.ErrChatNotFound) {
+			return status.Errorf(codes.NotFound, "chat with id %d not found", chatID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// PurgeExpiredMessages removes up to limit messages whose time-to-live is over
+// with their attachments and announces them as deleted. It returns how many
+// messages were removed.
+func (s *serv) PurgeExpiredMessages(ctx context.Context, limit uint64) (int, error) {
+	var (
+		purged   []*model.Message
+		blobKeys []string
+	)
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		purged, blobKeys, errTx = s.chatRepository.DeleteExpiredMessages(ctx, limit)
+		if errTx != nil {
+			return errTx
+		}
+
+		type thread struct {
+			chatID int64
+			rootID string
+		}
+
+		replies := make(map[thread]int64)
+		for _, message := range purged {
+			if message.ReplyToID != "" {
+				replies[thread{message.Info.ChatID, message.ReplyToID}]++
+			}
+		}
+
+		for t, count := range replies {
+			errTx = s.chatRepository.DecrementReplyCount(ctx, t.chatID, t.rootID, count)
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		blobKeys, errTx = s.unusedBlobKeys(ctx, blobKeys)
+		return errTx
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	s.deleteBlobs(ctx, blobKeys)
+
+	// clients drop the message like one deleted for everyone
+	for _, message := range purged {
+		message.Info.Text = ""
+		message.DeletedAt = message.ExpiresAt
+		s.hub.Publish(message.Info.ChatID, message)
+	}
+
+	return len(purged), nil
+}
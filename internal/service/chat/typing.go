@@ -0,0 +1,78 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/typing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetTyping announces that the caller started or stopped typing.
+// Typing state lives in memory only and is never written to the database.
+func (s *serv) SetTyping(ctx context.Context, chatID, userID int64, isTyping bool) error {
+	callerUserID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if userID != callerUserID {
+		return status.Errorf(codes.PermissionDenied, "user %d cannot set typing state of user %d", callerUserID, userID)
+	}
+
+	err = s.checkMember(ctx, chatID, userID)
+	if err != nil {
+		return err
+	}
+
+	err = s.typingTracker.Set(chatID, userID, isTyping)
+	if err != nil {
+		if errors.Is(err, typing.ErrRateLimited) {
+			return status.Error(codes.ResourceExhausted, "typing updates are sent too often")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// WatchTyping streams typing indicators of the other members until ctx is done
+func (s *serv) WatchTyping(ctx context.Context, chatID int64) (<-chan *model.TypingEvent, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkMember(ctx, chatID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := s.typingTracker.Subscribe(chatID, userID)
+	go func() {
+		<-ctx.Done()
+		sub.Close()
+	}()
+
+	return sub.C, nil
+}
+
+// checkMember returns NotFound or PermissionDenied unless the user belongs to the chat
+func (s *serv) checkMember(ctx context.Context, chatID, userID int64) error {
+	isMember, err := s.chatRepository.IsMember(ctx, chatID, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrChatNotFound) {
+			return status.Errorf(codes.NotFound, "chat with id %d not found", chatID)
+		}
+		return err
+	}
+
+	if !isMember {
+		return status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID)
+	}
+
+	return nil
+}
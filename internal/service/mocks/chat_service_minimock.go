@@ -6,8 +6,10 @@ package mocks
 
 import (
 	"context"
+	"io"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/BelyaevEI/microservices_chat/internal/model"
@@ -19,23 +21,293 @@ type ChatServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAddMembers          func(ctx context.Context, chatID int64, userIDs []int64) (err error)
+	inspectFuncAddMembers   func(ctx context.Context, chatID int64, userIDs []int64)
+	afterAddMembersCounter  uint64
+	beforeAddMembersCounter uint64
+	AddMembersMock          mChatServiceMockAddMembers
+
+	funcAddReaction          func(ctx context.Context, reaction *model.Reaction) (err error)
+	inspectFuncAddReaction   func(ctx context.Context, reaction *model.Reaction)
+	afterAddReactionCounter  uint64
+	beforeAddReactionCounter uint64
+	AddReactionMock          mChatServiceMockAddReaction
+
+	funcCancelScheduledMessage          func(ctx context.Context, chatID int64, scheduledID string) (err error)
+	inspectFuncCancelScheduledMessage   func(ctx context.Context, chatID int64, scheduledID string)
+	afterCancelScheduledMessageCounter  uint64
+	beforeCancelScheduledMessageCounter uint64
+	CancelScheduledMessageMock          mChatServiceMockCancelScheduledMessage
+
+	funcClosePoll          func(ctx context.Context, chatID int64, messageID string) (pp1 *model.Poll, err error)
+	inspectFuncClosePoll   func(ctx context.Context, chatID int64, messageID string)
+	afterClosePollCounter  uint64
+	beforeClosePollCounter uint64
+	ClosePollMock          mChatServiceMockClosePoll
+
+	funcConnectChat          func(ctx context.Context, chatID int64) (ch1 <-chan *model.Message, err error)
+	inspectFuncConnectChat   func(ctx context.Context, chatID int64)
+	afterConnectChatCounter  uint64
+	beforeConnectChatCounter uint64
+	ConnectChatMock          mChatServiceMockConnectChat
+
 	funcCreateChat          func(ctx context.Context, createChat *model.ChatCreate) (i1 int64, err error)
 	inspectFuncCreateChat   func(ctx context.Context, createChat *model.ChatCreate)
 	afterCreateChatCounter  uint64
 	beforeCreateChatCounter uint64
 	CreateChatMock          mChatServiceMockCreateChat
 
+	funcCreateInviteLink          func(ctx context.Context, chatID int64, expiresAt *time.Time, maxUses int32) (ip1 *model.InviteLink, err error)
+	inspectFuncCreateInviteLink   func(ctx context.Context, chatID int64, expiresAt *time.Time, maxUses int32)
+	afterCreateInviteLinkCounter  uint64
+	beforeCreateInviteLinkCounter uint64
+	CreateInviteLinkMock          mChatServiceMockCreateInviteLink
+
+	funcCreatePoll          func(ctx context.Context, create *model.PollCreate) (mp1 *model.Message, err error)
+	inspectFuncCreatePoll   func(ctx context.Context, create *model.PollCreate)
+	afterCreatePollCounter  uint64
+	beforeCreatePollCounter uint64
+	CreatePollMock          mChatServiceMockCreatePoll
+
 	funcDeleteChat          func(ctx context.Context, id int64) (err error)
 	inspectFuncDeleteChat   func(ctx context.Context, id int64)
 	afterDeleteChatCounter  uint64
 	beforeDeleteChatCounter uint64
 	DeleteChatMock          mChatServiceMockDeleteChat
 
-	funcSendMessage          func(ctx context.Context, createMessage *model.MessageCreate) (s1 string, err error)
+	funcDeleteMessage          func(ctx context.Context, del *model.MessageDelete) (err error)
+	inspectFuncDeleteMessage   func(ctx context.Context, del *model.MessageDelete)
+	afterDeleteMessageCounter  uint64
+	beforeDeleteMessageCounter uint64
+	DeleteMessageMock          mChatServiceMockDeleteMessage
+
+	funcEditMessage          func(ctx context.Context, edit *model.MessageEdit) (mp1 *model.Message, err error)
+	inspectFuncEditMessage   func(ctx context.Context, edit *model.MessageEdit)
+	afterEditMessageCounter  uint64
+	beforeEditMessageCounter uint64
+	EditMessageMock          mChatServiceMockEditMessage
+
+	funcForwardMessages          func(ctx context.Context, sourceChatID int64, messageIDs []string, targetChatID int64) (mpa1 []*model.Message, err error)
+	inspectFuncForwardMessages   func(ctx context.Context, sourceChatID int64, messageIDs []string, targetChatID int64)
+	afterForwardMessagesCounter  uint64
+	beforeForwardMessagesCounter uint64
+	ForwardMessagesMock          mChatServiceMockForwardMessages
+
+	funcGetChat          func(ctx context.Context, id int64) (cp1 *model.Chat, err error)
+	inspectFuncGetChat   func(ctx context.Context, id int64)
+	afterGetChatCounter  uint64
+	beforeGetChatCounter uint64
+	GetChatMock          mChatServiceMockGetChat
+
+	funcGetMessageHistory          func(ctx context.Context, chatID int64, messageID string) (mpa1 []*model.MessageRevision, err error)
+	inspectFuncGetMessageHistory   func(ctx context.Context, chatID int64, messageID string)
+	afterGetMessageHistoryCounter  uint64
+	beforeGetMessageHistoryCounter uint64
+	GetMessageHistoryMock          mChatServiceMockGetMessageHistory
+
+	funcGetPresence          func(ctx context.Context, userIDs []int64) (ppa1 []*model.Presence, err error)
+	inspectFuncGetPresence   func(ctx context.Context, userIDs []int64)
+	afterGetPresenceCounter  uint64
+	beforeGetPresenceCounter uint64
+	GetPresenceMock          mChatServiceMockGetPresence
+
+	funcGetReadState          func(ctx context.Context, chatID int64) (rpa1 []*model.ReadState, err error)
+	inspectFuncGetReadState   func(ctx context.Context, chatID int64)
+	afterGetReadStateCounter  uint64
+	beforeGetReadStateCounter uint64
+	GetReadStateMock          mChatServiceMockGetReadState
+
+	funcHeartbeat          func(ctx context.Context) (err error)
+	inspectFuncHeartbeat   func(ctx context.Context)
+	afterHeartbeatCounter  uint64
+	beforeHeartbeatCounter uint64
+	HeartbeatMock          mChatServiceMockHeartbeat
+
+	funcJoinByInvite          func(ctx context.Context, token string) (i1 int64, err error)
+	inspectFuncJoinByInvite   func(ctx context.Context, token string)
+	afterJoinByInviteCounter  uint64
+	beforeJoinByInviteCounter uint64
+	JoinByInviteMock          mChatServiceMockJoinByInvite
+
+	funcLeaveChat          func(ctx context.Context, chatID int64, userID int64) (err error)
+	inspectFuncLeaveChat   func(ctx context.Context, chatID int64, userID int64)
+	afterLeaveChatCounter  uint64
+	beforeLeaveChatCounter uint64
+	LeaveChatMock          mChatServiceMockLeaveChat
+
+	funcListChats          func(ctx context.Context, userID int64, pageSize int32, pageToken string) (cp1 *model.ChatPage, err error)
+	inspectFuncListChats   func(ctx context.Context, userID int64, pageSize int32, pageToken string)
+	afterListChatsCounter  uint64
+	beforeListChatsCounter uint64
+	ListChatsMock          mChatServiceMockListChats
+
+	funcListMentions          func(ctx context.Context, userID int64, pageSize int32, pageToken string) (mp1 *model.MentionPage, err error)
+	inspectFuncListMentions   func(ctx context.Context, userID int64, pageSize int32, pageToken string)
+	afterListMentionsCounter  uint64
+	beforeListMentionsCounter uint64
+	ListMentionsMock          mChatServiceMockListMentions
+
+	funcListMessages          func(ctx context.Context, query *model.MessageListQuery) (mp1 *model.MessagePage, err error)
+	inspectFuncListMessages   func(ctx context.Context, query *model.MessageListQuery)
+	afterListMessagesCounter  uint64
+	beforeListMessagesCounter uint64
+	ListMessagesMock          mChatServiceMockListMessages
+
+	funcListPinned          func(ctx context.Context, chatID int64) (ppa1 []*model.PinnedMessage, err error)
+	inspectFuncListPinned   func(ctx context.Context, chatID int64)
+	afterListPinnedCounter  uint64
+	beforeListPinnedCounter uint64
+	ListPinnedMock          mChatServiceMockListPinned
+
+	funcListScheduledMessages          func(ctx context.Context, chatID int64) (spa1 []*model.ScheduledMessage, err error)
+	inspectFuncListScheduledMessages   func(ctx context.Context, chatID int64)
+	afterListScheduledMessagesCounter  uint64
+	beforeListScheduledMessagesCounter uint64
+	ListScheduledMessagesMock          mChatServiceMockListScheduledMessages
+
+	funcListThread          func(ctx context.Context, query *model.ThreadQuery) (tp1 *model.ThreadPage, err error)
+	inspectFuncListThread   func(ctx context.Context, query *model.ThreadQuery)
+	afterListThreadCounter  uint64
+	beforeListThreadCounter uint64
+	ListThreadMock          mChatServiceMockListThread
+
+	funcMarkRead          func(ctx context.Context, chatID int64, upToSeq int64) (rp1 *model.ReadMark, err error)
+	inspectFuncMarkRead   func(ctx context.Context, chatID int64, upToSeq int64)
+	afterMarkReadCounter  uint64
+	beforeMarkReadCounter uint64
+	MarkReadMock          mChatServiceMockMarkRead
+
+	funcOpenAttachment          func(ctx context.Context, attachmentID string) (ap1 *model.Attachment, r2 io.ReadCloser, err error)
+	inspectFuncOpenAttachment   func(ctx context.Context, attachmentID string)
+	afterOpenAttachmentCounter  uint64
+	beforeOpenAttachmentCounter uint64
+	OpenAttachmentMock          mChatServiceMockOpenAttachment
+
+	funcPinMessage          func(ctx context.Context, chatID int64, messageID string) (err error)
+	inspectFuncPinMessage   func(ctx context.Context, chatID int64, messageID string)
+	afterPinMessageCounter  uint64
+	beforePinMessageCounter uint64
+	PinMessageMock          mChatServiceMockPinMessage
+
+	funcPublishMessage          func(message *model.Message)
+	inspectFuncPublishMessage   func(message *model.Message)
+	afterPublishMessageCounter  uint64
+	beforePublishMessageCounter uint64
+	PublishMessageMock          mChatServiceMockPublishMessage
+
+	funcPurgeExpiredMessages          func(ctx context.Context, limit uint64) (i1 int, err error)
+	inspectFuncPurgeExpiredMessages   func(ctx context.Context, limit uint64)
+	afterPurgeExpiredMessagesCounter  uint64
+	beforePurgeExpiredMessagesCounter uint64
+	PurgeExpiredMessagesMock          mChatServiceMockPurgeExpiredMessages
+
+	funcPurgeStaleUploads          func(ctx context.Context, uploadedBefore time.Time, limit uint64) (i1 int, err error)
+	inspectFuncPurgeStaleUploads   func(ctx context.Context, uploadedBefore time.Time, limit uint64)
+	afterPurgeStaleUploadsCounter  uint64
+	beforePurgeStaleUploadsCounter uint64
+	PurgeStaleUploadsMock          mChatServiceMockPurgeStaleUploads
+
+	funcRemoveMembers          func(ctx context.Context, chatID int64, userIDs []int64) (err error)
+	inspectFuncRemoveMembers   func(ctx context.Context, chatID int64, userIDs []int64)
+	afterRemoveMembersCounter  uint64
+	beforeRemoveMembersCounter uint64
+	RemoveMembersMock          mChatServiceMockRemoveMembers
+
+	funcRemoveReaction          func(ctx context.Context, reaction *model.Reaction) (err error)
+	inspectFuncRemoveReaction   func(ctx context.Context, reaction *model.Reaction)
+	afterRemoveReactionCounter  uint64
+	beforeRemoveReactionCounter uint64
+	RemoveReactionMock          mChatServiceMockRemoveReaction
+
+	funcRenameChat          func(ctx context.Context, chatID int64, name string) (err error)
+	inspectFuncRenameChat   func(ctx context.Context, chatID int64, name string)
+	afterRenameChatCounter  uint64
+	beforeRenameChatCounter uint64
+	RenameChatMock          mChatServiceMockRenameChat
+
+	funcRevokeInviteLink          func(ctx context.Context, chatID int64, inviteID string) (err error)
+	inspectFuncRevokeInviteLink   func(ctx context.Context, chatID int64, inviteID string)
+	afterRevokeInviteLinkCounter  uint64
+	beforeRevokeInviteLinkCounter uint64
+	RevokeInviteLinkMock          mChatServiceMockRevokeInviteLink
+
+	funcScheduleMessage          func(ctx context.Context, chatID int64, text string, sendAt time.Time) (sp1 *model.ScheduledMessage, err error)
+	inspectFuncScheduleMessage   func(ctx context.Context, chatID int64, text string, sendAt time.Time)
+	afterScheduleMessageCounter  uint64
+	beforeScheduleMessageCounter uint64
+	ScheduleMessageMock          mChatServiceMockScheduleMessage
+
+	funcSearchMessages          func(ctx context.Context, query *model.SearchQuery) (sp1 *model.SearchPage, err error)
+	inspectFuncSearchMessages   func(ctx context.Context, query *model.SearchQuery)
+	afterSearchMessagesCounter  uint64
+	beforeSearchMessagesCounter uint64
+	SearchMessagesMock          mChatServiceMockSearchMessages
+
+	funcSendMessage          func(ctx context.Context, createMessage *model.MessageCreate) (mp1 *model.Message, err error)
 	inspectFuncSendMessage   func(ctx context.Context, createMessage *model.MessageCreate)
 	afterSendMessageCounter  uint64
 	beforeSendMessageCounter uint64
 	SendMessageMock          mChatServiceMockSendMessage
+
+	funcSetMemberRole          func(ctx context.Context, chatID int64, userID int64, role string) (err error)
+	inspectFuncSetMemberRole   func(ctx context.Context, chatID int64, userID int64, role string)
+	afterSetMemberRoleCounter  uint64
+	beforeSetMemberRoleCounter uint64
+	SetMemberRoleMock          mChatServiceMockSetMemberRole
+
+	funcSetMessageTTL          func(ctx context.Context, chatID int64, ttl time.Duration) (err error)
+	inspectFuncSetMessageTTL   func(ctx context.Context, chatID int64, ttl time.Duration)
+	afterSetMessageTTLCounter  uint64
+	beforeSetMessageTTLCounter uint64
+	SetMessageTTLMock          mChatServiceMockSetMessageTTL
+
+	funcSetTyping          func(ctx context.Context, chatID int64, userID int64, isTyping bool) (err error)
+	inspectFuncSetTyping   func(ctx context.Context, chatID int64, userID int64, isTyping bool)
+	afterSetTypingCounter  uint64
+	beforeSetTypingCounter uint64
+	SetTypingMock          mChatServiceMockSetTyping
+
+	funcStoreMessage          func(ctx context.Context, createMessage *model.MessageCreate) (mp1 *model.Message, err error)
+	inspectFuncStoreMessage   func(ctx context.Context, createMessage *model.MessageCreate)
+	afterStoreMessageCounter  uint64
+	beforeStoreMessageCounter uint64
+	StoreMessageMock          mChatServiceMockStoreMessage
+
+	funcTransferOwnership          func(ctx context.Context, chatID int64, userID int64) (err error)
+	inspectFuncTransferOwnership   func(ctx context.Context, chatID int64, userID int64)
+	afterTransferOwnershipCounter  uint64
+	beforeTransferOwnershipCounter uint64
+	TransferOwnershipMock          mChatServiceMockTransferOwnership
+
+	funcUnpinMessage          func(ctx context.Context, chatID int64, messageID string) (err error)
+	inspectFuncUnpinMessage   func(ctx context.Context, chatID int64, messageID string)
+	afterUnpinMessageCounter  uint64
+	beforeUnpinMessageCounter uint64
+	UnpinMessageMock          mChatServiceMockUnpinMessage
+
+	funcUploadAttachment          func(ctx context.Context, upload *model.AttachmentUpload, content io.Reader) (ap1 *model.Attachment, err error)
+	inspectFuncUploadAttachment   func(ctx context.Context, upload *model.AttachmentUpload, content io.Reader)
+	afterUploadAttachmentCounter  uint64
+	beforeUploadAttachmentCounter uint64
+	UploadAttachmentMock          mChatServiceMockUploadAttachment
+
+	funcVote          func(ctx context.Context, vote *model.PollVote) (pp1 *model.Poll, err error)
+	inspectFuncVote   func(ctx context.Context, vote *model.PollVote)
+	afterVoteCounter  uint64
+	beforeVoteCounter uint64
+	VoteMock          mChatServiceMockVote
+
+	funcWatchPresence          func(ctx context.Context) (ch1 <-chan *model.Presence, err error)
+	inspectFuncWatchPresence   func(ctx context.Context)
+	afterWatchPresenceCounter  uint64
+	beforeWatchPresenceCounter uint64
+	WatchPresenceMock          mChatServiceMockWatchPresence
+
+	funcWatchTyping          func(ctx context.Context, chatID int64) (ch1 <-chan *model.TypingEvent, err error)
+	inspectFuncWatchTyping   func(ctx context.Context, chatID int64)
+	afterWatchTypingCounter  uint64
+	beforeWatchTypingCounter uint64
+	WatchTypingMock          mChatServiceMockWatchTyping
 }
 
 // NewChatServiceMock returns a mock for service.ChatService
@@ -46,56 +318,15347 @@ func NewChatServiceMock(t minimock.Tester) *ChatServiceMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddMembersMock = mChatServiceMockAddMembers{mock: m}
+	m.AddMembersMock.callArgs = []*ChatServiceMockAddMembersParams{}
+
+	m.AddReactionMock = mChatServiceMockAddReaction{mock: m}
+	m.AddReactionMock.callArgs = []*ChatServiceMockAddReactionParams{}
+
+	m.CancelScheduledMessageMock = mChatServiceMockCancelScheduledMessage{mock: m}
+	m.CancelScheduledMessageMock.callArgs = []*ChatServiceMockCancelScheduledMessageParams{}
+
+	m.ClosePollMock = mChatServiceMockClosePoll{mock: m}
+	m.ClosePollMock.callArgs = []*ChatServiceMockClosePollParams{}
+
+	m.ConnectChatMock = mChatServiceMockConnectChat{mock: m}
+	m.ConnectChatMock.callArgs = []*ChatServiceMockConnectChatParams{}
+
 	m.CreateChatMock = mChatServiceMockCreateChat{mock: m}
 	m.CreateChatMock.callArgs = []*ChatServiceMockCreateChatParams{}
 
+	m.CreateInviteLinkMock = mChatServiceMockCreateInviteLink{mock: m}
+	m.CreateInviteLinkMock.callArgs = []*ChatServiceMockCreateInviteLinkParams{}
+
+	m.CreatePollMock = mChatServiceMockCreatePoll{mock: m}
+	m.CreatePollMock.callArgs = []*ChatServiceMockCreatePollParams{}
+
 	m.DeleteChatMock = mChatServiceMockDeleteChat{mock: m}
 	m.DeleteChatMock.callArgs = []*ChatServiceMockDeleteChatParams{}
 
+	m.DeleteMessageMock = mChatServiceMockDeleteMessage{mock: m}
+	m.DeleteMessageMock.callArgs = []*ChatServiceMockDeleteMessageParams{}
+
+	m.EditMessageMock = mChatServiceMockEditMessage{mock: m}
+	m.EditMessageMock.callArgs = []*ChatServiceMockEditMessageParams{}
+
+	m.ForwardMessagesMock = mChatServiceMockForwardMessages{mock: m}
+	m.ForwardMessagesMock.callArgs = []*ChatServiceMockForwardMessagesParams{}
+
+	m.GetChatMock = mChatServiceMockGetChat{mock: m}
+	m.GetChatMock.callArgs = []*ChatServiceMockGetChatParams{}
+
+	m.GetMessageHistoryMock = mChatServiceMockGetMessageHistory{mock: m}
+	m.GetMessageHistoryMock.callArgs = []*ChatServiceMockGetMessageHistoryParams{}
+
+	m.GetPresenceMock = mChatServiceMockGetPresence{mock: m}
+	m.GetPresenceMock.callArgs = []*ChatServiceMockGetPresenceParams{}
+
+	m.GetReadStateMock = mChatServiceMockGetReadState{mock: m}
+	m.GetReadStateMock.callArgs = []*ChatServiceMockGetReadStateParams{}
+
+	m.HeartbeatMock = mChatServiceMockHeartbeat{mock: m}
+	m.HeartbeatMock.callArgs = []*ChatServiceMockHeartbeatParams{}
+
+	m.JoinByInviteMock = mChatServiceMockJoinByInvite{mock: m}
+	m.JoinByInviteMock.callArgs = []*ChatServiceMockJoinByInviteParams{}
+
+	m.LeaveChatMock = mChatServiceMockLeaveChat{mock: m}
+	m.LeaveChatMock.callArgs = []*ChatServiceMockLeaveChatParams{}
+
+	m.ListChatsMock = mChatServiceMockListChats{mock: m}
+	m.ListChatsMock.callArgs = []*ChatServiceMockListChatsParams{}
+
+	m.ListMentionsMock = mChatServiceMockListMentions{mock: m}
+	m.ListMentionsMock.callArgs = []*ChatServiceMockListMentionsParams{}
+
+	m.ListMessagesMock = mChatServiceMockListMessages{mock: m}
+	m.ListMessagesMock.callArgs = []*ChatServiceMockListMessagesParams{}
+
+	m.ListPinnedMock = mChatServiceMockListPinned{mock: m}
+	m.ListPinnedMock.callArgs = []*ChatServiceMockListPinnedParams{}
+
+	m.ListScheduledMessagesMock = mChatServiceMockListScheduledMessages{mock: m}
+	m.ListScheduledMessagesMock.callArgs = []*ChatServiceMockListScheduledMessagesParams{}
+
+	m.ListThreadMock = mChatServiceMockListThread{mock: m}
+	m.ListThreadMock.callArgs = []*ChatServiceMockListThreadParams{}
+
+	m.MarkReadMock = mChatServiceMockMarkRead{mock: m}
+	m.MarkReadMock.callArgs = []*ChatServiceMockMarkReadParams{}
+
+	m.OpenAttachmentMock = mChatServiceMockOpenAttachment{mock: m}
+	m.OpenAttachmentMock.callArgs = []*ChatServiceMockOpenAttachmentParams{}
+
+	m.PinMessageMock = mChatServiceMockPinMessage{mock: m}
+	m.PinMessageMock.callArgs = []*ChatServiceMockPinMessageParams{}
+
+	m.PublishMessageMock = mChatServiceMockPublishMessage{mock: m}
+	m.PublishMessageMock.callArgs = []*ChatServiceMockPublishMessageParams{}
+
+	m.PurgeExpiredMessagesMock = mChatServiceMockPurgeExpiredMessages{mock: m}
+	m.PurgeExpiredMessagesMock.callArgs = []*ChatServiceMockPurgeExpiredMessagesParams{}
+
+	m.PurgeStaleUploadsMock = mChatServiceMockPurgeStaleUploads{mock: m}
+	m.PurgeStaleUploadsMock.callArgs = []*ChatServiceMockPurgeStaleUploadsParams{}
+
+	m.RemoveMembersMock = mChatServiceMockRemoveMembers{mock: m}
+	m.RemoveMembersMock.callArgs = []*ChatServiceMockRemoveMembersParams{}
+
+	m.RemoveReactionMock = mChatServiceMockRemoveReaction{mock: m}
+	m.RemoveReactionMock.callArgs = []*ChatServiceMockRemoveReactionParams{}
+
+	m.RenameChatMock = mChatServiceMockRenameChat{mock: m}
+	m.RenameChatMock.callArgs = []*ChatServiceMockRenameChatParams{}
+
+	m.RevokeInviteLinkMock = mChatServiceMockRevokeInviteLink{mock: m}
+	m.RevokeInviteLinkMock.callArgs = []*ChatServiceMockRevokeInviteLinkParams{}
+
+	m.ScheduleMessageMock = mChatServiceMockScheduleMessage{mock: m}
+	m.ScheduleMessageMock.callArgs = []*ChatServiceMockScheduleMessageParams{}
+
+	m.SearchMessagesMock = mChatServiceMockSearchMessages{mock: m}
+	m.SearchMessagesMock.callArgs = []*ChatServiceMockSearchMessagesParams{}
+
 	m.SendMessageMock = mChatServiceMockSendMessage{mock: m}
 	m.SendMessageMock.callArgs = []*ChatServiceMockSendMessageParams{}
 
+	m.SetMemberRoleMock = mChatServiceMockSetMemberRole{mock: m}
+	m.SetMemberRoleMock.callArgs = []*ChatServiceMockSetMemberRoleParams{}
+
+	m.SetMessageTTLMock = mChatServiceMockSetMessageTTL{mock: m}
+	m.SetMessageTTLMock.callArgs = []*ChatServiceMockSetMessageTTLParams{}
+
+	m.SetTypingMock = mChatServiceMockSetTyping{mock: m}
+	m.SetTypingMock.callArgs = []*ChatServiceMockSetTypingParams{}
+
+	m.StoreMessageMock = mChatServiceMockStoreMessage{mock: m}
+	m.StoreMessageMock.callArgs = []*ChatServiceMockStoreMessageParams{}
+
+	m.TransferOwnershipMock = mChatServiceMockTransferOwnership{mock: m}
+	m.TransferOwnershipMock.callArgs = []*ChatServiceMockTransferOwnershipParams{}
+
+	m.UnpinMessageMock = mChatServiceMockUnpinMessage{mock: m}
+	m.UnpinMessageMock.callArgs = []*ChatServiceMockUnpinMessageParams{}
+
+	m.UploadAttachmentMock = mChatServiceMockUploadAttachment{mock: m}
+	m.UploadAttachmentMock.callArgs = []*ChatServiceMockUploadAttachmentParams{}
+
+	m.VoteMock = mChatServiceMockVote{mock: m}
+	m.VoteMock.callArgs = []*ChatServiceMockVoteParams{}
+
+	m.WatchPresenceMock = mChatServiceMockWatchPresence{mock: m}
+	m.WatchPresenceMock.callArgs = []*ChatServiceMockWatchPresenceParams{}
+
+	m.WatchTypingMock = mChatServiceMockWatchTyping{mock: m}
+	m.WatchTypingMock.callArgs = []*ChatServiceMockWatchTypingParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mChatServiceMockCreateChat struct {
+type mChatServiceMockAddMembers struct {
 	optional           bool
 	mock               *ChatServiceMock
-	defaultExpectation *ChatServiceMockCreateChatExpectation
-	expectations       []*ChatServiceMockCreateChatExpectation
+	defaultExpectation *ChatServiceMockAddMembersExpectation
+	expectations       []*ChatServiceMockAddMembersExpectation
 
-	callArgs []*ChatServiceMockCreateChatParams
+	callArgs []*ChatServiceMockAddMembersParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// ChatServiceMockCreateChatExpectation specifies expectation struct of the ChatService.CreateChat
-type ChatServiceMockCreateChatExpectation struct {
+// ChatServiceMockAddMembersExpectation specifies expectation struct of the ChatService.AddMembers
+type ChatServiceMockAddMembersExpectation struct {
 	mock      *ChatServiceMock
-	params    *ChatServiceMockCreateChatParams
-	paramPtrs *ChatServiceMockCreateChatParamPtrs
-	results   *ChatServiceMockCreateChatResults
+	params    *ChatServiceMockAddMembersParams
+	paramPtrs *ChatServiceMockAddMembersParamPtrs
+	results   *ChatServiceMockAddMembersResults
 	Counter   uint64
 }
 
-// ChatServiceMockCreateChatParams contains parameters of the ChatService.CreateChat
-type ChatServiceMockCreateChatParams struct {
-	ctx        context.Context
-	createChat *model.ChatCreate
+// ChatServiceMockAddMembersParams contains parameters of the ChatService.AddMembers
+type ChatServiceMockAddMembersParams struct {
+	ctx     context.Context
+	chatID  int64
+	userIDs []int64
+}
+
+// ChatServiceMockAddMembersParamPtrs contains pointers to parameters of the ChatService.AddMembers
+type ChatServiceMockAddMembersParamPtrs struct {
+	ctx     *context.Context
+	chatID  *int64
+	userIDs *[]int64
+}
+
+// ChatServiceMockAddMembersResults contains results of the ChatService.AddMembers
+type ChatServiceMockAddMembersResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddMembers *mChatServiceMockAddMembers) Optional() *mChatServiceMockAddMembers {
+	mmAddMembers.optional = true
+	return mmAddMembers
+}
+
+// Expect sets up expected params for ChatService.AddMembers
+func (mmAddMembers *mChatServiceMockAddMembers) Expect(ctx context.Context, chatID int64, userIDs []int64) *mChatServiceMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatServiceMockAddMembersExpectation{}
+	}
+
+	if mmAddMembers.defaultExpectation.paramPtrs != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by ExpectParams functions")
+	}
+
+	mmAddMembers.defaultExpectation.params = &ChatServiceMockAddMembersParams{ctx, chatID, userIDs}
+	for _, e := range mmAddMembers.expectations {
+		if minimock.Equal(e.params, mmAddMembers.defaultExpectation.params) {
+			mmAddMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddMembers.defaultExpectation.params)
+		}
+	}
+
+	return mmAddMembers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.AddMembers
+func (mmAddMembers *mChatServiceMockAddMembers) ExpectCtxParam1(ctx context.Context) *mChatServiceMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatServiceMockAddMembersExpectation{}
+	}
+
+	if mmAddMembers.defaultExpectation.params != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Expect")
+	}
+
+	if mmAddMembers.defaultExpectation.paramPtrs == nil {
+		mmAddMembers.defaultExpectation.paramPtrs = &ChatServiceMockAddMembersParamPtrs{}
+	}
+	mmAddMembers.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAddMembers
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.AddMembers
+func (mmAddMembers *mChatServiceMockAddMembers) ExpectChatIDParam2(chatID int64) *mChatServiceMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatServiceMockAddMembersExpectation{}
+	}
+
+	if mmAddMembers.defaultExpectation.params != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Expect")
+	}
+
+	if mmAddMembers.defaultExpectation.paramPtrs == nil {
+		mmAddMembers.defaultExpectation.paramPtrs = &ChatServiceMockAddMembersParamPtrs{}
+	}
+	mmAddMembers.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmAddMembers
+}
+
+// ExpectUserIDsParam3 sets up expected param userIDs for ChatService.AddMembers
+func (mmAddMembers *mChatServiceMockAddMembers) ExpectUserIDsParam3(userIDs []int64) *mChatServiceMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatServiceMockAddMembersExpectation{}
+	}
+
+	if mmAddMembers.defaultExpectation.params != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Expect")
+	}
+
+	if mmAddMembers.defaultExpectation.paramPtrs == nil {
+		mmAddMembers.defaultExpectation.paramPtrs = &ChatServiceMockAddMembersParamPtrs{}
+	}
+	mmAddMembers.defaultExpectation.paramPtrs.userIDs = &userIDs
+
+	return mmAddMembers
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.AddMembers
+func (mmAddMembers *mChatServiceMockAddMembers) Inspect(f func(ctx context.Context, chatID int64, userIDs []int64)) *mChatServiceMockAddMembers {
+	if mmAddMembers.mock.inspectFuncAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.AddMembers")
+	}
+
+	mmAddMembers.mock.inspectFuncAddMembers = f
+
+	return mmAddMembers
+}
+
+// Return sets up results that will be returned by ChatService.AddMembers
+func (mmAddMembers *mChatServiceMockAddMembers) Return(err error) *ChatServiceMock {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatServiceMockAddMembersExpectation{mock: mmAddMembers.mock}
+	}
+	mmAddMembers.defaultExpectation.results = &ChatServiceMockAddMembersResults{err}
+	return mmAddMembers.mock
+}
+
+// Set uses given function f to mock the ChatService.AddMembers method
+func (mmAddMembers *mChatServiceMockAddMembers) Set(f func(ctx context.Context, chatID int64, userIDs []int64) (err error)) *ChatServiceMock {
+	if mmAddMembers.defaultExpectation != nil {
+		mmAddMembers.mock.t.Fatalf("Default expectation is already set for the ChatService.AddMembers method")
+	}
+
+	if len(mmAddMembers.expectations) > 0 {
+		mmAddMembers.mock.t.Fatalf("Some expectations are already set for the ChatService.AddMembers method")
+	}
+
+	mmAddMembers.mock.funcAddMembers = f
+	return mmAddMembers.mock
+}
+
+// When sets expectation for the ChatService.AddMembers which will trigger the result defined by the following
+// Then helper
+func (mmAddMembers *mChatServiceMockAddMembers) When(ctx context.Context, chatID int64, userIDs []int64) *ChatServiceMockAddMembersExpectation {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockAddMembersExpectation{
+		mock:   mmAddMembers.mock,
+		params: &ChatServiceMockAddMembersParams{ctx, chatID, userIDs},
+	}
+	mmAddMembers.expectations = append(mmAddMembers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.AddMembers return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockAddMembersExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockAddMembersResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.AddMembers should be invoked
+func (mmAddMembers *mChatServiceMockAddMembers) Times(n uint64) *mChatServiceMockAddMembers {
+	if n == 0 {
+		mmAddMembers.mock.t.Fatalf("Times of ChatServiceMock.AddMembers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddMembers.expectedInvocations, n)
+	return mmAddMembers
+}
+
+func (mmAddMembers *mChatServiceMockAddMembers) invocationsDone() bool {
+	if len(mmAddMembers.expectations) == 0 && mmAddMembers.defaultExpectation == nil && mmAddMembers.mock.funcAddMembers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddMembers.mock.afterAddMembersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddMembers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddMembers implements service.ChatService
+func (mmAddMembers *ChatServiceMock) AddMembers(ctx context.Context, chatID int64, userIDs []int64) (err error) {
+	mm_atomic.AddUint64(&mmAddMembers.beforeAddMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddMembers.afterAddMembersCounter, 1)
+
+	if mmAddMembers.inspectFuncAddMembers != nil {
+		mmAddMembers.inspectFuncAddMembers(ctx, chatID, userIDs)
+	}
+
+	mm_params := ChatServiceMockAddMembersParams{ctx, chatID, userIDs}
+
+	// Record call args
+	mmAddMembers.AddMembersMock.mutex.Lock()
+	mmAddMembers.AddMembersMock.callArgs = append(mmAddMembers.AddMembersMock.callArgs, &mm_params)
+	mmAddMembers.AddMembersMock.mutex.Unlock()
+
+	for _, e := range mmAddMembers.AddMembersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddMembers.AddMembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddMembers.AddMembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddMembers.AddMembersMock.defaultExpectation.params
+		mm_want_ptrs := mmAddMembers.AddMembersMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockAddMembersParams{ctx, chatID, userIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddMembers.t.Errorf("ChatServiceMock.AddMembers got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmAddMembers.t.Errorf("ChatServiceMock.AddMembers got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.userIDs != nil && !minimock.Equal(*mm_want_ptrs.userIDs, mm_got.userIDs) {
+				mmAddMembers.t.Errorf("ChatServiceMock.AddMembers got unexpected parameter userIDs, want: %#v, got: %#v%s\n", *mm_want_ptrs.userIDs, mm_got.userIDs, minimock.Diff(*mm_want_ptrs.userIDs, mm_got.userIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddMembers.t.Errorf("ChatServiceMock.AddMembers got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddMembers.AddMembersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddMembers.t.Fatal("No results are set for the ChatServiceMock.AddMembers")
+		}
+		return (*mm_results).err
+	}
+	if mmAddMembers.funcAddMembers != nil {
+		return mmAddMembers.funcAddMembers(ctx, chatID, userIDs)
+	}
+	mmAddMembers.t.Fatalf("Unexpected call to ChatServiceMock.AddMembers. %v %v %v", ctx, chatID, userIDs)
+	return
+}
+
+// AddMembersAfterCounter returns a count of finished ChatServiceMock.AddMembers invocations
+func (mmAddMembers *ChatServiceMock) AddMembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMembers.afterAddMembersCounter)
+}
+
+// AddMembersBeforeCounter returns a count of ChatServiceMock.AddMembers invocations
+func (mmAddMembers *ChatServiceMock) AddMembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMembers.beforeAddMembersCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.AddMembers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddMembers *mChatServiceMockAddMembers) Calls() []*ChatServiceMockAddMembersParams {
+	mmAddMembers.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockAddMembersParams, len(mmAddMembers.callArgs))
+	copy(argCopy, mmAddMembers.callArgs)
+
+	mmAddMembers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddMembersDone returns true if the count of the AddMembers invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockAddMembersDone() bool {
+	if m.AddMembersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddMembersMock.invocationsDone()
+}
+
+// MinimockAddMembersInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockAddMembersInspect() {
+	for _, e := range m.AddMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.AddMembers with params: %#v", *e.params)
+		}
+	}
+
+	afterAddMembersCounter := mm_atomic.LoadUint64(&m.afterAddMembersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMembersMock.defaultExpectation != nil && afterAddMembersCounter < 1 {
+		if m.AddMembersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.AddMembers")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.AddMembers with params: %#v", *m.AddMembersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddMembers != nil && afterAddMembersCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.AddMembers")
+	}
+
+	if !m.AddMembersMock.invocationsDone() && afterAddMembersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.AddMembers but found %d calls",
+			mm_atomic.LoadUint64(&m.AddMembersMock.expectedInvocations), afterAddMembersCounter)
+	}
+}
+
+type mChatServiceMockAddReaction struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockAddReactionExpectation
+	expectations       []*ChatServiceMockAddReactionExpectation
+
+	callArgs []*ChatServiceMockAddReactionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockAddReactionExpectation specifies expectation struct of the ChatService.AddReaction
+type ChatServiceMockAddReactionExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockAddReactionParams
+	paramPtrs *ChatServiceMockAddReactionParamPtrs
+	results   *ChatServiceMockAddReactionResults
+	Counter   uint64
+}
+
+// ChatServiceMockAddReactionParams contains parameters of the ChatService.AddReaction
+type ChatServiceMockAddReactionParams struct {
+	ctx      context.Context
+	reaction *model.Reaction
+}
+
+// ChatServiceMockAddReactionParamPtrs contains pointers to parameters of the ChatService.AddReaction
+type ChatServiceMockAddReactionParamPtrs struct {
+	ctx      *context.Context
+	reaction **model.Reaction
+}
+
+// ChatServiceMockAddReactionResults contains results of the ChatService.AddReaction
+type ChatServiceMockAddReactionResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddReaction *mChatServiceMockAddReaction) Optional() *mChatServiceMockAddReaction {
+	mmAddReaction.optional = true
+	return mmAddReaction
+}
+
+// Expect sets up expected params for ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) Expect(ctx context.Context, reaction *model.Reaction) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by ExpectParams functions")
+	}
+
+	mmAddReaction.defaultExpectation.params = &ChatServiceMockAddReactionParams{ctx, reaction}
+	for _, e := range mmAddReaction.expectations {
+		if minimock.Equal(e.params, mmAddReaction.defaultExpectation.params) {
+			mmAddReaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddReaction.defaultExpectation.params)
+		}
+	}
+
+	return mmAddReaction
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) ExpectCtxParam1(ctx context.Context) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ChatServiceMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAddReaction
+}
+
+// ExpectReactionParam2 sets up expected param reaction for ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) ExpectReactionParam2(reaction *model.Reaction) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ChatServiceMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.reaction = &reaction
+
+	return mmAddReaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) Inspect(f func(ctx context.Context, reaction *model.Reaction)) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.inspectFuncAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.AddReaction")
+	}
+
+	mmAddReaction.mock.inspectFuncAddReaction = f
+
+	return mmAddReaction
+}
+
+// Return sets up results that will be returned by ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) Return(err error) *ChatServiceMock {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{mock: mmAddReaction.mock}
+	}
+	mmAddReaction.defaultExpectation.results = &ChatServiceMockAddReactionResults{err}
+	return mmAddReaction.mock
+}
+
+// Set uses given function f to mock the ChatService.AddReaction method
+func (mmAddReaction *mChatServiceMockAddReaction) Set(f func(ctx context.Context, reaction *model.Reaction) (err error)) *ChatServiceMock {
+	if mmAddReaction.defaultExpectation != nil {
+		mmAddReaction.mock.t.Fatalf("Default expectation is already set for the ChatService.AddReaction method")
+	}
+
+	if len(mmAddReaction.expectations) > 0 {
+		mmAddReaction.mock.t.Fatalf("Some expectations are already set for the ChatService.AddReaction method")
+	}
+
+	mmAddReaction.mock.funcAddReaction = f
+	return mmAddReaction.mock
+}
+
+// When sets expectation for the ChatService.AddReaction which will trigger the result defined by the following
+// Then helper
+func (mmAddReaction *mChatServiceMockAddReaction) When(ctx context.Context, reaction *model.Reaction) *ChatServiceMockAddReactionExpectation {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockAddReactionExpectation{
+		mock:   mmAddReaction.mock,
+		params: &ChatServiceMockAddReactionParams{ctx, reaction},
+	}
+	mmAddReaction.expectations = append(mmAddReaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.AddReaction return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockAddReactionExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockAddReactionResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.AddReaction should be invoked
+func (mmAddReaction *mChatServiceMockAddReaction) Times(n uint64) *mChatServiceMockAddReaction {
+	if n == 0 {
+		mmAddReaction.mock.t.Fatalf("Times of ChatServiceMock.AddReaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddReaction.expectedInvocations, n)
+	return mmAddReaction
+}
+
+func (mmAddReaction *mChatServiceMockAddReaction) invocationsDone() bool {
+	if len(mmAddReaction.expectations) == 0 && mmAddReaction.defaultExpectation == nil && mmAddReaction.mock.funcAddReaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddReaction.mock.afterAddReactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddReaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddReaction implements service.ChatService
+func (mmAddReaction *ChatServiceMock) AddReaction(ctx context.Context, reaction *model.Reaction) (err error) {
+	mm_atomic.AddUint64(&mmAddReaction.beforeAddReactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddReaction.afterAddReactionCounter, 1)
+
+	if mmAddReaction.inspectFuncAddReaction != nil {
+		mmAddReaction.inspectFuncAddReaction(ctx, reaction)
+	}
+
+	mm_params := ChatServiceMockAddReactionParams{ctx, reaction}
+
+	// Record call args
+	mmAddReaction.AddReactionMock.mutex.Lock()
+	mmAddReaction.AddReactionMock.callArgs = append(mmAddReaction.AddReactionMock.callArgs, &mm_params)
+	mmAddReaction.AddReactionMock.mutex.Unlock()
+
+	for _, e := range mmAddReaction.AddReactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddReaction.AddReactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddReaction.AddReactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddReaction.AddReactionMock.defaultExpectation.params
+		mm_want_ptrs := mmAddReaction.AddReactionMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockAddReactionParams{ctx, reaction}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddReaction.t.Errorf("ChatServiceMock.AddReaction got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.reaction != nil && !minimock.Equal(*mm_want_ptrs.reaction, mm_got.reaction) {
+				mmAddReaction.t.Errorf("ChatServiceMock.AddReaction got unexpected parameter reaction, want: %#v, got: %#v%s\n", *mm_want_ptrs.reaction, mm_got.reaction, minimock.Diff(*mm_want_ptrs.reaction, mm_got.reaction))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddReaction.t.Errorf("ChatServiceMock.AddReaction got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddReaction.AddReactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddReaction.t.Fatal("No results are set for the ChatServiceMock.AddReaction")
+		}
+		return (*mm_results).err
+	}
+	if mmAddReaction.funcAddReaction != nil {
+		return mmAddReaction.funcAddReaction(ctx, reaction)
+	}
+	mmAddReaction.t.Fatalf("Unexpected call to ChatServiceMock.AddReaction. %v %v", ctx, reaction)
+	return
+}
+
+// AddReactionAfterCounter returns a count of finished ChatServiceMock.AddReaction invocations
+func (mmAddReaction *ChatServiceMock) AddReactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReaction.afterAddReactionCounter)
+}
+
+// AddReactionBeforeCounter returns a count of ChatServiceMock.AddReaction invocations
+func (mmAddReaction *ChatServiceMock) AddReactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReaction.beforeAddReactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.AddReaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddReaction *mChatServiceMockAddReaction) Calls() []*ChatServiceMockAddReactionParams {
+	mmAddReaction.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockAddReactionParams, len(mmAddReaction.callArgs))
+	copy(argCopy, mmAddReaction.callArgs)
+
+	mmAddReaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddReactionDone returns true if the count of the AddReaction invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockAddReactionDone() bool {
+	if m.AddReactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddReactionMock.invocationsDone()
+}
+
+// MinimockAddReactionInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockAddReactionInspect() {
+	for _, e := range m.AddReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.AddReaction with params: %#v", *e.params)
+		}
+	}
+
+	afterAddReactionCounter := mm_atomic.LoadUint64(&m.afterAddReactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddReactionMock.defaultExpectation != nil && afterAddReactionCounter < 1 {
+		if m.AddReactionMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.AddReaction")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.AddReaction with params: %#v", *m.AddReactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddReaction != nil && afterAddReactionCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.AddReaction")
+	}
+
+	if !m.AddReactionMock.invocationsDone() && afterAddReactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.AddReaction but found %d calls",
+			mm_atomic.LoadUint64(&m.AddReactionMock.expectedInvocations), afterAddReactionCounter)
+	}
+}
+
+type mChatServiceMockCancelScheduledMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockCancelScheduledMessageExpectation
+	expectations       []*ChatServiceMockCancelScheduledMessageExpectation
+
+	callArgs []*ChatServiceMockCancelScheduledMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockCancelScheduledMessageExpectation specifies expectation struct of the ChatService.CancelScheduledMessage
+type ChatServiceMockCancelScheduledMessageExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockCancelScheduledMessageParams
+	paramPtrs *ChatServiceMockCancelScheduledMessageParamPtrs
+	results   *ChatServiceMockCancelScheduledMessageResults
+	Counter   uint64
+}
+
+// ChatServiceMockCancelScheduledMessageParams contains parameters of the ChatService.CancelScheduledMessage
+type ChatServiceMockCancelScheduledMessageParams struct {
+	ctx         context.Context
+	chatID      int64
+	scheduledID string
+}
+
+// ChatServiceMockCancelScheduledMessageParamPtrs contains pointers to parameters of the ChatService.CancelScheduledMessage
+type ChatServiceMockCancelScheduledMessageParamPtrs struct {
+	ctx         *context.Context
+	chatID      *int64
+	scheduledID *string
+}
+
+// ChatServiceMockCancelScheduledMessageResults contains results of the ChatService.CancelScheduledMessage
+type ChatServiceMockCancelScheduledMessageResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) Optional() *mChatServiceMockCancelScheduledMessage {
+	mmCancelScheduledMessage.optional = true
+	return mmCancelScheduledMessage
+}
+
+// Expect sets up expected params for ChatService.CancelScheduledMessage
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) Expect(ctx context.Context, chatID int64, scheduledID string) *mChatServiceMockCancelScheduledMessage {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation == nil {
+		mmCancelScheduledMessage.defaultExpectation = &ChatServiceMockCancelScheduledMessageExpectation{}
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.paramPtrs != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by ExpectParams functions")
+	}
+
+	mmCancelScheduledMessage.defaultExpectation.params = &ChatServiceMockCancelScheduledMessageParams{ctx, chatID, scheduledID}
+	for _, e := range mmCancelScheduledMessage.expectations {
+		if minimock.Equal(e.params, mmCancelScheduledMessage.defaultExpectation.params) {
+			mmCancelScheduledMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCancelScheduledMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmCancelScheduledMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.CancelScheduledMessage
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockCancelScheduledMessage {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation == nil {
+		mmCancelScheduledMessage.defaultExpectation = &ChatServiceMockCancelScheduledMessageExpectation{}
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.params != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by Expect")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.paramPtrs == nil {
+		mmCancelScheduledMessage.defaultExpectation.paramPtrs = &ChatServiceMockCancelScheduledMessageParamPtrs{}
+	}
+	mmCancelScheduledMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCancelScheduledMessage
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.CancelScheduledMessage
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) ExpectChatIDParam2(chatID int64) *mChatServiceMockCancelScheduledMessage {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation == nil {
+		mmCancelScheduledMessage.defaultExpectation = &ChatServiceMockCancelScheduledMessageExpectation{}
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.params != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by Expect")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.paramPtrs == nil {
+		mmCancelScheduledMessage.defaultExpectation.paramPtrs = &ChatServiceMockCancelScheduledMessageParamPtrs{}
+	}
+	mmCancelScheduledMessage.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmCancelScheduledMessage
+}
+
+// ExpectScheduledIDParam3 sets up expected param scheduledID for ChatService.CancelScheduledMessage
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) ExpectScheduledIDParam3(scheduledID string) *mChatServiceMockCancelScheduledMessage {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation == nil {
+		mmCancelScheduledMessage.defaultExpectation = &ChatServiceMockCancelScheduledMessageExpectation{}
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.params != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by Expect")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.paramPtrs == nil {
+		mmCancelScheduledMessage.defaultExpectation.paramPtrs = &ChatServiceMockCancelScheduledMessageParamPtrs{}
+	}
+	mmCancelScheduledMessage.defaultExpectation.paramPtrs.scheduledID = &scheduledID
+
+	return mmCancelScheduledMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.CancelScheduledMessage
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) Inspect(f func(ctx context.Context, chatID int64, scheduledID string)) *mChatServiceMockCancelScheduledMessage {
+	if mmCancelScheduledMessage.mock.inspectFuncCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.CancelScheduledMessage")
+	}
+
+	mmCancelScheduledMessage.mock.inspectFuncCancelScheduledMessage = f
+
+	return mmCancelScheduledMessage
+}
+
+// Return sets up results that will be returned by ChatService.CancelScheduledMessage
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) Return(err error) *ChatServiceMock {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation == nil {
+		mmCancelScheduledMessage.defaultExpectation = &ChatServiceMockCancelScheduledMessageExpectation{mock: mmCancelScheduledMessage.mock}
+	}
+	mmCancelScheduledMessage.defaultExpectation.results = &ChatServiceMockCancelScheduledMessageResults{err}
+	return mmCancelScheduledMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.CancelScheduledMessage method
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) Set(f func(ctx context.Context, chatID int64, scheduledID string) (err error)) *ChatServiceMock {
+	if mmCancelScheduledMessage.defaultExpectation != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.CancelScheduledMessage method")
+	}
+
+	if len(mmCancelScheduledMessage.expectations) > 0 {
+		mmCancelScheduledMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.CancelScheduledMessage method")
+	}
+
+	mmCancelScheduledMessage.mock.funcCancelScheduledMessage = f
+	return mmCancelScheduledMessage.mock
+}
+
+// When sets expectation for the ChatService.CancelScheduledMessage which will trigger the result defined by the following
+// Then helper
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) When(ctx context.Context, chatID int64, scheduledID string) *ChatServiceMockCancelScheduledMessageExpectation {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockCancelScheduledMessageExpectation{
+		mock:   mmCancelScheduledMessage.mock,
+		params: &ChatServiceMockCancelScheduledMessageParams{ctx, chatID, scheduledID},
+	}
+	mmCancelScheduledMessage.expectations = append(mmCancelScheduledMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.CancelScheduledMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockCancelScheduledMessageExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockCancelScheduledMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.CancelScheduledMessage should be invoked
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) Times(n uint64) *mChatServiceMockCancelScheduledMessage {
+	if n == 0 {
+		mmCancelScheduledMessage.mock.t.Fatalf("Times of ChatServiceMock.CancelScheduledMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCancelScheduledMessage.expectedInvocations, n)
+	return mmCancelScheduledMessage
+}
+
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) invocationsDone() bool {
+	if len(mmCancelScheduledMessage.expectations) == 0 && mmCancelScheduledMessage.defaultExpectation == nil && mmCancelScheduledMessage.mock.funcCancelScheduledMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCancelScheduledMessage.mock.afterCancelScheduledMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCancelScheduledMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CancelScheduledMessage implements service.ChatService
+func (mmCancelScheduledMessage *ChatServiceMock) CancelScheduledMessage(ctx context.Context, chatID int64, scheduledID string) (err error) {
+	mm_atomic.AddUint64(&mmCancelScheduledMessage.beforeCancelScheduledMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmCancelScheduledMessage.afterCancelScheduledMessageCounter, 1)
+
+	if mmCancelScheduledMessage.inspectFuncCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.inspectFuncCancelScheduledMessage(ctx, chatID, scheduledID)
+	}
+
+	mm_params := ChatServiceMockCancelScheduledMessageParams{ctx, chatID, scheduledID}
+
+	// Record call args
+	mmCancelScheduledMessage.CancelScheduledMessageMock.mutex.Lock()
+	mmCancelScheduledMessage.CancelScheduledMessageMock.callArgs = append(mmCancelScheduledMessage.CancelScheduledMessageMock.callArgs, &mm_params)
+	mmCancelScheduledMessage.CancelScheduledMessageMock.mutex.Unlock()
+
+	for _, e := range mmCancelScheduledMessage.CancelScheduledMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockCancelScheduledMessageParams{ctx, chatID, scheduledID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCancelScheduledMessage.t.Errorf("ChatServiceMock.CancelScheduledMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmCancelScheduledMessage.t.Errorf("ChatServiceMock.CancelScheduledMessage got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.scheduledID != nil && !minimock.Equal(*mm_want_ptrs.scheduledID, mm_got.scheduledID) {
+				mmCancelScheduledMessage.t.Errorf("ChatServiceMock.CancelScheduledMessage got unexpected parameter scheduledID, want: %#v, got: %#v%s\n", *mm_want_ptrs.scheduledID, mm_got.scheduledID, minimock.Diff(*mm_want_ptrs.scheduledID, mm_got.scheduledID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCancelScheduledMessage.t.Errorf("ChatServiceMock.CancelScheduledMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCancelScheduledMessage.t.Fatal("No results are set for the ChatServiceMock.CancelScheduledMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmCancelScheduledMessage.funcCancelScheduledMessage != nil {
+		return mmCancelScheduledMessage.funcCancelScheduledMessage(ctx, chatID, scheduledID)
+	}
+	mmCancelScheduledMessage.t.Fatalf("Unexpected call to ChatServiceMock.CancelScheduledMessage. %v %v %v", ctx, chatID, scheduledID)
+	return
+}
+
+// CancelScheduledMessageAfterCounter returns a count of finished ChatServiceMock.CancelScheduledMessage invocations
+func (mmCancelScheduledMessage *ChatServiceMock) CancelScheduledMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelScheduledMessage.afterCancelScheduledMessageCounter)
+}
+
+// CancelScheduledMessageBeforeCounter returns a count of ChatServiceMock.CancelScheduledMessage invocations
+func (mmCancelScheduledMessage *ChatServiceMock) CancelScheduledMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelScheduledMessage.beforeCancelScheduledMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.CancelScheduledMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) Calls() []*ChatServiceMockCancelScheduledMessageParams {
+	mmCancelScheduledMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockCancelScheduledMessageParams, len(mmCancelScheduledMessage.callArgs))
+	copy(argCopy, mmCancelScheduledMessage.callArgs)
+
+	mmCancelScheduledMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCancelScheduledMessageDone returns true if the count of the CancelScheduledMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockCancelScheduledMessageDone() bool {
+	if m.CancelScheduledMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CancelScheduledMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CancelScheduledMessageMock.invocationsDone()
+}
+
+// MinimockCancelScheduledMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockCancelScheduledMessageInspect() {
+	for _, e := range m.CancelScheduledMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.CancelScheduledMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterCancelScheduledMessageCounter := mm_atomic.LoadUint64(&m.afterCancelScheduledMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CancelScheduledMessageMock.defaultExpectation != nil && afterCancelScheduledMessageCounter < 1 {
+		if m.CancelScheduledMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.CancelScheduledMessage")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.CancelScheduledMessage with params: %#v", *m.CancelScheduledMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCancelScheduledMessage != nil && afterCancelScheduledMessageCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.CancelScheduledMessage")
+	}
+
+	if !m.CancelScheduledMessageMock.invocationsDone() && afterCancelScheduledMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.CancelScheduledMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.CancelScheduledMessageMock.expectedInvocations), afterCancelScheduledMessageCounter)
+	}
+}
+
+type mChatServiceMockClosePoll struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockClosePollExpectation
+	expectations       []*ChatServiceMockClosePollExpectation
+
+	callArgs []*ChatServiceMockClosePollParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockClosePollExpectation specifies expectation struct of the ChatService.ClosePoll
+type ChatServiceMockClosePollExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockClosePollParams
+	paramPtrs *ChatServiceMockClosePollParamPtrs
+	results   *ChatServiceMockClosePollResults
+	Counter   uint64
+}
+
+// ChatServiceMockClosePollParams contains parameters of the ChatService.ClosePoll
+type ChatServiceMockClosePollParams struct {
+	ctx       context.Context
+	chatID    int64
+	messageID string
+}
+
+// ChatServiceMockClosePollParamPtrs contains pointers to parameters of the ChatService.ClosePoll
+type ChatServiceMockClosePollParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	messageID *string
+}
+
+// ChatServiceMockClosePollResults contains results of the ChatService.ClosePoll
+type ChatServiceMockClosePollResults struct {
+	pp1 *model.Poll
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClosePoll *mChatServiceMockClosePoll) Optional() *mChatServiceMockClosePoll {
+	mmClosePoll.optional = true
+	return mmClosePoll
+}
+
+// Expect sets up expected params for ChatService.ClosePoll
+func (mmClosePoll *mChatServiceMockClosePoll) Expect(ctx context.Context, chatID int64, messageID string) *mChatServiceMockClosePoll {
+	if mmClosePoll.mock.funcClosePoll != nil {
+		mmClosePoll.mock.t.Fatalf("ChatServiceMock.ClosePoll mock is already set by Set")
+	}
+
+	if mmClosePoll.defaultExpectation == nil {
+		mmClosePoll.defaultExpectation = &ChatServiceMockClosePollExpectation{}
+	}
+
+	if mmClosePoll.defaultExpectation.paramPtrs != nil {
+		mmClosePoll.mock.t.Fatalf("ChatServiceMock.ClosePoll mock is already set by ExpectParams functions")
+	}
+
+	mmClosePoll.defaultExpectation.params = &ChatServiceMockClosePollParams{ctx, chatID, messageID}
+	for _, e := range mmClosePoll.expectations {
+		if minimock.Equal(e.params, mmClosePoll.defaultExpectation.params) {
+			mmClosePoll.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClosePoll.defaultExpectation.params)
+		}
+	}
+
+	return mmClosePoll
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ClosePoll
+func (mmClosePoll *mChatServiceMockClosePoll) ExpectCtxParam1(ctx context.Context) *mChatServiceMockClosePoll {
+	if mmClosePoll.mock.funcClosePoll != nil {
+		mmClosePoll.mock.t.Fatalf("ChatServiceMock.ClosePoll mock is already set by Set")
+	}
+
+	if mmClosePoll.defaultExpectation == nil {
+		mmClosePoll.defaultExpectation = &ChatServiceMockClosePollExpectation{}
+	}
+
+	if mmClosePoll.defaultExpectation.params != nil {
+		mmClosePoll.mock.t.Fatalf("ChatServiceMock.ClosePoll mock is already set by Expect")
+	}
+
+	if mmClosePoll.defaultExpectation.paramPtrs == nil {
+		mmClosePoll.defaultExpectation.paramPtrs = &ChatServiceMockClosePollParamPtrs{}
+	}
+	mmClosePoll.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmClosePoll
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.ClosePoll
+func (mmClosePoll *mChatServiceMockClosePoll) ExpectChatIDParam2(chatID int64) *mChatServiceMockClosePoll {
+	if mmClosePoll.mock.funcClosePoll != nil {
+		mmClosePoll.mock.t.Fatalf("ChatServiceMock.ClosePoll mock is already set by Set")
+	}
+
+	if mmClosePoll.defaultExpectation == nil {
+		mmClosePoll.defaultExpectation = &ChatServiceMockClosePollExpectation{}
+	}
+
+	if mmClosePoll.defaultExpectation.params != nil {
+		mmClosePoll.mock.t.Fatalf("ChatServiceMock.ClosePoll mock is already set by Expect")
+	}
+
+	if mmClosePoll.defaultExpectation.paramPtrs == nil {
+		mmClosePoll.defaultExpectation.paramPtrs = &ChatServiceMockClosePollParamPtrs{}
+	}
+	mmClosePoll.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmClosePoll
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatService.ClosePoll
+func (mmClosePoll *mChatServiceMockClosePoll) ExpectMessageIDParam3(messageID string) *mChatServiceMockClosePoll {
+	if mmClosePoll.mock.funcClosePoll != nil {
+		mmClosePoll.mock.t.Fatalf("ChatServiceMock.ClosePoll mock is already set by Set")
+	}
+
+	if mmClosePoll.defaultExpectation == nil {
+		mmClosePoll.defaultExpectation = &ChatServiceMockClosePollExpectation{}
+	}
+
+	if mmClosePoll.defaultExpectation.params != nil {
+		mmClosePoll.mock.t.Fatalf("ChatServiceMock.ClosePoll mock is already set by Expect")
+	}
+
+	if mmClosePoll.defaultExpectation.paramPtrs == nil {
+		mmClosePoll.defaultExpectation.paramPtrs = &ChatServiceMockClosePollParamPtrs{}
+	}
+	mmClosePoll.defaultExpectation.paramPtrs.messageID = &messageID
+
+	return mmClosePoll
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ClosePoll
+func (mmClosePoll *mChatServiceMockClosePoll) Inspect(f func(ctx context.Context, chatID int64, messageID string)) *mChatServiceMockClosePoll {
+	if mmClosePoll.mock.inspectFuncClosePoll != nil {
+		mmClosePoll.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ClosePoll")
+	}
+
+	mmClosePoll.mock.inspectFuncClosePoll = f
+
+	return mmClosePoll
+}
+
+// Return sets up results that will be returned by ChatService.ClosePoll
+func (mmClosePoll *mChatServiceMockClosePoll) Return(pp1 *model.Poll, err error) *ChatServiceMock {
+	if mmClosePoll.mock.funcClosePoll != nil {
+		mmClosePoll.mock.t.Fatalf("ChatServiceMock.ClosePoll mock is already set by Set")
+	}
+
+	if mmClosePoll.defaultExpectation == nil {
+		mmClosePoll.defaultExpectation = &ChatServiceMockClosePollExpectation{mock: mmClosePoll.mock}
+	}
+	mmClosePoll.defaultExpectation.results = &ChatServiceMockClosePollResults{pp1, err}
+	return mmClosePoll.mock
+}
+
+// Set uses given function f to mock the ChatService.ClosePoll method
+func (mmClosePoll *mChatServiceMockClosePoll) Set(f func(ctx context.Context, chatID int64, messageID string) (pp1 *model.Poll, err error)) *ChatServiceMock {
+	if mmClosePoll.defaultExpectation != nil {
+		mmClosePoll.mock.t.Fatalf("Default expectation is already set for the ChatService.ClosePoll method")
+	}
+
+	if len(mmClosePoll.expectations) > 0 {
+		mmClosePoll.mock.t.Fatalf("Some expectations are already set for the ChatService.ClosePoll method")
+	}
+
+	mmClosePoll.mock.funcClosePoll = f
+	return mmClosePoll.mock
+}
+
+// When sets expectation for the ChatService.ClosePoll which will trigger the result defined by the following
+// Then helper
+func (mmClosePoll *mChatServiceMockClosePoll) When(ctx context.Context, chatID int64, messageID string) *ChatServiceMockClosePollExpectation {
+	if mmClosePoll.mock.funcClosePoll != nil {
+		mmClosePoll.mock.t.Fatalf("ChatServiceMock.ClosePoll mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockClosePollExpectation{
+		mock:   mmClosePoll.mock,
+		params: &ChatServiceMockClosePollParams{ctx, chatID, messageID},
+	}
+	mmClosePoll.expectations = append(mmClosePoll.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ClosePoll return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockClosePollExpectation) Then(pp1 *model.Poll, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockClosePollResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ClosePoll should be invoked
+func (mmClosePoll *mChatServiceMockClosePoll) Times(n uint64) *mChatServiceMockClosePoll {
+	if n == 0 {
+		mmClosePoll.mock.t.Fatalf("Times of ChatServiceMock.ClosePoll mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClosePoll.expectedInvocations, n)
+	return mmClosePoll
+}
+
+func (mmClosePoll *mChatServiceMockClosePoll) invocationsDone() bool {
+	if len(mmClosePoll.expectations) == 0 && mmClosePoll.defaultExpectation == nil && mmClosePoll.mock.funcClosePoll == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClosePoll.mock.afterClosePollCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClosePoll.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ClosePoll implements service.ChatService
+func (mmClosePoll *ChatServiceMock) ClosePoll(ctx context.Context, chatID int64, messageID string) (pp1 *model.Poll, err error) {
+	mm_atomic.AddUint64(&mmClosePoll.beforeClosePollCounter, 1)
+	defer mm_atomic.AddUint64(&mmClosePoll.afterClosePollCounter, 1)
+
+	if mmClosePoll.inspectFuncClosePoll != nil {
+		mmClosePoll.inspectFuncClosePoll(ctx, chatID, messageID)
+	}
+
+	mm_params := ChatServiceMockClosePollParams{ctx, chatID, messageID}
+
+	// Record call args
+	mmClosePoll.ClosePollMock.mutex.Lock()
+	mmClosePoll.ClosePollMock.callArgs = append(mmClosePoll.ClosePollMock.callArgs, &mm_params)
+	mmClosePoll.ClosePollMock.mutex.Unlock()
+
+	for _, e := range mmClosePoll.ClosePollMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmClosePoll.ClosePollMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClosePoll.ClosePollMock.defaultExpectation.Counter, 1)
+		mm_want := mmClosePoll.ClosePollMock.defaultExpectation.params
+		mm_want_ptrs := mmClosePoll.ClosePollMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockClosePollParams{ctx, chatID, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClosePoll.t.Errorf("ChatServiceMock.ClosePoll got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmClosePoll.t.Errorf("ChatServiceMock.ClosePoll got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmClosePoll.t.Errorf("ChatServiceMock.ClosePoll got unexpected parameter messageID, want: %#v, got: %#v%s\n", *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClosePoll.t.Errorf("ChatServiceMock.ClosePoll got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClosePoll.ClosePollMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClosePoll.t.Fatal("No results are set for the ChatServiceMock.ClosePoll")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmClosePoll.funcClosePoll != nil {
+		return mmClosePoll.funcClosePoll(ctx, chatID, messageID)
+	}
+	mmClosePoll.t.Fatalf("Unexpected call to ChatServiceMock.ClosePoll. %v %v %v", ctx, chatID, messageID)
+	return
+}
+
+// ClosePollAfterCounter returns a count of finished ChatServiceMock.ClosePoll invocations
+func (mmClosePoll *ChatServiceMock) ClosePollAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClosePoll.afterClosePollCounter)
+}
+
+// ClosePollBeforeCounter returns a count of ChatServiceMock.ClosePoll invocations
+func (mmClosePoll *ChatServiceMock) ClosePollBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClosePoll.beforeClosePollCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ClosePoll.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClosePoll *mChatServiceMockClosePoll) Calls() []*ChatServiceMockClosePollParams {
+	mmClosePoll.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockClosePollParams, len(mmClosePoll.callArgs))
+	copy(argCopy, mmClosePoll.callArgs)
+
+	mmClosePoll.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClosePollDone returns true if the count of the ClosePoll invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockClosePollDone() bool {
+	if m.ClosePollMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClosePollMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClosePollMock.invocationsDone()
+}
+
+// MinimockClosePollInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockClosePollInspect() {
+	for _, e := range m.ClosePollMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ClosePoll with params: %#v", *e.params)
+		}
+	}
+
+	afterClosePollCounter := mm_atomic.LoadUint64(&m.afterClosePollCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClosePollMock.defaultExpectation != nil && afterClosePollCounter < 1 {
+		if m.ClosePollMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.ClosePoll")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ClosePoll with params: %#v", *m.ClosePollMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClosePoll != nil && afterClosePollCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.ClosePoll")
+	}
+
+	if !m.ClosePollMock.invocationsDone() && afterClosePollCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ClosePoll but found %d calls",
+			mm_atomic.LoadUint64(&m.ClosePollMock.expectedInvocations), afterClosePollCounter)
+	}
+}
+
+type mChatServiceMockConnectChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockConnectChatExpectation
+	expectations       []*ChatServiceMockConnectChatExpectation
+
+	callArgs []*ChatServiceMockConnectChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockConnectChatExpectation specifies expectation struct of the ChatService.ConnectChat
+type ChatServiceMockConnectChatExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockConnectChatParams
+	paramPtrs *ChatServiceMockConnectChatParamPtrs
+	results   *ChatServiceMockConnectChatResults
+	Counter   uint64
+}
+
+// ChatServiceMockConnectChatParams contains parameters of the ChatService.ConnectChat
+type ChatServiceMockConnectChatParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// ChatServiceMockConnectChatParamPtrs contains pointers to parameters of the ChatService.ConnectChat
+type ChatServiceMockConnectChatParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// ChatServiceMockConnectChatResults contains results of the ChatService.ConnectChat
+type ChatServiceMockConnectChatResults struct {
+	ch1 <-chan *model.Message
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmConnectChat *mChatServiceMockConnectChat) Optional() *mChatServiceMockConnectChat {
+	mmConnectChat.optional = true
+	return mmConnectChat
+}
+
+// Expect sets up expected params for ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) Expect(ctx context.Context, chatID int64) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	}
+
+	if mmConnectChat.defaultExpectation.paramPtrs != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by ExpectParams functions")
+	}
+
+	mmConnectChat.defaultExpectation.params = &ChatServiceMockConnectChatParams{ctx, chatID}
+	for _, e := range mmConnectChat.expectations {
+		if minimock.Equal(e.params, mmConnectChat.defaultExpectation.params) {
+			mmConnectChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConnectChat.defaultExpectation.params)
+		}
+	}
+
+	return mmConnectChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	}
+
+	if mmConnectChat.defaultExpectation.params != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Expect")
+	}
+
+	if mmConnectChat.defaultExpectation.paramPtrs == nil {
+		mmConnectChat.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatParamPtrs{}
+	}
+	mmConnectChat.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmConnectChat
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) ExpectChatIDParam2(chatID int64) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	}
+
+	if mmConnectChat.defaultExpectation.params != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Expect")
+	}
+
+	if mmConnectChat.defaultExpectation.paramPtrs == nil {
+		mmConnectChat.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatParamPtrs{}
+	}
+	mmConnectChat.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmConnectChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) Inspect(f func(ctx context.Context, chatID int64)) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.inspectFuncConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ConnectChat")
+	}
+
+	mmConnectChat.mock.inspectFuncConnectChat = f
+
+	return mmConnectChat
+}
+
+// Return sets up results that will be returned by ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) Return(ch1 <-chan *model.Message, err error) *ChatServiceMock {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{mock: mmConnectChat.mock}
+	}
+	mmConnectChat.defaultExpectation.results = &ChatServiceMockConnectChatResults{ch1, err}
+	return mmConnectChat.mock
+}
+
+// Set uses given function f to mock the ChatService.ConnectChat method
+func (mmConnectChat *mChatServiceMockConnectChat) Set(f func(ctx context.Context, chatID int64) (ch1 <-chan *model.Message, err error)) *ChatServiceMock {
+	if mmConnectChat.defaultExpectation != nil {
+		mmConnectChat.mock.t.Fatalf("Default expectation is already set for the ChatService.ConnectChat method")
+	}
+
+	if len(mmConnectChat.expectations) > 0 {
+		mmConnectChat.mock.t.Fatalf("Some expectations are already set for the ChatService.ConnectChat method")
+	}
+
+	mmConnectChat.mock.funcConnectChat = f
+	return mmConnectChat.mock
+}
+
+// When sets expectation for the ChatService.ConnectChat which will trigger the result defined by the following
+// Then helper
+func (mmConnectChat *mChatServiceMockConnectChat) When(ctx context.Context, chatID int64) *ChatServiceMockConnectChatExpectation {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockConnectChatExpectation{
+		mock:   mmConnectChat.mock,
+		params: &ChatServiceMockConnectChatParams{ctx, chatID},
+	}
+	mmConnectChat.expectations = append(mmConnectChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ConnectChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockConnectChatExpectation) Then(ch1 <-chan *model.Message, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockConnectChatResults{ch1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ConnectChat should be invoked
+func (mmConnectChat *mChatServiceMockConnectChat) Times(n uint64) *mChatServiceMockConnectChat {
+	if n == 0 {
+		mmConnectChat.mock.t.Fatalf("Times of ChatServiceMock.ConnectChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmConnectChat.expectedInvocations, n)
+	return mmConnectChat
+}
+
+func (mmConnectChat *mChatServiceMockConnectChat) invocationsDone() bool {
+	if len(mmConnectChat.expectations) == 0 && mmConnectChat.defaultExpectation == nil && mmConnectChat.mock.funcConnectChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmConnectChat.mock.afterConnectChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConnectChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ConnectChat implements service.ChatService
+func (mmConnectChat *ChatServiceMock) ConnectChat(ctx context.Context, chatID int64) (ch1 <-chan *model.Message, err error) {
+	mm_atomic.AddUint64(&mmConnectChat.beforeConnectChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmConnectChat.afterConnectChatCounter, 1)
+
+	if mmConnectChat.inspectFuncConnectChat != nil {
+		mmConnectChat.inspectFuncConnectChat(ctx, chatID)
+	}
+
+	mm_params := ChatServiceMockConnectChatParams{ctx, chatID}
+
+	// Record call args
+	mmConnectChat.ConnectChatMock.mutex.Lock()
+	mmConnectChat.ConnectChatMock.callArgs = append(mmConnectChat.ConnectChatMock.callArgs, &mm_params)
+	mmConnectChat.ConnectChatMock.mutex.Unlock()
+
+	for _, e := range mmConnectChat.ConnectChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ch1, e.results.err
+		}
+	}
+
+	if mmConnectChat.ConnectChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConnectChat.ConnectChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmConnectChat.ConnectChatMock.defaultExpectation.params
+		mm_want_ptrs := mmConnectChat.ConnectChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockConnectChatParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConnectChat.ConnectChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConnectChat.t.Fatal("No results are set for the ChatServiceMock.ConnectChat")
+		}
+		return (*mm_results).ch1, (*mm_results).err
+	}
+	if mmConnectChat.funcConnectChat != nil {
+		return mmConnectChat.funcConnectChat(ctx, chatID)
+	}
+	mmConnectChat.t.Fatalf("Unexpected call to ChatServiceMock.ConnectChat. %v %v", ctx, chatID)
+	return
+}
+
+// ConnectChatAfterCounter returns a count of finished ChatServiceMock.ConnectChat invocations
+func (mmConnectChat *ChatServiceMock) ConnectChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConnectChat.afterConnectChatCounter)
+}
+
+// ConnectChatBeforeCounter returns a count of ChatServiceMock.ConnectChat invocations
+func (mmConnectChat *ChatServiceMock) ConnectChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConnectChat.beforeConnectChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ConnectChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConnectChat *mChatServiceMockConnectChat) Calls() []*ChatServiceMockConnectChatParams {
+	mmConnectChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockConnectChatParams, len(mmConnectChat.callArgs))
+	copy(argCopy, mmConnectChat.callArgs)
+
+	mmConnectChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConnectChatDone returns true if the count of the ConnectChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockConnectChatDone() bool {
+	if m.ConnectChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ConnectChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ConnectChatMock.invocationsDone()
+}
+
+// MinimockConnectChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockConnectChatInspect() {
+	for _, e := range m.ConnectChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ConnectChat with params: %#v", *e.params)
+		}
+	}
+
+	afterConnectChatCounter := mm_atomic.LoadUint64(&m.afterConnectChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConnectChatMock.defaultExpectation != nil && afterConnectChatCounter < 1 {
+		if m.ConnectChatMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.ConnectChat")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ConnectChat with params: %#v", *m.ConnectChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConnectChat != nil && afterConnectChatCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.ConnectChat")
+	}
+
+	if !m.ConnectChatMock.invocationsDone() && afterConnectChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ConnectChat but found %d calls",
+			mm_atomic.LoadUint64(&m.ConnectChatMock.expectedInvocations), afterConnectChatCounter)
+	}
+}
+
+type mChatServiceMockCreateChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockCreateChatExpectation
+	expectations       []*ChatServiceMockCreateChatExpectation
+
+	callArgs []*ChatServiceMockCreateChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockCreateChatExpectation specifies expectation struct of the ChatService.CreateChat
+type ChatServiceMockCreateChatExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockCreateChatParams
+	paramPtrs *ChatServiceMockCreateChatParamPtrs
+	results   *ChatServiceMockCreateChatResults
+	Counter   uint64
+}
+
+// ChatServiceMockCreateChatParams contains parameters of the ChatService.CreateChat
+type ChatServiceMockCreateChatParams struct {
+	ctx        context.Context
+	createChat *model.ChatCreate
+}
+
+// ChatServiceMockCreateChatParamPtrs contains pointers to parameters of the ChatService.CreateChat
+type ChatServiceMockCreateChatParamPtrs struct {
+	ctx        *context.Context
+	createChat **model.ChatCreate
+}
+
+// ChatServiceMockCreateChatResults contains results of the ChatService.CreateChat
+type ChatServiceMockCreateChatResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateChat *mChatServiceMockCreateChat) Optional() *mChatServiceMockCreateChat {
+	mmCreateChat.optional = true
+	return mmCreateChat
+}
+
+// Expect sets up expected params for ChatService.CreateChat
+func (mmCreateChat *mChatServiceMockCreateChat) Expect(ctx context.Context, createChat *model.ChatCreate) *mChatServiceMockCreateChat {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{}
+	}
+
+	if mmCreateChat.defaultExpectation.paramPtrs != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by ExpectParams functions")
+	}
+
+	mmCreateChat.defaultExpectation.params = &ChatServiceMockCreateChatParams{ctx, createChat}
+	for _, e := range mmCreateChat.expectations {
+		if minimock.Equal(e.params, mmCreateChat.defaultExpectation.params) {
+			mmCreateChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateChat.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.CreateChat
+func (mmCreateChat *mChatServiceMockCreateChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockCreateChat {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{}
+	}
+
+	if mmCreateChat.defaultExpectation.params != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Expect")
+	}
+
+	if mmCreateChat.defaultExpectation.paramPtrs == nil {
+		mmCreateChat.defaultExpectation.paramPtrs = &ChatServiceMockCreateChatParamPtrs{}
+	}
+	mmCreateChat.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateChat
+}
+
+// ExpectCreateChatParam2 sets up expected param createChat for ChatService.CreateChat
+func (mmCreateChat *mChatServiceMockCreateChat) ExpectCreateChatParam2(createChat *model.ChatCreate) *mChatServiceMockCreateChat {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{}
+	}
+
+	if mmCreateChat.defaultExpectation.params != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Expect")
+	}
+
+	if mmCreateChat.defaultExpectation.paramPtrs == nil {
+		mmCreateChat.defaultExpectation.paramPtrs = &ChatServiceMockCreateChatParamPtrs{}
+	}
+	mmCreateChat.defaultExpectation.paramPtrs.createChat = &createChat
+
+	return mmCreateChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.CreateChat
+func (mmCreateChat *mChatServiceMockCreateChat) Inspect(f func(ctx context.Context, createChat *model.ChatCreate)) *mChatServiceMockCreateChat {
+	if mmCreateChat.mock.inspectFuncCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.CreateChat")
+	}
+
+	mmCreateChat.mock.inspectFuncCreateChat = f
+
+	return mmCreateChat
+}
+
+// Return sets up results that will be returned by ChatService.CreateChat
+func (mmCreateChat *mChatServiceMockCreateChat) Return(i1 int64, err error) *ChatServiceMock {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{mock: mmCreateChat.mock}
+	}
+	mmCreateChat.defaultExpectation.results = &ChatServiceMockCreateChatResults{i1, err}
+	return mmCreateChat.mock
+}
+
+// Set uses given function f to mock the ChatService.CreateChat method
+func (mmCreateChat *mChatServiceMockCreateChat) Set(f func(ctx context.Context, createChat *model.ChatCreate) (i1 int64, err error)) *ChatServiceMock {
+	if mmCreateChat.defaultExpectation != nil {
+		mmCreateChat.mock.t.Fatalf("Default expectation is already set for the ChatService.CreateChat method")
+	}
+
+	if len(mmCreateChat.expectations) > 0 {
+		mmCreateChat.mock.t.Fatalf("Some expectations are already set for the ChatService.CreateChat method")
+	}
+
+	mmCreateChat.mock.funcCreateChat = f
+	return mmCreateChat.mock
+}
+
+// When sets expectation for the ChatService.CreateChat which will trigger the result defined by the following
+// Then helper
+func (mmCreateChat *mChatServiceMockCreateChat) When(ctx context.Context, createChat *model.ChatCreate) *ChatServiceMockCreateChatExpectation {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockCreateChatExpectation{
+		mock:   mmCreateChat.mock,
+		params: &ChatServiceMockCreateChatParams{ctx, createChat},
+	}
+	mmCreateChat.expectations = append(mmCreateChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.CreateChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockCreateChatExpectation) Then(i1 int64, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockCreateChatResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.CreateChat should be invoked
+func (mmCreateChat *mChatServiceMockCreateChat) Times(n uint64) *mChatServiceMockCreateChat {
+	if n == 0 {
+		mmCreateChat.mock.t.Fatalf("Times of ChatServiceMock.CreateChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateChat.expectedInvocations, n)
+	return mmCreateChat
+}
+
+func (mmCreateChat *mChatServiceMockCreateChat) invocationsDone() bool {
+	if len(mmCreateChat.expectations) == 0 && mmCreateChat.defaultExpectation == nil && mmCreateChat.mock.funcCreateChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateChat.mock.afterCreateChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateChat implements service.ChatService
+func (mmCreateChat *ChatServiceMock) CreateChat(ctx context.Context, createChat *model.ChatCreate) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateChat.beforeCreateChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateChat.afterCreateChatCounter, 1)
+
+	if mmCreateChat.inspectFuncCreateChat != nil {
+		mmCreateChat.inspectFuncCreateChat(ctx, createChat)
+	}
+
+	mm_params := ChatServiceMockCreateChatParams{ctx, createChat}
+
+	// Record call args
+	mmCreateChat.CreateChatMock.mutex.Lock()
+	mmCreateChat.CreateChatMock.callArgs = append(mmCreateChat.CreateChatMock.callArgs, &mm_params)
+	mmCreateChat.CreateChatMock.mutex.Unlock()
+
+	for _, e := range mmCreateChat.CreateChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateChat.CreateChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateChat.CreateChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateChat.CreateChatMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateChat.CreateChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockCreateChatParams{ctx, createChat}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateChat.t.Errorf("ChatServiceMock.CreateChat got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.createChat != nil && !minimock.Equal(*mm_want_ptrs.createChat, mm_got.createChat) {
+				mmCreateChat.t.Errorf("ChatServiceMock.CreateChat got unexpected parameter createChat, want: %#v, got: %#v%s\n", *mm_want_ptrs.createChat, mm_got.createChat, minimock.Diff(*mm_want_ptrs.createChat, mm_got.createChat))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateChat.t.Errorf("ChatServiceMock.CreateChat got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateChat.CreateChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateChat.t.Fatal("No results are set for the ChatServiceMock.CreateChat")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateChat.funcCreateChat != nil {
+		return mmCreateChat.funcCreateChat(ctx, createChat)
+	}
+	mmCreateChat.t.Fatalf("Unexpected call to ChatServiceMock.CreateChat. %v %v", ctx, createChat)
+	return
+}
+
+// CreateChatAfterCounter returns a count of finished ChatServiceMock.CreateChat invocations
+func (mmCreateChat *ChatServiceMock) CreateChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateChat.afterCreateChatCounter)
+}
+
+// CreateChatBeforeCounter returns a count of ChatServiceMock.CreateChat invocations
+func (mmCreateChat *ChatServiceMock) CreateChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateChat.beforeCreateChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.CreateChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateChat *mChatServiceMockCreateChat) Calls() []*ChatServiceMockCreateChatParams {
+	mmCreateChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockCreateChatParams, len(mmCreateChat.callArgs))
+	copy(argCopy, mmCreateChat.callArgs)
+
+	mmCreateChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateChatDone returns true if the count of the CreateChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockCreateChatDone() bool {
+	if m.CreateChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateChatMock.invocationsDone()
+}
+
+// MinimockCreateChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockCreateChatInspect() {
+	for _, e := range m.CreateChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.CreateChat with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateChatCounter := mm_atomic.LoadUint64(&m.afterCreateChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateChatMock.defaultExpectation != nil && afterCreateChatCounter < 1 {
+		if m.CreateChatMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.CreateChat")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.CreateChat with params: %#v", *m.CreateChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateChat != nil && afterCreateChatCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.CreateChat")
+	}
+
+	if !m.CreateChatMock.invocationsDone() && afterCreateChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.CreateChat but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateChatMock.expectedInvocations), afterCreateChatCounter)
+	}
+}
+
+type mChatServiceMockCreateInviteLink struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockCreateInviteLinkExpectation
+	expectations       []*ChatServiceMockCreateInviteLinkExpectation
+
+	callArgs []*ChatServiceMockCreateInviteLinkParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockCreateInviteLinkExpectation specifies expectation struct of the ChatService.CreateInviteLink
+type ChatServiceMockCreateInviteLinkExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockCreateInviteLinkParams
+	paramPtrs *ChatServiceMockCreateInviteLinkParamPtrs
+	results   *ChatServiceMockCreateInviteLinkResults
+	Counter   uint64
+}
+
+// ChatServiceMockCreateInviteLinkParams contains parameters of the ChatService.CreateInviteLink
+type ChatServiceMockCreateInviteLinkParams struct {
+	ctx       context.Context
+	chatID    int64
+	expiresAt *time.Time
+	maxUses   int32
+}
+
+// ChatServiceMockCreateInviteLinkParamPtrs contains pointers to parameters of the ChatService.CreateInviteLink
+type ChatServiceMockCreateInviteLinkParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	expiresAt **time.Time
+	maxUses   *int32
+}
+
+// ChatServiceMockCreateInviteLinkResults contains results of the ChatService.CreateInviteLink
+type ChatServiceMockCreateInviteLinkResults struct {
+	ip1 *model.InviteLink
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateInviteLink *mChatServiceMockCreateInviteLink) Optional() *mChatServiceMockCreateInviteLink {
+	mmCreateInviteLink.optional = true
+	return mmCreateInviteLink
+}
+
+// Expect sets up expected params for ChatService.CreateInviteLink
+func (mmCreateInviteLink *mChatServiceMockCreateInviteLink) Expect(ctx context.Context, chatID int64, expiresAt *time.Time, maxUses int32) *mChatServiceMockCreateInviteLink {
+	if mmCreateInviteLink.mock.funcCreateInviteLink != nil {
+		mmCreateInviteLink.mock.t.Fatalf("ChatServiceMock.CreateInviteLink mock is already set by Set")
+	}
+
+	if mmCreateInviteLink.defaultExpectation == nil {
+		mmCreateInviteLink.defaultExpectation = &ChatServiceMockCreateInviteLinkExpectation{}
+	}
+
+	if mmCreateInviteLink.defaultExpectation.paramPtrs != nil {
+		mmCreateInviteLink.mock.t.Fatalf("ChatServiceMock.CreateInviteLink mock is already set by ExpectParams functions")
+	}
+
+	mmCreateInviteLink.defaultExpectation.params = &ChatServiceMockCreateInviteLinkParams{ctx, chatID, expiresAt, maxUses}
+	for _, e := range mmCreateInviteLink.expectations {
+		if minimock.Equal(e.params, mmCreateInviteLink.defaultExpectation.params) {
+			mmCreateInviteLink.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateInviteLink.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateInviteLink
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.CreateInviteLink
+func (mmCreateInviteLink *mChatServiceMockCreateInviteLink) ExpectCtxParam1(ctx context.Context) *mChatServiceMockCreateInviteLink {
+	if mmCreateInviteLink.mock.funcCreateInviteLink != nil {
+		mmCreateInviteLink.mock.t.Fatalf("ChatServiceMock.CreateInviteLink mock is already set by Set")
+	}
+
+	if mmCreateInviteLink.defaultExpectation == nil {
+		mmCreateInviteLink.defaultExpectation = &ChatServiceMockCreateInviteLinkExpectation{}
+	}
+
+	if mmCreateInviteLink.defaultExpectation.params != nil {
+		mmCreateInviteLink.mock.t.Fatalf("ChatServiceMock.CreateInviteLink mock is already set by Expect")
+	}
+
+	if mmCreateInviteLink.defaultExpectation.paramPtrs == nil {
+		mmCreateInviteLink.defaultExpectation.paramPtrs = &ChatServiceMockCreateInviteLinkParamPtrs{}
+	}
+	mmCreateInviteLink.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateInviteLink
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.CreateInviteLink
+func (mmCreateInviteLink *mChatServiceMockCreateInviteLink) ExpectChatIDParam2(chatID int64) *mChatServiceMockCreateInviteLink {
+	if mmCreateInviteLink.mock.funcCreateInviteLink != nil {
+		mmCreateInviteLink.mock.t.Fatalf("ChatServiceMock.CreateInviteLink mock is already set by Set")
+	}
+
+	if mmCreateInviteLink.defaultExpectation == nil {
+		mmCreateInviteLink.defaultExpectation = &ChatServiceMockCreateInviteLinkExpectation{}
+	}
+
+	if mmCreateInviteLink.defaultExpectation.params != nil {
+		mmCreateInviteLink.mock.t.Fatalf("ChatServiceMock.CreateInviteLink mock is already set by Expect")
+	}
+
+	if mmCreateInviteLink.defaultExpectation.paramPtrs == nil {
+		mmCreateInviteLink.defaultExpectation.paramPtrs = &ChatServiceMockCreateInviteLinkParamPtrs{}
+	}
+	mmCreateInviteLink.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmCreateInviteLink
+}
+
+// ExpectExpiresAtParam3 sets up expected param expiresAt for ChatService.CreateInviteLink
+func (mmCreateInviteLink *mChatServiceMockCreateInviteLink) ExpectExpiresAtParam3(expiresAt *time.Time) *mChatServiceMockCreateInviteLink {
+	if mmCreateInviteLink.mock.funcCreateInviteLink != nil {
+		mmCreateInviteLink.mock.t.Fatalf("ChatServiceMock.CreateInviteLink mock is already set by Set")
+	}
+
+	if mmCreateInviteLink.defaultExpectation == nil {
+		mmCreateInviteLink.defaultExpectation = &ChatServiceMockCreateInviteLinkExpectation{}
+	}
+
+	if mmCreateInviteLink.defaultExpectation.params != nil {
+		mmCreateInviteLink.mock.t.Fatalf("ChatServiceMock.CreateInviteLink mock is already set by Expect")
+	}
+
+	if mmCreateInviteLink.defaultExpectation.paramPtrs == nil {
+		mmCreateInviteLink.defaultExpectation.paramPtrs = &ChatServiceMockCreateInviteLinkParamPtrs{}
+	}
+	mmCreateInviteLink.defaultExpectation.paramPtrs.expiresAt = &expiresAt
+
+	return mmCreateInviteLink
+}
+
+// ExpectMaxUsesParam4 sets up expected param maxUses for ChatService.CreateInviteLink
+func (mmCreateInviteLink *mChatServiceMockCreateInviteLink) ExpectMaxUsesParam4(maxUses int32) *mChatServiceMockCreateInviteLink {
+	if mmCreateInviteLink.mock.funcCreateInviteLink != nil {
+		mmCreateInviteLink.mock.t.Fatalf("ChatServiceMock.CreateInviteLink mock is already set by Set")
+	}
+
+	if mmCreateInviteLink.defaultExpectation == nil {
+		mmCreateInviteLink.defaultExpectation = &ChatServiceMockCreateInviteLinkExpectation{}
+	}
+
+	if mmCreateInviteLink.defaultExpectation.params != nil {
+		mmCreateInviteLink.mock.t.Fatalf("ChatServiceMock.CreateInviteLink mock is already set by Expect")
+	}
+
+	if mmCreateInviteLink.defaultExpectation.paramPtrs == nil {
+		mmCreateInviteLink.defaultExpectation.paramPtrs = &ChatServiceMockCreateInviteLinkParamPtrs{}
+	}
+	mmCreateInviteLink.defaultExpectation.paramPtrs.maxUses = &maxUses
+
+	return mmCreateInviteLink
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.CreateInviteLink
+func (mmCreateInviteLink *mChatServiceMockCreateInviteLink) Inspect(f func(ctx context.Context, chatID int64, expiresAt *time.Time, maxUses int32)) *mChatServiceMockCreateInviteLink {
+	if mmCreateInviteLink.mock.inspectFuncCreateInviteLink != nil {
+		mmCreateInviteLink.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.CreateInviteLink")
+	}
+
+	mmCreateInviteLink.mock.inspectFuncCreateInviteLink = f
+
+	return mmCreateInviteLink
+}
+
+// Return sets up results that will be returned by ChatService.CreateInviteLink
+func (mmCreateInviteLink *mChatServiceMockCreateInviteLink) Return(ip1 *model.InviteLink, err error) *ChatServiceMock {
+	if mmCreateInviteLink.mock.funcCreateInviteLink != nil {
+		mmCreateInviteLink.mock.t.Fatalf("ChatServiceMock.CreateInviteLink mock is already set by Set")
+	}
+
+	if mmCreateInviteLink.defaultExpectation == nil {
+		mmCreateInviteLink.defaultExpectation = &ChatServiceMockCreateInviteLinkExpectation{mock: mmCreateInviteLink.mock}
+	}
+	mmCreateInviteLink.defaultExpectation.results = &ChatServiceMockCreateInviteLinkResults{ip1, err}
+	return mmCreateInviteLink.mock
+}
+
+// Set uses given function f to mock the ChatService.CreateInviteLink method
+func (mmCreateInviteLink *mChatServiceMockCreateInviteLink) Set(f func(ctx context.Context, chatID int64, expiresAt *time.Time, maxUses int32) (ip1 *model.InviteLink, err error)) *ChatServiceMock {
+	if mmCreateInviteLink.defaultExpectation != nil {
+		mmCreateInviteLink.mock.t.Fatalf("Default expectation is already set for the ChatService.CreateInviteLink method")
+	}
+
+	if len(mmCreateInviteLink.expectations) > 0 {
+		mmCreateInviteLink.mock.t.Fatalf("Some expectations are already set for the ChatService.CreateInviteLink method")
+	}
+
+	mmCreateInviteLink.mock.funcCreateInviteLink = f
+	return mmCreateInviteLink.mock
+}
+
+// When sets expectation for the ChatService.CreateInviteLink which will trigger the result defined by the following
+// Then helper
+func (mmCreateInviteLink *mChatServiceMockCreateInviteLink) When(ctx context.Context, chatID int64, expiresAt *time.Time, maxUses int32) *ChatServiceMockCreateInviteLinkExpectation {
+	if mmCreateInviteLink.mock.funcCreateInviteLink != nil {
+		mmCreateInviteLink.mock.t.Fatalf("ChatServiceMock.CreateInviteLink mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockCreateInviteLinkExpectation{
+		mock:   mmCreateInviteLink.mock,
+		params: &ChatServiceMockCreateInviteLinkParams{ctx, chatID, expiresAt, maxUses},
+	}
+	mmCreateInviteLink.expectations = append(mmCreateInviteLink.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.CreateInviteLink return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockCreateInviteLinkExpectation) Then(ip1 *model.InviteLink, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockCreateInviteLinkResults{ip1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.CreateInviteLink should be invoked
+func (mmCreateInviteLink *mChatServiceMockCreateInviteLink) Times(n uint64) *mChatServiceMockCreateInviteLink {
+	if n == 0 {
+		mmCreateInviteLink.mock.t.Fatalf("Times of ChatServiceMock.CreateInviteLink mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateInviteLink.expectedInvocations, n)
+	return mmCreateInviteLink
+}
+
+func (mmCreateInviteLink *mChatServiceMockCreateInviteLink) invocationsDone() bool {
+	if len(mmCreateInviteLink.expectations) == 0 && mmCreateInviteLink.defaultExpectation == nil && mmCreateInviteLink.mock.funcCreateInviteLink == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateInviteLink.mock.afterCreateInviteLinkCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateInviteLink.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateInviteLink implements service.ChatService
+func (mmCreateInviteLink *ChatServiceMock) CreateInviteLink(ctx context.Context, chatID int64, expiresAt *time.Time, maxUses int32) (ip1 *model.InviteLink, err error) {
+	mm_atomic.AddUint64(&mmCreateInviteLink.beforeCreateInviteLinkCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateInviteLink.afterCreateInviteLinkCounter, 1)
+
+	if mmCreateInviteLink.inspectFuncCreateInviteLink != nil {
+		mmCreateInviteLink.inspectFuncCreateInviteLink(ctx, chatID, expiresAt, maxUses)
+	}
+
+	mm_params := ChatServiceMockCreateInviteLinkParams{ctx, chatID, expiresAt, maxUses}
+
+	// Record call args
+	mmCreateInviteLink.CreateInviteLinkMock.mutex.Lock()
+	mmCreateInviteLink.CreateInviteLinkMock.callArgs = append(mmCreateInviteLink.CreateInviteLinkMock.callArgs, &mm_params)
+	mmCreateInviteLink.CreateInviteLinkMock.mutex.Unlock()
+
+	for _, e := range mmCreateInviteLink.CreateInviteLinkMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ip1, e.results.err
+		}
+	}
+
+	if mmCreateInviteLink.CreateInviteLinkMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateInviteLink.CreateInviteLinkMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateInviteLink.CreateInviteLinkMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateInviteLink.CreateInviteLinkMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockCreateInviteLinkParams{ctx, chatID, expiresAt, maxUses}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateInviteLink.t.Errorf("ChatServiceMock.CreateInviteLink got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmCreateInviteLink.t.Errorf("ChatServiceMock.CreateInviteLink got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.expiresAt != nil && !minimock.Equal(*mm_want_ptrs.expiresAt, mm_got.expiresAt) {
+				mmCreateInviteLink.t.Errorf("ChatServiceMock.CreateInviteLink got unexpected parameter expiresAt, want: %#v, got: %#v%s\n", *mm_want_ptrs.expiresAt, mm_got.expiresAt, minimock.Diff(*mm_want_ptrs.expiresAt, mm_got.expiresAt))
+			}
+
+			if mm_want_ptrs.maxUses != nil && !minimock.Equal(*mm_want_ptrs.maxUses, mm_got.maxUses) {
+				mmCreateInviteLink.t.Errorf("ChatServiceMock.CreateInviteLink got unexpected parameter maxUses, want: %#v, got: %#v%s\n", *mm_want_ptrs.maxUses, mm_got.maxUses, minimock.Diff(*mm_want_ptrs.maxUses, mm_got.maxUses))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateInviteLink.t.Errorf("ChatServiceMock.CreateInviteLink got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateInviteLink.CreateInviteLinkMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateInviteLink.t.Fatal("No results are set for the ChatServiceMock.CreateInviteLink")
+		}
+		return (*mm_results).ip1, (*mm_results).err
+	}
+	if mmCreateInviteLink.funcCreateInviteLink != nil {
+		return mmCreateInviteLink.funcCreateInviteLink(ctx, chatID, expiresAt, maxUses)
+	}
+	mmCreateInviteLink.t.Fatalf("Unexpected call to ChatServiceMock.CreateInviteLink. %v %v %v %v", ctx, chatID, expiresAt, maxUses)
+	return
+}
+
+// CreateInviteLinkAfterCounter returns a count of finished ChatServiceMock.CreateInviteLink invocations
+func (mmCreateInviteLink *ChatServiceMock) CreateInviteLinkAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateInviteLink.afterCreateInviteLinkCounter)
+}
+
+// CreateInviteLinkBeforeCounter returns a count of ChatServiceMock.CreateInviteLink invocations
+func (mmCreateInviteLink *ChatServiceMock) CreateInviteLinkBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateInviteLink.beforeCreateInviteLinkCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.CreateInviteLink.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateInviteLink *mChatServiceMockCreateInviteLink) Calls() []*ChatServiceMockCreateInviteLinkParams {
+	mmCreateInviteLink.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockCreateInviteLinkParams, len(mmCreateInviteLink.callArgs))
+	copy(argCopy, mmCreateInviteLink.callArgs)
+
+	mmCreateInviteLink.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateInviteLinkDone returns true if the count of the CreateInviteLink invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockCreateInviteLinkDone() bool {
+	if m.CreateInviteLinkMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateInviteLinkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateInviteLinkMock.invocationsDone()
+}
+
+// MinimockCreateInviteLinkInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockCreateInviteLinkInspect() {
+	for _, e := range m.CreateInviteLinkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.CreateInviteLink with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateInviteLinkCounter := mm_atomic.LoadUint64(&m.afterCreateInviteLinkCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateInviteLinkMock.defaultExpectation != nil && afterCreateInviteLinkCounter < 1 {
+		if m.CreateInviteLinkMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.CreateInviteLink")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.CreateInviteLink with params: %#v", *m.CreateInviteLinkMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateInviteLink != nil && afterCreateInviteLinkCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.CreateInviteLink")
+	}
+
+	if !m.CreateInviteLinkMock.invocationsDone() && afterCreateInviteLinkCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.CreateInviteLink but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateInviteLinkMock.expectedInvocations), afterCreateInviteLinkCounter)
+	}
+}
+
+type mChatServiceMockCreatePoll struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockCreatePollExpectation
+	expectations       []*ChatServiceMockCreatePollExpectation
+
+	callArgs []*ChatServiceMockCreatePollParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockCreatePollExpectation specifies expectation struct of the ChatService.CreatePoll
+type ChatServiceMockCreatePollExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockCreatePollParams
+	paramPtrs *ChatServiceMockCreatePollParamPtrs
+	results   *ChatServiceMockCreatePollResults
+	Counter   uint64
+}
+
+// ChatServiceMockCreatePollParams contains parameters of the ChatService.CreatePoll
+type ChatServiceMockCreatePollParams struct {
+	ctx    context.Context
+	create *model.PollCreate
+}
+
+// ChatServiceMockCreatePollParamPtrs contains pointers to parameters of the ChatService.CreatePoll
+type ChatServiceMockCreatePollParamPtrs struct {
+	ctx    *context.Context
+	create **model.PollCreate
+}
+
+// ChatServiceMockCreatePollResults contains results of the ChatService.CreatePoll
+type ChatServiceMockCreatePollResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreatePoll *mChatServiceMockCreatePoll) Optional() *mChatServiceMockCreatePoll {
+	mmCreatePoll.optional = true
+	return mmCreatePoll
+}
+
+// Expect sets up expected params for ChatService.CreatePoll
+func (mmCreatePoll *mChatServiceMockCreatePoll) Expect(ctx context.Context, create *model.PollCreate) *mChatServiceMockCreatePoll {
+	if mmCreatePoll.mock.funcCreatePoll != nil {
+		mmCreatePoll.mock.t.Fatalf("ChatServiceMock.CreatePoll mock is already set by Set")
+	}
+
+	if mmCreatePoll.defaultExpectation == nil {
+		mmCreatePoll.defaultExpectation = &ChatServiceMockCreatePollExpectation{}
+	}
+
+	if mmCreatePoll.defaultExpectation.paramPtrs != nil {
+		mmCreatePoll.mock.t.Fatalf("ChatServiceMock.CreatePoll mock is already set by ExpectParams functions")
+	}
+
+	mmCreatePoll.defaultExpectation.params = &ChatServiceMockCreatePollParams{ctx, create}
+	for _, e := range mmCreatePoll.expectations {
+		if minimock.Equal(e.params, mmCreatePoll.defaultExpectation.params) {
+			mmCreatePoll.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePoll.defaultExpectation.params)
+		}
+	}
+
+	return mmCreatePoll
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.CreatePoll
+func (mmCreatePoll *mChatServiceMockCreatePoll) ExpectCtxParam1(ctx context.Context) *mChatServiceMockCreatePoll {
+	if mmCreatePoll.mock.funcCreatePoll != nil {
+		mmCreatePoll.mock.t.Fatalf("ChatServiceMock.CreatePoll mock is already set by Set")
+	}
+
+	if mmCreatePoll.defaultExpectation == nil {
+		mmCreatePoll.defaultExpectation = &ChatServiceMockCreatePollExpectation{}
+	}
+
+	if mmCreatePoll.defaultExpectation.params != nil {
+		mmCreatePoll.mock.t.Fatalf("ChatServiceMock.CreatePoll mock is already set by Expect")
+	}
+
+	if mmCreatePoll.defaultExpectation.paramPtrs == nil {
+		mmCreatePoll.defaultExpectation.paramPtrs = &ChatServiceMockCreatePollParamPtrs{}
+	}
+	mmCreatePoll.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreatePoll
+}
+
+// ExpectCreateParam2 sets up expected param create for ChatService.CreatePoll
+func (mmCreatePoll *mChatServiceMockCreatePoll) ExpectCreateParam2(create *model.PollCreate) *mChatServiceMockCreatePoll {
+	if mmCreatePoll.mock.funcCreatePoll != nil {
+		mmCreatePoll.mock.t.Fatalf("ChatServiceMock.CreatePoll mock is already set by Set")
+	}
+
+	if mmCreatePoll.defaultExpectation == nil {
+		mmCreatePoll.defaultExpectation = &ChatServiceMockCreatePollExpectation{}
+	}
+
+	if mmCreatePoll.defaultExpectation.params != nil {
+		mmCreatePoll.mock.t.Fatalf("ChatServiceMock.CreatePoll mock is already set by Expect")
+	}
+
+	if mmCreatePoll.defaultExpectation.paramPtrs == nil {
+		mmCreatePoll.defaultExpectation.paramPtrs = &ChatServiceMockCreatePollParamPtrs{}
+	}
+	mmCreatePoll.defaultExpectation.paramPtrs.create = &create
+
+	return mmCreatePoll
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.CreatePoll
+func (mmCreatePoll *mChatServiceMockCreatePoll) Inspect(f func(ctx context.Context, create *model.PollCreate)) *mChatServiceMockCreatePoll {
+	if mmCreatePoll.mock.inspectFuncCreatePoll != nil {
+		mmCreatePoll.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.CreatePoll")
+	}
+
+	mmCreatePoll.mock.inspectFuncCreatePoll = f
+
+	return mmCreatePoll
+}
+
+// Return sets up results that will be returned by ChatService.CreatePoll
+func (mmCreatePoll *mChatServiceMockCreatePoll) Return(mp1 *model.Message, err error) *ChatServiceMock {
+	if mmCreatePoll.mock.funcCreatePoll != nil {
+		mmCreatePoll.mock.t.Fatalf("ChatServiceMock.CreatePoll mock is already set by Set")
+	}
+
+	if mmCreatePoll.defaultExpectation == nil {
+		mmCreatePoll.defaultExpectation = &ChatServiceMockCreatePollExpectation{mock: mmCreatePoll.mock}
+	}
+	mmCreatePoll.defaultExpectation.results = &ChatServiceMockCreatePollResults{mp1, err}
+	return mmCreatePoll.mock
+}
+
+// Set uses given function f to mock the ChatService.CreatePoll method
+func (mmCreatePoll *mChatServiceMockCreatePoll) Set(f func(ctx context.Context, create *model.PollCreate) (mp1 *model.Message, err error)) *ChatServiceMock {
+	if mmCreatePoll.defaultExpectation != nil {
+		mmCreatePoll.mock.t.Fatalf("Default expectation is already set for the ChatService.CreatePoll method")
+	}
+
+	if len(mmCreatePoll.expectations) > 0 {
+		mmCreatePoll.mock.t.Fatalf("Some expectations are already set for the ChatService.CreatePoll method")
+	}
+
+	mmCreatePoll.mock.funcCreatePoll = f
+	return mmCreatePoll.mock
+}
+
+// When sets expectation for the ChatService.CreatePoll which will trigger the result defined by the following
+// Then helper
+func (mmCreatePoll *mChatServiceMockCreatePoll) When(ctx context.Context, create *model.PollCreate) *ChatServiceMockCreatePollExpectation {
+	if mmCreatePoll.mock.funcCreatePoll != nil {
+		mmCreatePoll.mock.t.Fatalf("ChatServiceMock.CreatePoll mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockCreatePollExpectation{
+		mock:   mmCreatePoll.mock,
+		params: &ChatServiceMockCreatePollParams{ctx, create},
+	}
+	mmCreatePoll.expectations = append(mmCreatePoll.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.CreatePoll return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockCreatePollExpectation) Then(mp1 *model.Message, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockCreatePollResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.CreatePoll should be invoked
+func (mmCreatePoll *mChatServiceMockCreatePoll) Times(n uint64) *mChatServiceMockCreatePoll {
+	if n == 0 {
+		mmCreatePoll.mock.t.Fatalf("Times of ChatServiceMock.CreatePoll mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreatePoll.expectedInvocations, n)
+	return mmCreatePoll
+}
+
+func (mmCreatePoll *mChatServiceMockCreatePoll) invocationsDone() bool {
+	if len(mmCreatePoll.expectations) == 0 && mmCreatePoll.defaultExpectation == nil && mmCreatePoll.mock.funcCreatePoll == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePoll.mock.afterCreatePollCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePoll.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreatePoll implements service.ChatService
+func (mmCreatePoll *ChatServiceMock) CreatePoll(ctx context.Context, create *model.PollCreate) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmCreatePoll.beforeCreatePollCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePoll.afterCreatePollCounter, 1)
+
+	if mmCreatePoll.inspectFuncCreatePoll != nil {
+		mmCreatePoll.inspectFuncCreatePoll(ctx, create)
+	}
+
+	mm_params := ChatServiceMockCreatePollParams{ctx, create}
+
+	// Record call args
+	mmCreatePoll.CreatePollMock.mutex.Lock()
+	mmCreatePoll.CreatePollMock.callArgs = append(mmCreatePoll.CreatePollMock.callArgs, &mm_params)
+	mmCreatePoll.CreatePollMock.mutex.Unlock()
+
+	for _, e := range mmCreatePoll.CreatePollMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmCreatePoll.CreatePollMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePoll.CreatePollMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePoll.CreatePollMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePoll.CreatePollMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockCreatePollParams{ctx, create}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreatePoll.t.Errorf("ChatServiceMock.CreatePoll got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.create != nil && !minimock.Equal(*mm_want_ptrs.create, mm_got.create) {
+				mmCreatePoll.t.Errorf("ChatServiceMock.CreatePoll got unexpected parameter create, want: %#v, got: %#v%s\n", *mm_want_ptrs.create, mm_got.create, minimock.Diff(*mm_want_ptrs.create, mm_got.create))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePoll.t.Errorf("ChatServiceMock.CreatePoll got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePoll.CreatePollMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePoll.t.Fatal("No results are set for the ChatServiceMock.CreatePoll")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmCreatePoll.funcCreatePoll != nil {
+		return mmCreatePoll.funcCreatePoll(ctx, create)
+	}
+	mmCreatePoll.t.Fatalf("Unexpected call to ChatServiceMock.CreatePoll. %v %v", ctx, create)
+	return
+}
+
+// CreatePollAfterCounter returns a count of finished ChatServiceMock.CreatePoll invocations
+func (mmCreatePoll *ChatServiceMock) CreatePollAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePoll.afterCreatePollCounter)
+}
+
+// CreatePollBeforeCounter returns a count of ChatServiceMock.CreatePoll invocations
+func (mmCreatePoll *ChatServiceMock) CreatePollBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePoll.beforeCreatePollCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.CreatePoll.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePoll *mChatServiceMockCreatePoll) Calls() []*ChatServiceMockCreatePollParams {
+	mmCreatePoll.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockCreatePollParams, len(mmCreatePoll.callArgs))
+	copy(argCopy, mmCreatePoll.callArgs)
+
+	mmCreatePoll.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePollDone returns true if the count of the CreatePoll invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockCreatePollDone() bool {
+	if m.CreatePollMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreatePollMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreatePollMock.invocationsDone()
+}
+
+// MinimockCreatePollInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockCreatePollInspect() {
+	for _, e := range m.CreatePollMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.CreatePoll with params: %#v", *e.params)
+		}
+	}
+
+	afterCreatePollCounter := mm_atomic.LoadUint64(&m.afterCreatePollCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePollMock.defaultExpectation != nil && afterCreatePollCounter < 1 {
+		if m.CreatePollMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.CreatePoll")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.CreatePoll with params: %#v", *m.CreatePollMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePoll != nil && afterCreatePollCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.CreatePoll")
+	}
+
+	if !m.CreatePollMock.invocationsDone() && afterCreatePollCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.CreatePoll but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePollMock.expectedInvocations), afterCreatePollCounter)
+	}
+}
+
+type mChatServiceMockDeleteChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockDeleteChatExpectation
+	expectations       []*ChatServiceMockDeleteChatExpectation
+
+	callArgs []*ChatServiceMockDeleteChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockDeleteChatExpectation specifies expectation struct of the ChatService.DeleteChat
+type ChatServiceMockDeleteChatExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockDeleteChatParams
+	paramPtrs *ChatServiceMockDeleteChatParamPtrs
+	results   *ChatServiceMockDeleteChatResults
+	Counter   uint64
+}
+
+// ChatServiceMockDeleteChatParams contains parameters of the ChatService.DeleteChat
+type ChatServiceMockDeleteChatParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ChatServiceMockDeleteChatParamPtrs contains pointers to parameters of the ChatService.DeleteChat
+type ChatServiceMockDeleteChatParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ChatServiceMockDeleteChatResults contains results of the ChatService.DeleteChat
+type ChatServiceMockDeleteChatResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteChat *mChatServiceMockDeleteChat) Optional() *mChatServiceMockDeleteChat {
+	mmDeleteChat.optional = true
+	return mmDeleteChat
+}
+
+// Expect sets up expected params for ChatService.DeleteChat
+func (mmDeleteChat *mChatServiceMockDeleteChat) Expect(ctx context.Context, id int64) *mChatServiceMockDeleteChat {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{}
+	}
+
+	if mmDeleteChat.defaultExpectation.paramPtrs != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteChat.defaultExpectation.params = &ChatServiceMockDeleteChatParams{ctx, id}
+	for _, e := range mmDeleteChat.expectations {
+		if minimock.Equal(e.params, mmDeleteChat.defaultExpectation.params) {
+			mmDeleteChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteChat.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.DeleteChat
+func (mmDeleteChat *mChatServiceMockDeleteChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockDeleteChat {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{}
+	}
+
+	if mmDeleteChat.defaultExpectation.params != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Expect")
+	}
+
+	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
+		mmDeleteChat.defaultExpectation.paramPtrs = &ChatServiceMockDeleteChatParamPtrs{}
+	}
+	mmDeleteChat.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteChat
+}
+
+// ExpectIdParam2 sets up expected param id for ChatService.DeleteChat
+func (mmDeleteChat *mChatServiceMockDeleteChat) ExpectIdParam2(id int64) *mChatServiceMockDeleteChat {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{}
+	}
+
+	if mmDeleteChat.defaultExpectation.params != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Expect")
+	}
+
+	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
+		mmDeleteChat.defaultExpectation.paramPtrs = &ChatServiceMockDeleteChatParamPtrs{}
+	}
+	mmDeleteChat.defaultExpectation.paramPtrs.id = &id
+
+	return mmDeleteChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.DeleteChat
+func (mmDeleteChat *mChatServiceMockDeleteChat) Inspect(f func(ctx context.Context, id int64)) *mChatServiceMockDeleteChat {
+	if mmDeleteChat.mock.inspectFuncDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.DeleteChat")
+	}
+
+	mmDeleteChat.mock.inspectFuncDeleteChat = f
+
+	return mmDeleteChat
+}
+
+// Return sets up results that will be returned by ChatService.DeleteChat
+func (mmDeleteChat *mChatServiceMockDeleteChat) Return(err error) *ChatServiceMock {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{mock: mmDeleteChat.mock}
+	}
+	mmDeleteChat.defaultExpectation.results = &ChatServiceMockDeleteChatResults{err}
+	return mmDeleteChat.mock
+}
+
+// Set uses given function f to mock the ChatService.DeleteChat method
+func (mmDeleteChat *mChatServiceMockDeleteChat) Set(f func(ctx context.Context, id int64) (err error)) *ChatServiceMock {
+	if mmDeleteChat.defaultExpectation != nil {
+		mmDeleteChat.mock.t.Fatalf("Default expectation is already set for the ChatService.DeleteChat method")
+	}
+
+	if len(mmDeleteChat.expectations) > 0 {
+		mmDeleteChat.mock.t.Fatalf("Some expectations are already set for the ChatService.DeleteChat method")
+	}
+
+	mmDeleteChat.mock.funcDeleteChat = f
+	return mmDeleteChat.mock
+}
+
+// When sets expectation for the ChatService.DeleteChat which will trigger the result defined by the following
+// Then helper
+func (mmDeleteChat *mChatServiceMockDeleteChat) When(ctx context.Context, id int64) *ChatServiceMockDeleteChatExpectation {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockDeleteChatExpectation{
+		mock:   mmDeleteChat.mock,
+		params: &ChatServiceMockDeleteChatParams{ctx, id},
+	}
+	mmDeleteChat.expectations = append(mmDeleteChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.DeleteChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockDeleteChatExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockDeleteChatResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.DeleteChat should be invoked
+func (mmDeleteChat *mChatServiceMockDeleteChat) Times(n uint64) *mChatServiceMockDeleteChat {
+	if n == 0 {
+		mmDeleteChat.mock.t.Fatalf("Times of ChatServiceMock.DeleteChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteChat.expectedInvocations, n)
+	return mmDeleteChat
+}
+
+func (mmDeleteChat *mChatServiceMockDeleteChat) invocationsDone() bool {
+	if len(mmDeleteChat.expectations) == 0 && mmDeleteChat.defaultExpectation == nil && mmDeleteChat.mock.funcDeleteChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteChat.mock.afterDeleteChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteChat implements service.ChatService
+func (mmDeleteChat *ChatServiceMock) DeleteChat(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteChat.beforeDeleteChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteChat.afterDeleteChatCounter, 1)
+
+	if mmDeleteChat.inspectFuncDeleteChat != nil {
+		mmDeleteChat.inspectFuncDeleteChat(ctx, id)
+	}
+
+	mm_params := ChatServiceMockDeleteChatParams{ctx, id}
+
+	// Record call args
+	mmDeleteChat.DeleteChatMock.mutex.Lock()
+	mmDeleteChat.DeleteChatMock.callArgs = append(mmDeleteChat.DeleteChatMock.callArgs, &mm_params)
+	mmDeleteChat.DeleteChatMock.mutex.Unlock()
+
+	for _, e := range mmDeleteChat.DeleteChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteChat.DeleteChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteChat.DeleteChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteChat.DeleteChatMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteChat.DeleteChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockDeleteChatParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteChat.t.Errorf("ChatServiceMock.DeleteChat got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteChat.t.Errorf("ChatServiceMock.DeleteChat got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteChat.t.Errorf("ChatServiceMock.DeleteChat got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteChat.DeleteChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteChat.t.Fatal("No results are set for the ChatServiceMock.DeleteChat")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteChat.funcDeleteChat != nil {
+		return mmDeleteChat.funcDeleteChat(ctx, id)
+	}
+	mmDeleteChat.t.Fatalf("Unexpected call to ChatServiceMock.DeleteChat. %v %v", ctx, id)
+	return
+}
+
+// DeleteChatAfterCounter returns a count of finished ChatServiceMock.DeleteChat invocations
+func (mmDeleteChat *ChatServiceMock) DeleteChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteChat.afterDeleteChatCounter)
+}
+
+// DeleteChatBeforeCounter returns a count of ChatServiceMock.DeleteChat invocations
+func (mmDeleteChat *ChatServiceMock) DeleteChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteChat.beforeDeleteChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.DeleteChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteChat *mChatServiceMockDeleteChat) Calls() []*ChatServiceMockDeleteChatParams {
+	mmDeleteChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockDeleteChatParams, len(mmDeleteChat.callArgs))
+	copy(argCopy, mmDeleteChat.callArgs)
+
+	mmDeleteChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteChatDone returns true if the count of the DeleteChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockDeleteChatDone() bool {
+	if m.DeleteChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteChatMock.invocationsDone()
+}
+
+// MinimockDeleteChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockDeleteChatInspect() {
+	for _, e := range m.DeleteChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteChat with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteChatCounter := mm_atomic.LoadUint64(&m.afterDeleteChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteChatMock.defaultExpectation != nil && afterDeleteChatCounter < 1 {
+		if m.DeleteChatMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.DeleteChat")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteChat with params: %#v", *m.DeleteChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteChat != nil && afterDeleteChatCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.DeleteChat")
+	}
+
+	if !m.DeleteChatMock.invocationsDone() && afterDeleteChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.DeleteChat but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteChatMock.expectedInvocations), afterDeleteChatCounter)
+	}
+}
+
+type mChatServiceMockDeleteMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockDeleteMessageExpectation
+	expectations       []*ChatServiceMockDeleteMessageExpectation
+
+	callArgs []*ChatServiceMockDeleteMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockDeleteMessageExpectation specifies expectation struct of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockDeleteMessageParams
+	paramPtrs *ChatServiceMockDeleteMessageParamPtrs
+	results   *ChatServiceMockDeleteMessageResults
+	Counter   uint64
+}
+
+// ChatServiceMockDeleteMessageParams contains parameters of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageParams struct {
+	ctx context.Context
+	del *model.MessageDelete
+}
+
+// ChatServiceMockDeleteMessageParamPtrs contains pointers to parameters of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageParamPtrs struct {
+	ctx *context.Context
+	del **model.MessageDelete
+}
+
+// ChatServiceMockDeleteMessageResults contains results of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Optional() *mChatServiceMockDeleteMessage {
+	mmDeleteMessage.optional = true
+	return mmDeleteMessage
+}
+
+// Expect sets up expected params for ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Expect(ctx context.Context, del *model.MessageDelete) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteMessage.defaultExpectation.params = &ChatServiceMockDeleteMessageParams{ctx, del}
+	for _, e := range mmDeleteMessage.expectations {
+		if minimock.Equal(e.params, mmDeleteMessage.defaultExpectation.params) {
+			mmDeleteMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Expect")
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &ChatServiceMockDeleteMessageParamPtrs{}
+	}
+	mmDeleteMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteMessage
+}
+
+// ExpectDelParam2 sets up expected param del for ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) ExpectDelParam2(del *model.MessageDelete) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Expect")
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &ChatServiceMockDeleteMessageParamPtrs{}
+	}
+	mmDeleteMessage.defaultExpectation.paramPtrs.del = &del
+
+	return mmDeleteMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Inspect(f func(ctx context.Context, del *model.MessageDelete)) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.inspectFuncDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.DeleteMessage")
+	}
+
+	mmDeleteMessage.mock.inspectFuncDeleteMessage = f
+
+	return mmDeleteMessage
+}
+
+// Return sets up results that will be returned by ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Return(err error) *ChatServiceMock {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{mock: mmDeleteMessage.mock}
+	}
+	mmDeleteMessage.defaultExpectation.results = &ChatServiceMockDeleteMessageResults{err}
+	return mmDeleteMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.DeleteMessage method
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Set(f func(ctx context.Context, del *model.MessageDelete) (err error)) *ChatServiceMock {
+	if mmDeleteMessage.defaultExpectation != nil {
+		mmDeleteMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.DeleteMessage method")
+	}
+
+	if len(mmDeleteMessage.expectations) > 0 {
+		mmDeleteMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.DeleteMessage method")
+	}
+
+	mmDeleteMessage.mock.funcDeleteMessage = f
+	return mmDeleteMessage.mock
+}
+
+// When sets expectation for the ChatService.DeleteMessage which will trigger the result defined by the following
+// Then helper
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) When(ctx context.Context, del *model.MessageDelete) *ChatServiceMockDeleteMessageExpectation {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockDeleteMessageExpectation{
+		mock:   mmDeleteMessage.mock,
+		params: &ChatServiceMockDeleteMessageParams{ctx, del},
+	}
+	mmDeleteMessage.expectations = append(mmDeleteMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.DeleteMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockDeleteMessageExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockDeleteMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.DeleteMessage should be invoked
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Times(n uint64) *mChatServiceMockDeleteMessage {
+	if n == 0 {
+		mmDeleteMessage.mock.t.Fatalf("Times of ChatServiceMock.DeleteMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteMessage.expectedInvocations, n)
+	return mmDeleteMessage
+}
+
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) invocationsDone() bool {
+	if len(mmDeleteMessage.expectations) == 0 && mmDeleteMessage.defaultExpectation == nil && mmDeleteMessage.mock.funcDeleteMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteMessage.mock.afterDeleteMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteMessage implements service.ChatService
+func (mmDeleteMessage *ChatServiceMock) DeleteMessage(ctx context.Context, del *model.MessageDelete) (err error) {
+	mm_atomic.AddUint64(&mmDeleteMessage.beforeDeleteMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteMessage.afterDeleteMessageCounter, 1)
+
+	if mmDeleteMessage.inspectFuncDeleteMessage != nil {
+		mmDeleteMessage.inspectFuncDeleteMessage(ctx, del)
+	}
+
+	mm_params := ChatServiceMockDeleteMessageParams{ctx, del}
+
+	// Record call args
+	mmDeleteMessage.DeleteMessageMock.mutex.Lock()
+	mmDeleteMessage.DeleteMessageMock.callArgs = append(mmDeleteMessage.DeleteMessageMock.callArgs, &mm_params)
+	mmDeleteMessage.DeleteMessageMock.mutex.Unlock()
+
+	for _, e := range mmDeleteMessage.DeleteMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteMessage.DeleteMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteMessage.DeleteMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteMessage.DeleteMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteMessage.DeleteMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockDeleteMessageParams{ctx, del}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteMessage.t.Errorf("ChatServiceMock.DeleteMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.del != nil && !minimock.Equal(*mm_want_ptrs.del, mm_got.del) {
+				mmDeleteMessage.t.Errorf("ChatServiceMock.DeleteMessage got unexpected parameter del, want: %#v, got: %#v%s\n", *mm_want_ptrs.del, mm_got.del, minimock.Diff(*mm_want_ptrs.del, mm_got.del))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteMessage.t.Errorf("ChatServiceMock.DeleteMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteMessage.DeleteMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteMessage.t.Fatal("No results are set for the ChatServiceMock.DeleteMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteMessage.funcDeleteMessage != nil {
+		return mmDeleteMessage.funcDeleteMessage(ctx, del)
+	}
+	mmDeleteMessage.t.Fatalf("Unexpected call to ChatServiceMock.DeleteMessage. %v %v", ctx, del)
+	return
+}
+
+// DeleteMessageAfterCounter returns a count of finished ChatServiceMock.DeleteMessage invocations
+func (mmDeleteMessage *ChatServiceMock) DeleteMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessage.afterDeleteMessageCounter)
+}
+
+// DeleteMessageBeforeCounter returns a count of ChatServiceMock.DeleteMessage invocations
+func (mmDeleteMessage *ChatServiceMock) DeleteMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessage.beforeDeleteMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.DeleteMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Calls() []*ChatServiceMockDeleteMessageParams {
+	mmDeleteMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockDeleteMessageParams, len(mmDeleteMessage.callArgs))
+	copy(argCopy, mmDeleteMessage.callArgs)
+
+	mmDeleteMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteMessageDone returns true if the count of the DeleteMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockDeleteMessageDone() bool {
+	if m.DeleteMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMessageMock.invocationsDone()
+}
+
+// MinimockDeleteMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockDeleteMessageInspect() {
+	for _, e := range m.DeleteMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteMessageCounter := mm_atomic.LoadUint64(&m.afterDeleteMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMessageMock.defaultExpectation != nil && afterDeleteMessageCounter < 1 {
+		if m.DeleteMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.DeleteMessage")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteMessage with params: %#v", *m.DeleteMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteMessage != nil && afterDeleteMessageCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.DeleteMessage")
+	}
+
+	if !m.DeleteMessageMock.invocationsDone() && afterDeleteMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.DeleteMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMessageMock.expectedInvocations), afterDeleteMessageCounter)
+	}
+}
+
+type mChatServiceMockEditMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockEditMessageExpectation
+	expectations       []*ChatServiceMockEditMessageExpectation
+
+	callArgs []*ChatServiceMockEditMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockEditMessageExpectation specifies expectation struct of the ChatService.EditMessage
+type ChatServiceMockEditMessageExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockEditMessageParams
+	paramPtrs *ChatServiceMockEditMessageParamPtrs
+	results   *ChatServiceMockEditMessageResults
+	Counter   uint64
+}
+
+// ChatServiceMockEditMessageParams contains parameters of the ChatService.EditMessage
+type ChatServiceMockEditMessageParams struct {
+	ctx  context.Context
+	edit *model.MessageEdit
+}
+
+// ChatServiceMockEditMessageParamPtrs contains pointers to parameters of the ChatService.EditMessage
+type ChatServiceMockEditMessageParamPtrs struct {
+	ctx  *context.Context
+	edit **model.MessageEdit
+}
+
+// ChatServiceMockEditMessageResults contains results of the ChatService.EditMessage
+type ChatServiceMockEditMessageResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEditMessage *mChatServiceMockEditMessage) Optional() *mChatServiceMockEditMessage {
+	mmEditMessage.optional = true
+	return mmEditMessage
+}
+
+// Expect sets up expected params for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) Expect(ctx context.Context, edit *model.MessageEdit) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by ExpectParams functions")
+	}
+
+	mmEditMessage.defaultExpectation.params = &ChatServiceMockEditMessageParams{ctx, edit}
+	for _, e := range mmEditMessage.expectations {
+		if minimock.Equal(e.params, mmEditMessage.defaultExpectation.params) {
+			mmEditMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEditMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmEditMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatServiceMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmEditMessage
+}
+
+// ExpectEditParam2 sets up expected param edit for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) ExpectEditParam2(edit *model.MessageEdit) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatServiceMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.edit = &edit
+
+	return mmEditMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) Inspect(f func(ctx context.Context, edit *model.MessageEdit)) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.inspectFuncEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.EditMessage")
+	}
+
+	mmEditMessage.mock.inspectFuncEditMessage = f
+
+	return mmEditMessage
+}
+
+// Return sets up results that will be returned by ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) Return(mp1 *model.Message, err error) *ChatServiceMock {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{mock: mmEditMessage.mock}
+	}
+	mmEditMessage.defaultExpectation.results = &ChatServiceMockEditMessageResults{mp1, err}
+	return mmEditMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.EditMessage method
+func (mmEditMessage *mChatServiceMockEditMessage) Set(f func(ctx context.Context, edit *model.MessageEdit) (mp1 *model.Message, err error)) *ChatServiceMock {
+	if mmEditMessage.defaultExpectation != nil {
+		mmEditMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.EditMessage method")
+	}
+
+	if len(mmEditMessage.expectations) > 0 {
+		mmEditMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.EditMessage method")
+	}
+
+	mmEditMessage.mock.funcEditMessage = f
+	return mmEditMessage.mock
+}
+
+// When sets expectation for the ChatService.EditMessage which will trigger the result defined by the following
+// Then helper
+func (mmEditMessage *mChatServiceMockEditMessage) When(ctx context.Context, edit *model.MessageEdit) *ChatServiceMockEditMessageExpectation {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockEditMessageExpectation{
+		mock:   mmEditMessage.mock,
+		params: &ChatServiceMockEditMessageParams{ctx, edit},
+	}
+	mmEditMessage.expectations = append(mmEditMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.EditMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockEditMessageExpectation) Then(mp1 *model.Message, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockEditMessageResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.EditMessage should be invoked
+func (mmEditMessage *mChatServiceMockEditMessage) Times(n uint64) *mChatServiceMockEditMessage {
+	if n == 0 {
+		mmEditMessage.mock.t.Fatalf("Times of ChatServiceMock.EditMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEditMessage.expectedInvocations, n)
+	return mmEditMessage
+}
+
+func (mmEditMessage *mChatServiceMockEditMessage) invocationsDone() bool {
+	if len(mmEditMessage.expectations) == 0 && mmEditMessage.defaultExpectation == nil && mmEditMessage.mock.funcEditMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEditMessage.mock.afterEditMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEditMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EditMessage implements service.ChatService
+func (mmEditMessage *ChatServiceMock) EditMessage(ctx context.Context, edit *model.MessageEdit) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmEditMessage.beforeEditMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmEditMessage.afterEditMessageCounter, 1)
+
+	if mmEditMessage.inspectFuncEditMessage != nil {
+		mmEditMessage.inspectFuncEditMessage(ctx, edit)
+	}
+
+	mm_params := ChatServiceMockEditMessageParams{ctx, edit}
+
+	// Record call args
+	mmEditMessage.EditMessageMock.mutex.Lock()
+	mmEditMessage.EditMessageMock.callArgs = append(mmEditMessage.EditMessageMock.callArgs, &mm_params)
+	mmEditMessage.EditMessageMock.mutex.Unlock()
+
+	for _, e := range mmEditMessage.EditMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmEditMessage.EditMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEditMessage.EditMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmEditMessage.EditMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmEditMessage.EditMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockEditMessageParams{ctx, edit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.edit != nil && !minimock.Equal(*mm_want_ptrs.edit, mm_got.edit) {
+				mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameter edit, want: %#v, got: %#v%s\n", *mm_want_ptrs.edit, mm_got.edit, minimock.Diff(*mm_want_ptrs.edit, mm_got.edit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEditMessage.EditMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEditMessage.t.Fatal("No results are set for the ChatServiceMock.EditMessage")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmEditMessage.funcEditMessage != nil {
+		return mmEditMessage.funcEditMessage(ctx, edit)
+	}
+	mmEditMessage.t.Fatalf("Unexpected call to ChatServiceMock.EditMessage. %v %v", ctx, edit)
+	return
+}
+
+// EditMessageAfterCounter returns a count of finished ChatServiceMock.EditMessage invocations
+func (mmEditMessage *ChatServiceMock) EditMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEditMessage.afterEditMessageCounter)
+}
+
+// EditMessageBeforeCounter returns a count of ChatServiceMock.EditMessage invocations
+func (mmEditMessage *ChatServiceMock) EditMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEditMessage.beforeEditMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.EditMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEditMessage *mChatServiceMockEditMessage) Calls() []*ChatServiceMockEditMessageParams {
+	mmEditMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockEditMessageParams, len(mmEditMessage.callArgs))
+	copy(argCopy, mmEditMessage.callArgs)
+
+	mmEditMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEditMessageDone returns true if the count of the EditMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockEditMessageDone() bool {
+	if m.EditMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EditMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EditMessageMock.invocationsDone()
+}
+
+// MinimockEditMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockEditMessageInspect() {
+	for _, e := range m.EditMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.EditMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterEditMessageCounter := mm_atomic.LoadUint64(&m.afterEditMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EditMessageMock.defaultExpectation != nil && afterEditMessageCounter < 1 {
+		if m.EditMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.EditMessage")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.EditMessage with params: %#v", *m.EditMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEditMessage != nil && afterEditMessageCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.EditMessage")
+	}
+
+	if !m.EditMessageMock.invocationsDone() && afterEditMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.EditMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.EditMessageMock.expectedInvocations), afterEditMessageCounter)
+	}
+}
+
+type mChatServiceMockForwardMessages struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockForwardMessagesExpectation
+	expectations       []*ChatServiceMockForwardMessagesExpectation
+
+	callArgs []*ChatServiceMockForwardMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockForwardMessagesExpectation specifies expectation struct of the ChatService.ForwardMessages
+type ChatServiceMockForwardMessagesExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockForwardMessagesParams
+	paramPtrs *ChatServiceMockForwardMessagesParamPtrs
+	results   *ChatServiceMockForwardMessagesResults
+	Counter   uint64
+}
+
+// ChatServiceMockForwardMessagesParams contains parameters of the ChatService.ForwardMessages
+type ChatServiceMockForwardMessagesParams struct {
+	ctx          context.Context
+	sourceChatID int64
+	messageIDs   []string
+	targetChatID int64
+}
+
+// ChatServiceMockForwardMessagesParamPtrs contains pointers to parameters of the ChatService.ForwardMessages
+type ChatServiceMockForwardMessagesParamPtrs struct {
+	ctx          *context.Context
+	sourceChatID *int64
+	messageIDs   *[]string
+	targetChatID *int64
+}
+
+// ChatServiceMockForwardMessagesResults contains results of the ChatService.ForwardMessages
+type ChatServiceMockForwardMessagesResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmForwardMessages *mChatServiceMockForwardMessages) Optional() *mChatServiceMockForwardMessages {
+	mmForwardMessages.optional = true
+	return mmForwardMessages
+}
+
+// Expect sets up expected params for ChatService.ForwardMessages
+func (mmForwardMessages *mChatServiceMockForwardMessages) Expect(ctx context.Context, sourceChatID int64, messageIDs []string, targetChatID int64) *mChatServiceMockForwardMessages {
+	if mmForwardMessages.mock.funcForwardMessages != nil {
+		mmForwardMessages.mock.t.Fatalf("ChatServiceMock.ForwardMessages mock is already set by Set")
+	}
+
+	if mmForwardMessages.defaultExpectation == nil {
+		mmForwardMessages.defaultExpectation = &ChatServiceMockForwardMessagesExpectation{}
+	}
+
+	if mmForwardMessages.defaultExpectation.paramPtrs != nil {
+		mmForwardMessages.mock.t.Fatalf("ChatServiceMock.ForwardMessages mock is already set by ExpectParams functions")
+	}
+
+	mmForwardMessages.defaultExpectation.params = &ChatServiceMockForwardMessagesParams{ctx, sourceChatID, messageIDs, targetChatID}
+	for _, e := range mmForwardMessages.expectations {
+		if minimock.Equal(e.params, mmForwardMessages.defaultExpectation.params) {
+			mmForwardMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmForwardMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmForwardMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ForwardMessages
+func (mmForwardMessages *mChatServiceMockForwardMessages) ExpectCtxParam1(ctx context.Context) *mChatServiceMockForwardMessages {
+	if mmForwardMessages.mock.funcForwardMessages != nil {
+		mmForwardMessages.mock.t.Fatalf("ChatServiceMock.ForwardMessages mock is already set by Set")
+	}
+
+	if mmForwardMessages.defaultExpectation == nil {
+		mmForwardMessages.defaultExpectation = &ChatServiceMockForwardMessagesExpectation{}
+	}
+
+	if mmForwardMessages.defaultExpectation.params != nil {
+		mmForwardMessages.mock.t.Fatalf("ChatServiceMock.ForwardMessages mock is already set by Expect")
+	}
+
+	if mmForwardMessages.defaultExpectation.paramPtrs == nil {
+		mmForwardMessages.defaultExpectation.paramPtrs = &ChatServiceMockForwardMessagesParamPtrs{}
+	}
+	mmForwardMessages.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmForwardMessages
+}
+
+// ExpectSourceChatIDParam2 sets up expected param sourceChatID for ChatService.ForwardMessages
+func (mmForwardMessages *mChatServiceMockForwardMessages) ExpectSourceChatIDParam2(sourceChatID int64) *mChatServiceMockForwardMessages {
+	if mmForwardMessages.mock.funcForwardMessages != nil {
+		mmForwardMessages.mock.t.Fatalf("ChatServiceMock.ForwardMessages mock is already set by Set")
+	}
+
+	if mmForwardMessages.defaultExpectation == nil {
+		mmForwardMessages.defaultExpectation = &ChatServiceMockForwardMessagesExpectation{}
+	}
+
+	if mmForwardMessages.defaultExpectation.params != nil {
+		mmForwardMessages.mock.t.Fatalf("ChatServiceMock.ForwardMessages mock is already set by Expect")
+	}
+
+	if mmForwardMessages.defaultExpectation.paramPtrs == nil {
+		mmForwardMessages.defaultExpectation.paramPtrs = &ChatServiceMockForwardMessagesParamPtrs{}
+	}
+	mmForwardMessages.defaultExpectation.paramPtrs.sourceChatID = &sourceChatID
+
+	return mmForwardMessages
+}
+
+// ExpectMessageIDsParam3 sets up expected param messageIDs for ChatService.ForwardMessages
+func (mmForwardMessages *mChatServiceMockForwardMessages) ExpectMessageIDsParam3(messageIDs []string) *mChatServiceMockForwardMessages {
+	if mmForwardMessages.mock.funcForwardMessages != nil {
+		mmForwardMessages.mock.t.Fatalf("ChatServiceMock.ForwardMessages mock is already set by Set")
+	}
+
+	if mmForwardMessages.defaultExpectation == nil {
+		mmForwardMessages.defaultExpectation = &ChatServiceMockForwardMessagesExpectation{}
+	}
+
+	if mmForwardMessages.defaultExpectation.params != nil {
+		mmForwardMessages.mock.t.Fatalf("ChatServiceMock.ForwardMessages mock is already set by Expect")
+	}
+
+	if mmForwardMessages.defaultExpectation.paramPtrs == nil {
+		mmForwardMessages.defaultExpectation.paramPtrs = &ChatServiceMockForwardMessagesParamPtrs{}
+	}
+	mmForwardMessages.defaultExpectation.paramPtrs.messageIDs = &messageIDs
+
+	return mmForwardMessages
+}
+
+// ExpectTargetChatIDParam4 sets up expected param targetChatID for ChatService.ForwardMessages
+func (mmForwardMessages *mChatServiceMockForwardMessages) ExpectTargetChatIDParam4(targetChatID int64) *mChatServiceMockForwardMessages {
+	if mmForwardMessages.mock.funcForwardMessages != nil {
+		mmForwardMessages.mock.t.Fatalf("ChatServiceMock.ForwardMessages mock is already set by Set")
+	}
+
+	if mmForwardMessages.defaultExpectation == nil {
+		mmForwardMessages.defaultExpectation = &ChatServiceMockForwardMessagesExpectation{}
+	}
+
+	if mmForwardMessages.defaultExpectation.params != nil {
+		mmForwardMessages.mock.t.Fatalf("ChatServiceMock.ForwardMessages mock is already set by Expect")
+	}
+
+	if mmForwardMessages.defaultExpectation.paramPtrs == nil {
+		mmForwardMessages.defaultExpectation.paramPtrs = &ChatServiceMockForwardMessagesParamPtrs{}
+	}
+	mmForwardMessages.defaultExpectation.paramPtrs.targetChatID = &targetChatID
+
+	return mmForwardMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ForwardMessages
+func (mmForwardMessages *mChatServiceMockForwardMessages) Inspect(f func(ctx context.Context, sourceChatID int64, messageIDs []string, targetChatID int64)) *mChatServiceMockForwardMessages {
+	if mmForwardMessages.mock.inspectFuncForwardMessages != nil {
+		mmForwardMessages.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ForwardMessages")
+	}
+
+	mmForwardMessages.mock.inspectFuncForwardMessages = f
+
+	return mmForwardMessages
+}
+
+// Return sets up results that will be returned by ChatService.ForwardMessages
+func (mmForwardMessages *mChatServiceMockForwardMessages) Return(mpa1 []*model.Message, err error) *ChatServiceMock {
+	if mmForwardMessages.mock.funcForwardMessages != nil {
+		mmForwardMessages.mock.t.Fatalf("ChatServiceMock.ForwardMessages mock is already set by Set")
+	}
+
+	if mmForwardMessages.defaultExpectation == nil {
+		mmForwardMessages.defaultExpectation = &ChatServiceMockForwardMessagesExpectation{mock: mmForwardMessages.mock}
+	}
+	mmForwardMessages.defaultExpectation.results = &ChatServiceMockForwardMessagesResults{mpa1, err}
+	return mmForwardMessages.mock
+}
+
+// Set uses given function f to mock the ChatService.ForwardMessages method
+func (mmForwardMessages *mChatServiceMockForwardMessages) Set(f func(ctx context.Context, sourceChatID int64, messageIDs []string, targetChatID int64) (mpa1 []*model.Message, err error)) *ChatServiceMock {
+	if mmForwardMessages.defaultExpectation != nil {
+		mmForwardMessages.mock.t.Fatalf("Default expectation is already set for the ChatService.ForwardMessages method")
+	}
+
+	if len(mmForwardMessages.expectations) > 0 {
+		mmForwardMessages.mock.t.Fatalf("Some expectations are already set for the ChatService.ForwardMessages method")
+	}
+
+	mmForwardMessages.mock.funcForwardMessages = f
+	return mmForwardMessages.mock
+}
+
+// When sets expectation for the ChatService.ForwardMessages which will trigger the result defined by the following
+// Then helper
+func (mmForwardMessages *mChatServiceMockForwardMessages) When(ctx context.Context, sourceChatID int64, messageIDs []string, targetChatID int64) *ChatServiceMockForwardMessagesExpectation {
+	if mmForwardMessages.mock.funcForwardMessages != nil {
+		mmForwardMessages.mock.t.Fatalf("ChatServiceMock.ForwardMessages mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockForwardMessagesExpectation{
+		mock:   mmForwardMessages.mock,
+		params: &ChatServiceMockForwardMessagesParams{ctx, sourceChatID, messageIDs, targetChatID},
+	}
+	mmForwardMessages.expectations = append(mmForwardMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ForwardMessages return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockForwardMessagesExpectation) Then(mpa1 []*model.Message, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockForwardMessagesResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ForwardMessages should be invoked
+func (mmForwardMessages *mChatServiceMockForwardMessages) Times(n uint64) *mChatServiceMockForwardMessages {
+	if n == 0 {
+		mmForwardMessages.mock.t.Fatalf("Times of ChatServiceMock.ForwardMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmForwardMessages.expectedInvocations, n)
+	return mmForwardMessages
+}
+
+func (mmForwardMessages *mChatServiceMockForwardMessages) invocationsDone() bool {
+	if len(mmForwardMessages.expectations) == 0 && mmForwardMessages.defaultExpectation == nil && mmForwardMessages.mock.funcForwardMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmForwardMessages.mock.afterForwardMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmForwardMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ForwardMessages implements service.ChatService
+func (mmForwardMessages *ChatServiceMock) ForwardMessages(ctx context.Context, sourceChatID int64, messageIDs []string, targetChatID int64) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmForwardMessages.beforeForwardMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmForwardMessages.afterForwardMessagesCounter, 1)
+
+	if mmForwardMessages.inspectFuncForwardMessages != nil {
+		mmForwardMessages.inspectFuncForwardMessages(ctx, sourceChatID, messageIDs, targetChatID)
+	}
+
+	mm_params := ChatServiceMockForwardMessagesParams{ctx, sourceChatID, messageIDs, targetChatID}
+
+	// Record call args
+	mmForwardMessages.ForwardMessagesMock.mutex.Lock()
+	mmForwardMessages.ForwardMessagesMock.callArgs = append(mmForwardMessages.ForwardMessagesMock.callArgs, &mm_params)
+	mmForwardMessages.ForwardMessagesMock.mutex.Unlock()
+
+	for _, e := range mmForwardMessages.ForwardMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmForwardMessages.ForwardMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmForwardMessages.ForwardMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmForwardMessages.ForwardMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmForwardMessages.ForwardMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockForwardMessagesParams{ctx, sourceChatID, messageIDs, targetChatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmForwardMessages.t.Errorf("ChatServiceMock.ForwardMessages got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.sourceChatID != nil && !minimock.Equal(*mm_want_ptrs.sourceChatID, mm_got.sourceChatID) {
+				mmForwardMessages.t.Errorf("ChatServiceMock.ForwardMessages got unexpected parameter sourceChatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.sourceChatID, mm_got.sourceChatID, minimock.Diff(*mm_want_ptrs.sourceChatID, mm_got.sourceChatID))
+			}
+
+			if mm_want_ptrs.messageIDs != nil && !minimock.Equal(*mm_want_ptrs.messageIDs, mm_got.messageIDs) {
+				mmForwardMessages.t.Errorf("ChatServiceMock.ForwardMessages got unexpected parameter messageIDs, want: %#v, got: %#v%s\n", *mm_want_ptrs.messageIDs, mm_got.messageIDs, minimock.Diff(*mm_want_ptrs.messageIDs, mm_got.messageIDs))
+			}
+
+			if mm_want_ptrs.targetChatID != nil && !minimock.Equal(*mm_want_ptrs.targetChatID, mm_got.targetChatID) {
+				mmForwardMessages.t.Errorf("ChatServiceMock.ForwardMessages got unexpected parameter targetChatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.targetChatID, mm_got.targetChatID, minimock.Diff(*mm_want_ptrs.targetChatID, mm_got.targetChatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmForwardMessages.t.Errorf("ChatServiceMock.ForwardMessages got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmForwardMessages.ForwardMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmForwardMessages.t.Fatal("No results are set for the ChatServiceMock.ForwardMessages")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmForwardMessages.funcForwardMessages != nil {
+		return mmForwardMessages.funcForwardMessages(ctx, sourceChatID, messageIDs, targetChatID)
+	}
+	mmForwardMessages.t.Fatalf("Unexpected call to ChatServiceMock.ForwardMessages. %v %v %v %v", ctx, sourceChatID, messageIDs, targetChatID)
+	return
+}
+
+// ForwardMessagesAfterCounter returns a count of finished ChatServiceMock.ForwardMessages invocations
+func (mmForwardMessages *ChatServiceMock) ForwardMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmForwardMessages.afterForwardMessagesCounter)
+}
+
+// ForwardMessagesBeforeCounter returns a count of ChatServiceMock.ForwardMessages invocations
+func (mmForwardMessages *ChatServiceMock) ForwardMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmForwardMessages.beforeForwardMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ForwardMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmForwardMessages *mChatServiceMockForwardMessages) Calls() []*ChatServiceMockForwardMessagesParams {
+	mmForwardMessages.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockForwardMessagesParams, len(mmForwardMessages.callArgs))
+	copy(argCopy, mmForwardMessages.callArgs)
+
+	mmForwardMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockForwardMessagesDone returns true if the count of the ForwardMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockForwardMessagesDone() bool {
+	if m.ForwardMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ForwardMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ForwardMessagesMock.invocationsDone()
+}
+
+// MinimockForwardMessagesInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockForwardMessagesInspect() {
+	for _, e := range m.ForwardMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ForwardMessages with params: %#v", *e.params)
+		}
+	}
+
+	afterForwardMessagesCounter := mm_atomic.LoadUint64(&m.afterForwardMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ForwardMessagesMock.defaultExpectation != nil && afterForwardMessagesCounter < 1 {
+		if m.ForwardMessagesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.ForwardMessages")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ForwardMessages with params: %#v", *m.ForwardMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcForwardMessages != nil && afterForwardMessagesCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.ForwardMessages")
+	}
+
+	if !m.ForwardMessagesMock.invocationsDone() && afterForwardMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ForwardMessages but found %d calls",
+			mm_atomic.LoadUint64(&m.ForwardMessagesMock.expectedInvocations), afterForwardMessagesCounter)
+	}
+}
+
+type mChatServiceMockGetChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockGetChatExpectation
+	expectations       []*ChatServiceMockGetChatExpectation
+
+	callArgs []*ChatServiceMockGetChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockGetChatExpectation specifies expectation struct of the ChatService.GetChat
+type ChatServiceMockGetChatExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockGetChatParams
+	paramPtrs *ChatServiceMockGetChatParamPtrs
+	results   *ChatServiceMockGetChatResults
+	Counter   uint64
+}
+
+// ChatServiceMockGetChatParams contains parameters of the ChatService.GetChat
+type ChatServiceMockGetChatParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ChatServiceMockGetChatParamPtrs contains pointers to parameters of the ChatService.GetChat
+type ChatServiceMockGetChatParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ChatServiceMockGetChatResults contains results of the ChatService.GetChat
+type ChatServiceMockGetChatResults struct {
+	cp1 *model.Chat
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetChat *mChatServiceMockGetChat) Optional() *mChatServiceMockGetChat {
+	mmGetChat.optional = true
+	return mmGetChat
+}
+
+// Expect sets up expected params for ChatService.GetChat
+func (mmGetChat *mChatServiceMockGetChat) Expect(ctx context.Context, id int64) *mChatServiceMockGetChat {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatServiceMockGetChatExpectation{}
+	}
+
+	if mmGetChat.defaultExpectation.paramPtrs != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by ExpectParams functions")
+	}
+
+	mmGetChat.defaultExpectation.params = &ChatServiceMockGetChatParams{ctx, id}
+	for _, e := range mmGetChat.expectations {
+		if minimock.Equal(e.params, mmGetChat.defaultExpectation.params) {
+			mmGetChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetChat.defaultExpectation.params)
+		}
+	}
+
+	return mmGetChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.GetChat
+func (mmGetChat *mChatServiceMockGetChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGetChat {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatServiceMockGetChatExpectation{}
+	}
+
+	if mmGetChat.defaultExpectation.params != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Expect")
+	}
+
+	if mmGetChat.defaultExpectation.paramPtrs == nil {
+		mmGetChat.defaultExpectation.paramPtrs = &ChatServiceMockGetChatParamPtrs{}
+	}
+	mmGetChat.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetChat
+}
+
+// ExpectIdParam2 sets up expected param id for ChatService.GetChat
+func (mmGetChat *mChatServiceMockGetChat) ExpectIdParam2(id int64) *mChatServiceMockGetChat {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatServiceMockGetChatExpectation{}
+	}
+
+	if mmGetChat.defaultExpectation.params != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Expect")
+	}
+
+	if mmGetChat.defaultExpectation.paramPtrs == nil {
+		mmGetChat.defaultExpectation.paramPtrs = &ChatServiceMockGetChatParamPtrs{}
+	}
+	mmGetChat.defaultExpectation.paramPtrs.id = &id
+
+	return mmGetChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.GetChat
+func (mmGetChat *mChatServiceMockGetChat) Inspect(f func(ctx context.Context, id int64)) *mChatServiceMockGetChat {
+	if mmGetChat.mock.inspectFuncGetChat != nil {
+		mmGetChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.GetChat")
+	}
+
+	mmGetChat.mock.inspectFuncGetChat = f
+
+	return mmGetChat
+}
+
+// Return sets up results that will be returned by ChatService.GetChat
+func (mmGetChat *mChatServiceMockGetChat) Return(cp1 *model.Chat, err error) *ChatServiceMock {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatServiceMockGetChatExpectation{mock: mmGetChat.mock}
+	}
+	mmGetChat.defaultExpectation.results = &ChatServiceMockGetChatResults{cp1, err}
+	return mmGetChat.mock
+}
+
+// Set uses given function f to mock the ChatService.GetChat method
+func (mmGetChat *mChatServiceMockGetChat) Set(f func(ctx context.Context, id int64) (cp1 *model.Chat, err error)) *ChatServiceMock {
+	if mmGetChat.defaultExpectation != nil {
+		mmGetChat.mock.t.Fatalf("Default expectation is already set for the ChatService.GetChat method")
+	}
+
+	if len(mmGetChat.expectations) > 0 {
+		mmGetChat.mock.t.Fatalf("Some expectations are already set for the ChatService.GetChat method")
+	}
+
+	mmGetChat.mock.funcGetChat = f
+	return mmGetChat.mock
+}
+
+// When sets expectation for the ChatService.GetChat which will trigger the result defined by the following
+// Then helper
+func (mmGetChat *mChatServiceMockGetChat) When(ctx context.Context, id int64) *ChatServiceMockGetChatExpectation {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockGetChatExpectation{
+		mock:   mmGetChat.mock,
+		params: &ChatServiceMockGetChatParams{ctx, id},
+	}
+	mmGetChat.expectations = append(mmGetChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.GetChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockGetChatExpectation) Then(cp1 *model.Chat, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockGetChatResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.GetChat should be invoked
+func (mmGetChat *mChatServiceMockGetChat) Times(n uint64) *mChatServiceMockGetChat {
+	if n == 0 {
+		mmGetChat.mock.t.Fatalf("Times of ChatServiceMock.GetChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetChat.expectedInvocations, n)
+	return mmGetChat
+}
+
+func (mmGetChat *mChatServiceMockGetChat) invocationsDone() bool {
+	if len(mmGetChat.expectations) == 0 && mmGetChat.defaultExpectation == nil && mmGetChat.mock.funcGetChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetChat.mock.afterGetChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetChat implements service.ChatService
+func (mmGetChat *ChatServiceMock) GetChat(ctx context.Context, id int64) (cp1 *model.Chat, err error) {
+	mm_atomic.AddUint64(&mmGetChat.beforeGetChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetChat.afterGetChatCounter, 1)
+
+	if mmGetChat.inspectFuncGetChat != nil {
+		mmGetChat.inspectFuncGetChat(ctx, id)
+	}
+
+	mm_params := ChatServiceMockGetChatParams{ctx, id}
+
+	// Record call args
+	mmGetChat.GetChatMock.mutex.Lock()
+	mmGetChat.GetChatMock.callArgs = append(mmGetChat.GetChatMock.callArgs, &mm_params)
+	mmGetChat.GetChatMock.mutex.Unlock()
+
+	for _, e := range mmGetChat.GetChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmGetChat.GetChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetChat.GetChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetChat.GetChatMock.defaultExpectation.params
+		mm_want_ptrs := mmGetChat.GetChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockGetChatParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetChat.t.Errorf("ChatServiceMock.GetChat got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetChat.t.Errorf("ChatServiceMock.GetChat got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetChat.t.Errorf("ChatServiceMock.GetChat got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetChat.GetChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetChat.t.Fatal("No results are set for the ChatServiceMock.GetChat")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmGetChat.funcGetChat != nil {
+		return mmGetChat.funcGetChat(ctx, id)
+	}
+	mmGetChat.t.Fatalf("Unexpected call to ChatServiceMock.GetChat. %v %v", ctx, id)
+	return
+}
+
+// GetChatAfterCounter returns a count of finished ChatServiceMock.GetChat invocations
+func (mmGetChat *ChatServiceMock) GetChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetChat.afterGetChatCounter)
+}
+
+// GetChatBeforeCounter returns a count of ChatServiceMock.GetChat invocations
+func (mmGetChat *ChatServiceMock) GetChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetChat.beforeGetChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.GetChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetChat *mChatServiceMockGetChat) Calls() []*ChatServiceMockGetChatParams {
+	mmGetChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockGetChatParams, len(mmGetChat.callArgs))
+	copy(argCopy, mmGetChat.callArgs)
+
+	mmGetChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetChatDone returns true if the count of the GetChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockGetChatDone() bool {
+	if m.GetChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetChatMock.invocationsDone()
+}
+
+// MinimockGetChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockGetChatInspect() {
+	for _, e := range m.GetChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.GetChat with params: %#v", *e.params)
+		}
+	}
+
+	afterGetChatCounter := mm_atomic.LoadUint64(&m.afterGetChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetChatMock.defaultExpectation != nil && afterGetChatCounter < 1 {
+		if m.GetChatMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.GetChat")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.GetChat with params: %#v", *m.GetChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetChat != nil && afterGetChatCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.GetChat")
+	}
+
+	if !m.GetChatMock.invocationsDone() && afterGetChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.GetChat but found %d calls",
+			mm_atomic.LoadUint64(&m.GetChatMock.expectedInvocations), afterGetChatCounter)
+	}
+}
+
+type mChatServiceMockGetMessageHistory struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockGetMessageHistoryExpectation
+	expectations       []*ChatServiceMockGetMessageHistoryExpectation
+
+	callArgs []*ChatServiceMockGetMessageHistoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockGetMessageHistoryExpectation specifies expectation struct of the ChatService.GetMessageHistory
+type ChatServiceMockGetMessageHistoryExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockGetMessageHistoryParams
+	paramPtrs *ChatServiceMockGetMessageHistoryParamPtrs
+	results   *ChatServiceMockGetMessageHistoryResults
+	Counter   uint64
+}
+
+// ChatServiceMockGetMessageHistoryParams contains parameters of the ChatService.GetMessageHistory
+type ChatServiceMockGetMessageHistoryParams struct {
+	ctx       context.Context
+	chatID    int64
+	messageID string
+}
+
+// ChatServiceMockGetMessageHistoryParamPtrs contains pointers to parameters of the ChatService.GetMessageHistory
+type ChatServiceMockGetMessageHistoryParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	messageID *string
+}
+
+// ChatServiceMockGetMessageHistoryResults contains results of the ChatService.GetMessageHistory
+type ChatServiceMockGetMessageHistoryResults struct {
+	mpa1 []*model.MessageRevision
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetMessageHistory *mChatServiceMockGetMessageHistory) Optional() *mChatServiceMockGetMessageHistory {
+	mmGetMessageHistory.optional = true
+	return mmGetMessageHistory
+}
+
+// Expect sets up expected params for ChatService.GetMessageHistory
+func (mmGetMessageHistory *mChatServiceMockGetMessageHistory) Expect(ctx context.Context, chatID int64, messageID string) *mChatServiceMockGetMessageHistory {
+	if mmGetMessageHistory.mock.funcGetMessageHistory != nil {
+		mmGetMessageHistory.mock.t.Fatalf("ChatServiceMock.GetMessageHistory mock is already set by Set")
+	}
+
+	if mmGetMessageHistory.defaultExpectation == nil {
+		mmGetMessageHistory.defaultExpectation = &ChatServiceMockGetMessageHistoryExpectation{}
+	}
+
+	if mmGetMessageHistory.defaultExpectation.paramPtrs != nil {
+		mmGetMessageHistory.mock.t.Fatalf("ChatServiceMock.GetMessageHistory mock is already set by ExpectParams functions")
+	}
+
+	mmGetMessageHistory.defaultExpectation.params = &ChatServiceMockGetMessageHistoryParams{ctx, chatID, messageID}
+	for _, e := range mmGetMessageHistory.expectations {
+		if minimock.Equal(e.params, mmGetMessageHistory.defaultExpectation.params) {
+			mmGetMessageHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetMessageHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmGetMessageHistory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.GetMessageHistory
+func (mmGetMessageHistory *mChatServiceMockGetMessageHistory) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGetMessageHistory {
+	if mmGetMessageHistory.mock.funcGetMessageHistory != nil {
+		mmGetMessageHistory.mock.t.Fatalf("ChatServiceMock.GetMessageHistory mock is already set by Set")
+	}
+
+	if mmGetMessageHistory.defaultExpectation == nil {
+		mmGetMessageHistory.defaultExpectation = &ChatServiceMockGetMessageHistoryExpectation{}
+	}
+
+	if mmGetMessageHistory.defaultExpectation.params != nil {
+		mmGetMessageHistory.mock.t.Fatalf("ChatServiceMock.GetMessageHistory mock is already set by Expect")
+	}
+
+	if mmGetMessageHistory.defaultExpectation.paramPtrs == nil {
+		mmGetMessageHistory.defaultExpectation.paramPtrs = &ChatServiceMockGetMessageHistoryParamPtrs{}
+	}
+	mmGetMessageHistory.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetMessageHistory
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.GetMessageHistory
+func (mmGetMessageHistory *mChatServiceMockGetMessageHistory) ExpectChatIDParam2(chatID int64) *mChatServiceMockGetMessageHistory {
+	if mmGetMessageHistory.mock.funcGetMessageHistory != nil {
+		mmGetMessageHistory.mock.t.Fatalf("ChatServiceMock.GetMessageHistory mock is already set by Set")
+	}
+
+	if mmGetMessageHistory.defaultExpectation == nil {
+		mmGetMessageHistory.defaultExpectation = &ChatServiceMockGetMessageHistoryExpectation{}
+	}
+
+	if mmGetMessageHistory.defaultExpectation.params != nil {
+		mmGetMessageHistory.mock.t.Fatalf("ChatServiceMock.GetMessageHistory mock is already set by Expect")
+	}
+
+	if mmGetMessageHistory.defaultExpectation.paramPtrs == nil {
+		mmGetMessageHistory.defaultExpectation.paramPtrs = &ChatServiceMockGetMessageHistoryParamPtrs{}
+	}
+	mmGetMessageHistory.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmGetMessageHistory
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatService.GetMessageHistory
+func (mmGetMessageHistory *mChatServiceMockGetMessageHistory) ExpectMessageIDParam3(messageID string) *mChatServiceMockGetMessageHistory {
+	if mmGetMessageHistory.mock.funcGetMessageHistory != nil {
+		mmGetMessageHistory.mock.t.Fatalf("ChatServiceMock.GetMessageHistory mock is already set by Set")
+	}
+
+	if mmGetMessageHistory.defaultExpectation == nil {
+		mmGetMessageHistory.defaultExpectation = &ChatServiceMockGetMessageHistoryExpectation{}
+	}
+
+	if mmGetMessageHistory.defaultExpectation.params != nil {
+		mmGetMessageHistory.mock.t.Fatalf("ChatServiceMock.GetMessageHistory mock is already set by Expect")
+	}
+
+	if mmGetMessageHistory.defaultExpectation.paramPtrs == nil {
+		mmGetMessageHistory.defaultExpectation.paramPtrs = &ChatServiceMockGetMessageHistoryParamPtrs{}
+	}
+	mmGetMessageHistory.defaultExpectation.paramPtrs.messageID = &messageID
+
+	return mmGetMessageHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.GetMessageHistory
+func (mmGetMessageHistory *mChatServiceMockGetMessageHistory) Inspect(f func(ctx context.Context, chatID int64, messageID string)) *mChatServiceMockGetMessageHistory {
+	if mmGetMessageHistory.mock.inspectFuncGetMessageHistory != nil {
+		mmGetMessageHistory.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.GetMessageHistory")
+	}
+
+	mmGetMessageHistory.mock.inspectFuncGetMessageHistory = f
+
+	return mmGetMessageHistory
+}
+
+// Return sets up results that will be returned by ChatService.GetMessageHistory
+func (mmGetMessageHistory *mChatServiceMockGetMessageHistory) Return(mpa1 []*model.MessageRevision, err error) *ChatServiceMock {
+	if mmGetMessageHistory.mock.funcGetMessageHistory != nil {
+		mmGetMessageHistory.mock.t.Fatalf("ChatServiceMock.GetMessageHistory mock is already set by Set")
+	}
+
+	if mmGetMessageHistory.defaultExpectation == nil {
+		mmGetMessageHistory.defaultExpectation = &ChatServiceMockGetMessageHistoryExpectation{mock: mmGetMessageHistory.mock}
+	}
+	mmGetMessageHistory.defaultExpectation.results = &ChatServiceMockGetMessageHistoryResults{mpa1, err}
+	return mmGetMessageHistory.mock
+}
+
+// Set uses given function f to mock the ChatService.GetMessageHistory method
+func (mmGetMessageHistory *mChatServiceMockGetMessageHistory) Set(f func(ctx context.Context, chatID int64, messageID string) (mpa1 []*model.MessageRevision, err error)) *ChatServiceMock {
+	if mmGetMessageHistory.defaultExpectation != nil {
+		mmGetMessageHistory.mock.t.Fatalf("Default expectation is already set for the ChatService.GetMessageHistory method")
+	}
+
+	if len(mmGetMessageHistory.expectations) > 0 {
+		mmGetMessageHistory.mock.t.Fatalf("Some expectations are already set for the ChatService.GetMessageHistory method")
+	}
+
+	mmGetMessageHistory.mock.funcGetMessageHistory = f
+	return mmGetMessageHistory.mock
+}
+
+// When sets expectation for the ChatService.GetMessageHistory which will trigger the result defined by the following
+// Then helper
+func (mmGetMessageHistory *mChatServiceMockGetMessageHistory) When(ctx context.Context, chatID int64, messageID string) *ChatServiceMockGetMessageHistoryExpectation {
+	if mmGetMessageHistory.mock.funcGetMessageHistory != nil {
+		mmGetMessageHistory.mock.t.Fatalf("ChatServiceMock.GetMessageHistory mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockGetMessageHistoryExpectation{
+		mock:   mmGetMessageHistory.mock,
+		params: &ChatServiceMockGetMessageHistoryParams{ctx, chatID, messageID},
+	}
+	mmGetMessageHistory.expectations = append(mmGetMessageHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.GetMessageHistory return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockGetMessageHistoryExpectation) Then(mpa1 []*model.MessageRevision, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockGetMessageHistoryResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.GetMessageHistory should be invoked
+func (mmGetMessageHistory *mChatServiceMockGetMessageHistory) Times(n uint64) *mChatServiceMockGetMessageHistory {
+	if n == 0 {
+		mmGetMessageHistory.mock.t.Fatalf("Times of ChatServiceMock.GetMessageHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetMessageHistory.expectedInvocations, n)
+	return mmGetMessageHistory
+}
+
+func (mmGetMessageHistory *mChatServiceMockGetMessageHistory) invocationsDone() bool {
+	if len(mmGetMessageHistory.expectations) == 0 && mmGetMessageHistory.defaultExpectation == nil && mmGetMessageHistory.mock.funcGetMessageHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetMessageHistory.mock.afterGetMessageHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetMessageHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetMessageHistory implements service.ChatService
+func (mmGetMessageHistory *ChatServiceMock) GetMessageHistory(ctx context.Context, chatID int64, messageID string) (mpa1 []*model.MessageRevision, err error) {
+	mm_atomic.AddUint64(&mmGetMessageHistory.beforeGetMessageHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetMessageHistory.afterGetMessageHistoryCounter, 1)
+
+	if mmGetMessageHistory.inspectFuncGetMessageHistory != nil {
+		mmGetMessageHistory.inspectFuncGetMessageHistory(ctx, chatID, messageID)
+	}
+
+	mm_params := ChatServiceMockGetMessageHistoryParams{ctx, chatID, messageID}
+
+	// Record call args
+	mmGetMessageHistory.GetMessageHistoryMock.mutex.Lock()
+	mmGetMessageHistory.GetMessageHistoryMock.callArgs = append(mmGetMessageHistory.GetMessageHistoryMock.callArgs, &mm_params)
+	mmGetMessageHistory.GetMessageHistoryMock.mutex.Unlock()
+
+	for _, e := range mmGetMessageHistory.GetMessageHistoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmGetMessageHistory.GetMessageHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetMessageHistory.GetMessageHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetMessageHistory.GetMessageHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmGetMessageHistory.GetMessageHistoryMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockGetMessageHistoryParams{ctx, chatID, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetMessageHistory.t.Errorf("ChatServiceMock.GetMessageHistory got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmGetMessageHistory.t.Errorf("ChatServiceMock.GetMessageHistory got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmGetMessageHistory.t.Errorf("ChatServiceMock.GetMessageHistory got unexpected parameter messageID, want: %#v, got: %#v%s\n", *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetMessageHistory.t.Errorf("ChatServiceMock.GetMessageHistory got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetMessageHistory.GetMessageHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetMessageHistory.t.Fatal("No results are set for the ChatServiceMock.GetMessageHistory")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmGetMessageHistory.funcGetMessageHistory != nil {
+		return mmGetMessageHistory.funcGetMessageHistory(ctx, chatID, messageID)
+	}
+	mmGetMessageHistory.t.Fatalf("Unexpected call to ChatServiceMock.GetMessageHistory. %v %v %v", ctx, chatID, messageID)
+	return
+}
+
+// GetMessageHistoryAfterCounter returns a count of finished ChatServiceMock.GetMessageHistory invocations
+func (mmGetMessageHistory *ChatServiceMock) GetMessageHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMessageHistory.afterGetMessageHistoryCounter)
+}
+
+// GetMessageHistoryBeforeCounter returns a count of ChatServiceMock.GetMessageHistory invocations
+func (mmGetMessageHistory *ChatServiceMock) GetMessageHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMessageHistory.beforeGetMessageHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.GetMessageHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetMessageHistory *mChatServiceMockGetMessageHistory) Calls() []*ChatServiceMockGetMessageHistoryParams {
+	mmGetMessageHistory.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockGetMessageHistoryParams, len(mmGetMessageHistory.callArgs))
+	copy(argCopy, mmGetMessageHistory.callArgs)
+
+	mmGetMessageHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetMessageHistoryDone returns true if the count of the GetMessageHistory invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockGetMessageHistoryDone() bool {
+	if m.GetMessageHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMessageHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMessageHistoryMock.invocationsDone()
+}
+
+// MinimockGetMessageHistoryInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockGetMessageHistoryInspect() {
+	for _, e := range m.GetMessageHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.GetMessageHistory with params: %#v", *e.params)
+		}
+	}
+
+	afterGetMessageHistoryCounter := mm_atomic.LoadUint64(&m.afterGetMessageHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMessageHistoryMock.defaultExpectation != nil && afterGetMessageHistoryCounter < 1 {
+		if m.GetMessageHistoryMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.GetMessageHistory")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.GetMessageHistory with params: %#v", *m.GetMessageHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetMessageHistory != nil && afterGetMessageHistoryCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.GetMessageHistory")
+	}
+
+	if !m.GetMessageHistoryMock.invocationsDone() && afterGetMessageHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.GetMessageHistory but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMessageHistoryMock.expectedInvocations), afterGetMessageHistoryCounter)
+	}
+}
+
+type mChatServiceMockGetPresence struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockGetPresenceExpectation
+	expectations       []*ChatServiceMockGetPresenceExpectation
+
+	callArgs []*ChatServiceMockGetPresenceParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockGetPresenceExpectation specifies expectation struct of the ChatService.GetPresence
+type ChatServiceMockGetPresenceExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockGetPresenceParams
+	paramPtrs *ChatServiceMockGetPresenceParamPtrs
+	results   *ChatServiceMockGetPresenceResults
+	Counter   uint64
+}
+
+// ChatServiceMockGetPresenceParams contains parameters of the ChatService.GetPresence
+type ChatServiceMockGetPresenceParams struct {
+	ctx     context.Context
+	userIDs []int64
+}
+
+// ChatServiceMockGetPresenceParamPtrs contains pointers to parameters of the ChatService.GetPresence
+type ChatServiceMockGetPresenceParamPtrs struct {
+	ctx     *context.Context
+	userIDs *[]int64
+}
+
+// ChatServiceMockGetPresenceResults contains results of the ChatService.GetPresence
+type ChatServiceMockGetPresenceResults struct {
+	ppa1 []*model.Presence
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPresence *mChatServiceMockGetPresence) Optional() *mChatServiceMockGetPresence {
+	mmGetPresence.optional = true
+	return mmGetPresence
+}
+
+// Expect sets up expected params for ChatService.GetPresence
+func (mmGetPresence *mChatServiceMockGetPresence) Expect(ctx context.Context, userIDs []int64) *mChatServiceMockGetPresence {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Set")
+	}
+
+	if mmGetPresence.defaultExpectation == nil {
+		mmGetPresence.defaultExpectation = &ChatServiceMockGetPresenceExpectation{}
+	}
+
+	if mmGetPresence.defaultExpectation.paramPtrs != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by ExpectParams functions")
+	}
+
+	mmGetPresence.defaultExpectation.params = &ChatServiceMockGetPresenceParams{ctx, userIDs}
+	for _, e := range mmGetPresence.expectations {
+		if minimock.Equal(e.params, mmGetPresence.defaultExpectation.params) {
+			mmGetPresence.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPresence.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPresence
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.GetPresence
+func (mmGetPresence *mChatServiceMockGetPresence) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGetPresence {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Set")
+	}
+
+	if mmGetPresence.defaultExpectation == nil {
+		mmGetPresence.defaultExpectation = &ChatServiceMockGetPresenceExpectation{}
+	}
+
+	if mmGetPresence.defaultExpectation.params != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Expect")
+	}
+
+	if mmGetPresence.defaultExpectation.paramPtrs == nil {
+		mmGetPresence.defaultExpectation.paramPtrs = &ChatServiceMockGetPresenceParamPtrs{}
+	}
+	mmGetPresence.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetPresence
+}
+
+// ExpectUserIDsParam2 sets up expected param userIDs for ChatService.GetPresence
+func (mmGetPresence *mChatServiceMockGetPresence) ExpectUserIDsParam2(userIDs []int64) *mChatServiceMockGetPresence {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Set")
+	}
+
+	if mmGetPresence.defaultExpectation == nil {
+		mmGetPresence.defaultExpectation = &ChatServiceMockGetPresenceExpectation{}
+	}
+
+	if mmGetPresence.defaultExpectation.params != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Expect")
+	}
+
+	if mmGetPresence.defaultExpectation.paramPtrs == nil {
+		mmGetPresence.defaultExpectation.paramPtrs = &ChatServiceMockGetPresenceParamPtrs{}
+	}
+	mmGetPresence.defaultExpectation.paramPtrs.userIDs = &userIDs
+
+	return mmGetPresence
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.GetPresence
+func (mmGetPresence *mChatServiceMockGetPresence) Inspect(f func(ctx context.Context, userIDs []int64)) *mChatServiceMockGetPresence {
+	if mmGetPresence.mock.inspectFuncGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.GetPresence")
+	}
+
+	mmGetPresence.mock.inspectFuncGetPresence = f
+
+	return mmGetPresence
+}
+
+// Return sets up results that will be returned by ChatService.GetPresence
+func (mmGetPresence *mChatServiceMockGetPresence) Return(ppa1 []*model.Presence, err error) *ChatServiceMock {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Set")
+	}
+
+	if mmGetPresence.defaultExpectation == nil {
+		mmGetPresence.defaultExpectation = &ChatServiceMockGetPresenceExpectation{mock: mmGetPresence.mock}
+	}
+	mmGetPresence.defaultExpectation.results = &ChatServiceMockGetPresenceResults{ppa1, err}
+	return mmGetPresence.mock
+}
+
+// Set uses given function f to mock the ChatService.GetPresence method
+func (mmGetPresence *mChatServiceMockGetPresence) Set(f func(ctx context.Context, userIDs []int64) (ppa1 []*model.Presence, err error)) *ChatServiceMock {
+	if mmGetPresence.defaultExpectation != nil {
+		mmGetPresence.mock.t.Fatalf("Default expectation is already set for the ChatService.GetPresence method")
+	}
+
+	if len(mmGetPresence.expectations) > 0 {
+		mmGetPresence.mock.t.Fatalf("Some expectations are already set for the ChatService.GetPresence method")
+	}
+
+	mmGetPresence.mock.funcGetPresence = f
+	return mmGetPresence.mock
+}
+
+// When sets expectation for the ChatService.GetPresence which will trigger the result defined by the following
+// Then helper
+func (mmGetPresence *mChatServiceMockGetPresence) When(ctx context.Context, userIDs []int64) *ChatServiceMockGetPresenceExpectation {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockGetPresenceExpectation{
+		mock:   mmGetPresence.mock,
+		params: &ChatServiceMockGetPresenceParams{ctx, userIDs},
+	}
+	mmGetPresence.expectations = append(mmGetPresence.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.GetPresence return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockGetPresenceExpectation) Then(ppa1 []*model.Presence, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockGetPresenceResults{ppa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.GetPresence should be invoked
+func (mmGetPresence *mChatServiceMockGetPresence) Times(n uint64) *mChatServiceMockGetPresence {
+	if n == 0 {
+		mmGetPresence.mock.t.Fatalf("Times of ChatServiceMock.GetPresence mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPresence.expectedInvocations, n)
+	return mmGetPresence
+}
+
+func (mmGetPresence *mChatServiceMockGetPresence) invocationsDone() bool {
+	if len(mmGetPresence.expectations) == 0 && mmGetPresence.defaultExpectation == nil && mmGetPresence.mock.funcGetPresence == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPresence.mock.afterGetPresenceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPresence.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPresence implements service.ChatService
+func (mmGetPresence *ChatServiceMock) GetPresence(ctx context.Context, userIDs []int64) (ppa1 []*model.Presence, err error) {
+	mm_atomic.AddUint64(&mmGetPresence.beforeGetPresenceCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPresence.afterGetPresenceCounter, 1)
+
+	if mmGetPresence.inspectFuncGetPresence != nil {
+		mmGetPresence.inspectFuncGetPresence(ctx, userIDs)
+	}
+
+	mm_params := ChatServiceMockGetPresenceParams{ctx, userIDs}
+
+	// Record call args
+	mmGetPresence.GetPresenceMock.mutex.Lock()
+	mmGetPresence.GetPresenceMock.callArgs = append(mmGetPresence.GetPresenceMock.callArgs, &mm_params)
+	mmGetPresence.GetPresenceMock.mutex.Unlock()
+
+	for _, e := range mmGetPresence.GetPresenceMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmGetPresence.GetPresenceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPresence.GetPresenceMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPresence.GetPresenceMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPresence.GetPresenceMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockGetPresenceParams{ctx, userIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPresence.t.Errorf("ChatServiceMock.GetPresence got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userIDs != nil && !minimock.Equal(*mm_want_ptrs.userIDs, mm_got.userIDs) {
+				mmGetPresence.t.Errorf("ChatServiceMock.GetPresence got unexpected parameter userIDs, want: %#v, got: %#v%s\n", *mm_want_ptrs.userIDs, mm_got.userIDs, minimock.Diff(*mm_want_ptrs.userIDs, mm_got.userIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPresence.t.Errorf("ChatServiceMock.GetPresence got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPresence.GetPresenceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPresence.t.Fatal("No results are set for the ChatServiceMock.GetPresence")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmGetPresence.funcGetPresence != nil {
+		return mmGetPresence.funcGetPresence(ctx, userIDs)
+	}
+	mmGetPresence.t.Fatalf("Unexpected call to ChatServiceMock.GetPresence. %v %v", ctx, userIDs)
+	return
+}
+
+// GetPresenceAfterCounter returns a count of finished ChatServiceMock.GetPresence invocations
+func (mmGetPresence *ChatServiceMock) GetPresenceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPresence.afterGetPresenceCounter)
+}
+
+// GetPresenceBeforeCounter returns a count of ChatServiceMock.GetPresence invocations
+func (mmGetPresence *ChatServiceMock) GetPresenceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPresence.beforeGetPresenceCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.GetPresence.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPresence *mChatServiceMockGetPresence) Calls() []*ChatServiceMockGetPresenceParams {
+	mmGetPresence.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockGetPresenceParams, len(mmGetPresence.callArgs))
+	copy(argCopy, mmGetPresence.callArgs)
+
+	mmGetPresence.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPresenceDone returns true if the count of the GetPresence invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockGetPresenceDone() bool {
+	if m.GetPresenceMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPresenceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPresenceMock.invocationsDone()
+}
+
+// MinimockGetPresenceInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockGetPresenceInspect() {
+	for _, e := range m.GetPresenceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.GetPresence with params: %#v", *e.params)
+		}
+	}
+
+	afterGetPresenceCounter := mm_atomic.LoadUint64(&m.afterGetPresenceCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPresenceMock.defaultExpectation != nil && afterGetPresenceCounter < 1 {
+		if m.GetPresenceMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.GetPresence")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.GetPresence with params: %#v", *m.GetPresenceMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPresence != nil && afterGetPresenceCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.GetPresence")
+	}
+
+	if !m.GetPresenceMock.invocationsDone() && afterGetPresenceCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.GetPresence but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPresenceMock.expectedInvocations), afterGetPresenceCounter)
+	}
+}
+
+type mChatServiceMockGetReadState struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockGetReadStateExpectation
+	expectations       []*ChatServiceMockGetReadStateExpectation
+
+	callArgs []*ChatServiceMockGetReadStateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockGetReadStateExpectation specifies expectation struct of the ChatService.GetReadState
+type ChatServiceMockGetReadStateExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockGetReadStateParams
+	paramPtrs *ChatServiceMockGetReadStateParamPtrs
+	results   *ChatServiceMockGetReadStateResults
+	Counter   uint64
+}
+
+// ChatServiceMockGetReadStateParams contains parameters of the ChatService.GetReadState
+type ChatServiceMockGetReadStateParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// ChatServiceMockGetReadStateParamPtrs contains pointers to parameters of the ChatService.GetReadState
+type ChatServiceMockGetReadStateParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// ChatServiceMockGetReadStateResults contains results of the ChatService.GetReadState
+type ChatServiceMockGetReadStateResults struct {
+	rpa1 []*model.ReadState
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetReadState *mChatServiceMockGetReadState) Optional() *mChatServiceMockGetReadState {
+	mmGetReadState.optional = true
+	return mmGetReadState
+}
+
+// Expect sets up expected params for ChatService.GetReadState
+func (mmGetReadState *mChatServiceMockGetReadState) Expect(ctx context.Context, chatID int64) *mChatServiceMockGetReadState {
+	if mmGetReadState.mock.funcGetReadState != nil {
+		mmGetReadState.mock.t.Fatalf("ChatServiceMock.GetReadState mock is already set by Set")
+	}
+
+	if mmGetReadState.defaultExpectation == nil {
+		mmGetReadState.defaultExpectation = &ChatServiceMockGetReadStateExpectation{}
+	}
+
+	if mmGetReadState.defaultExpectation.paramPtrs != nil {
+		mmGetReadState.mock.t.Fatalf("ChatServiceMock.GetReadState mock is already set by ExpectParams functions")
+	}
+
+	mmGetReadState.defaultExpectation.params = &ChatServiceMockGetReadStateParams{ctx, chatID}
+	for _, e := range mmGetReadState.expectations {
+		if minimock.Equal(e.params, mmGetReadState.defaultExpectation.params) {
+			mmGetReadState.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReadState.defaultExpectation.params)
+		}
+	}
+
+	return mmGetReadState
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.GetReadState
+func (mmGetReadState *mChatServiceMockGetReadState) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGetReadState {
+	if mmGetReadState.mock.funcGetReadState != nil {
+		mmGetReadState.mock.t.Fatalf("ChatServiceMock.GetReadState mock is already set by Set")
+	}
+
+	if mmGetReadState.defaultExpectation == nil {
+		mmGetReadState.defaultExpectation = &ChatServiceMockGetReadStateExpectation{}
+	}
+
+	if mmGetReadState.defaultExpectation.params != nil {
+		mmGetReadState.mock.t.Fatalf("ChatServiceMock.GetReadState mock is already set by Expect")
+	}
+
+	if mmGetReadState.defaultExpectation.paramPtrs == nil {
+		mmGetReadState.defaultExpectation.paramPtrs = &ChatServiceMockGetReadStateParamPtrs{}
+	}
+	mmGetReadState.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetReadState
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.GetReadState
+func (mmGetReadState *mChatServiceMockGetReadState) ExpectChatIDParam2(chatID int64) *mChatServiceMockGetReadState {
+	if mmGetReadState.mock.funcGetReadState != nil {
+		mmGetReadState.mock.t.Fatalf("ChatServiceMock.GetReadState mock is already set by Set")
+	}
+
+	if mmGetReadState.defaultExpectation == nil {
+		mmGetReadState.defaultExpectation = &ChatServiceMockGetReadStateExpectation{}
+	}
+
+	if mmGetReadState.defaultExpectation.params != nil {
+		mmGetReadState.mock.t.Fatalf("ChatServiceMock.GetReadState mock is already set by Expect")
+	}
+
+	if mmGetReadState.defaultExpectation.paramPtrs == nil {
+		mmGetReadState.defaultExpectation.paramPtrs = &ChatServiceMockGetReadStateParamPtrs{}
+	}
+	mmGetReadState.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmGetReadState
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.GetReadState
+func (mmGetReadState *mChatServiceMockGetReadState) Inspect(f func(ctx context.Context, chatID int64)) *mChatServiceMockGetReadState {
+	if mmGetReadState.mock.inspectFuncGetReadState != nil {
+		mmGetReadState.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.GetReadState")
+	}
+
+	mmGetReadState.mock.inspectFuncGetReadState = f
+
+	return mmGetReadState
+}
+
+// Return sets up results that will be returned by ChatService.GetReadState
+func (mmGetReadState *mChatServiceMockGetReadState) Return(rpa1 []*model.ReadState, err error) *ChatServiceMock {
+	if mmGetReadState.mock.funcGetReadState != nil {
+		mmGetReadState.mock.t.Fatalf("ChatServiceMock.GetReadState mock is already set by Set")
+	}
+
+	if mmGetReadState.defaultExpectation == nil {
+		mmGetReadState.defaultExpectation = &ChatServiceMockGetReadStateExpectation{mock: mmGetReadState.mock}
+	}
+	mmGetReadState.defaultExpectation.results = &ChatServiceMockGetReadStateResults{rpa1, err}
+	return mmGetReadState.mock
+}
+
+// Set uses given function f to mock the ChatService.GetReadState method
+func (mmGetReadState *mChatServiceMockGetReadState) Set(f func(ctx context.Context, chatID int64) (rpa1 []*model.ReadState, err error)) *ChatServiceMock {
+	if mmGetReadState.defaultExpectation != nil {
+		mmGetReadState.mock.t.Fatalf("Default expectation is already set for the ChatService.GetReadState method")
+	}
+
+	if len(mmGetReadState.expectations) > 0 {
+		mmGetReadState.mock.t.Fatalf("Some expectations are already set for the ChatService.GetReadState method")
+	}
+
+	mmGetReadState.mock.funcGetReadState = f
+	return mmGetReadState.mock
+}
+
+// When sets expectation for the ChatService.GetReadState which will trigger the result defined by the following
+// Then helper
+func (mmGetReadState *mChatServiceMockGetReadState) When(ctx context.Context, chatID int64) *ChatServiceMockGetReadStateExpectation {
+	if mmGetReadState.mock.funcGetReadState != nil {
+		mmGetReadState.mock.t.Fatalf("ChatServiceMock.GetReadState mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockGetReadStateExpectation{
+		mock:   mmGetReadState.mock,
+		params: &ChatServiceMockGetReadStateParams{ctx, chatID},
+	}
+	mmGetReadState.expectations = append(mmGetReadState.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.GetReadState return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockGetReadStateExpectation) Then(rpa1 []*model.ReadState, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockGetReadStateResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.GetReadState should be invoked
+func (mmGetReadState *mChatServiceMockGetReadState) Times(n uint64) *mChatServiceMockGetReadState {
+	if n == 0 {
+		mmGetReadState.mock.t.Fatalf("Times of ChatServiceMock.GetReadState mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetReadState.expectedInvocations, n)
+	return mmGetReadState
+}
+
+func (mmGetReadState *mChatServiceMockGetReadState) invocationsDone() bool {
+	if len(mmGetReadState.expectations) == 0 && mmGetReadState.defaultExpectation == nil && mmGetReadState.mock.funcGetReadState == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetReadState.mock.afterGetReadStateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetReadState.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetReadState implements service.ChatService
+func (mmGetReadState *ChatServiceMock) GetReadState(ctx context.Context, chatID int64) (rpa1 []*model.ReadState, err error) {
+	mm_atomic.AddUint64(&mmGetReadState.beforeGetReadStateCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReadState.afterGetReadStateCounter, 1)
+
+	if mmGetReadState.inspectFuncGetReadState != nil {
+		mmGetReadState.inspectFuncGetReadState(ctx, chatID)
+	}
+
+	mm_params := ChatServiceMockGetReadStateParams{ctx, chatID}
+
+	// Record call args
+	mmGetReadState.GetReadStateMock.mutex.Lock()
+	mmGetReadState.GetReadStateMock.callArgs = append(mmGetReadState.GetReadStateMock.callArgs, &mm_params)
+	mmGetReadState.GetReadStateMock.mutex.Unlock()
+
+	for _, e := range mmGetReadState.GetReadStateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmGetReadState.GetReadStateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReadState.GetReadStateMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetReadState.GetReadStateMock.defaultExpectation.params
+		mm_want_ptrs := mmGetReadState.GetReadStateMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockGetReadStateParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetReadState.t.Errorf("ChatServiceMock.GetReadState got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmGetReadState.t.Errorf("ChatServiceMock.GetReadState got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetReadState.t.Errorf("ChatServiceMock.GetReadState got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetReadState.GetReadStateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReadState.t.Fatal("No results are set for the ChatServiceMock.GetReadState")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmGetReadState.funcGetReadState != nil {
+		return mmGetReadState.funcGetReadState(ctx, chatID)
+	}
+	mmGetReadState.t.Fatalf("Unexpected call to ChatServiceMock.GetReadState. %v %v", ctx, chatID)
+	return
+}
+
+// GetReadStateAfterCounter returns a count of finished ChatServiceMock.GetReadState invocations
+func (mmGetReadState *ChatServiceMock) GetReadStateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReadState.afterGetReadStateCounter)
+}
+
+// GetReadStateBeforeCounter returns a count of ChatServiceMock.GetReadState invocations
+func (mmGetReadState *ChatServiceMock) GetReadStateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReadState.beforeGetReadStateCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.GetReadState.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetReadState *mChatServiceMockGetReadState) Calls() []*ChatServiceMockGetReadStateParams {
+	mmGetReadState.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockGetReadStateParams, len(mmGetReadState.callArgs))
+	copy(argCopy, mmGetReadState.callArgs)
+
+	mmGetReadState.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetReadStateDone returns true if the count of the GetReadState invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockGetReadStateDone() bool {
+	if m.GetReadStateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetReadStateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetReadStateMock.invocationsDone()
+}
+
+// MinimockGetReadStateInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockGetReadStateInspect() {
+	for _, e := range m.GetReadStateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.GetReadState with params: %#v", *e.params)
+		}
+	}
+
+	afterGetReadStateCounter := mm_atomic.LoadUint64(&m.afterGetReadStateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReadStateMock.defaultExpectation != nil && afterGetReadStateCounter < 1 {
+		if m.GetReadStateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.GetReadState")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.GetReadState with params: %#v", *m.GetReadStateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReadState != nil && afterGetReadStateCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.GetReadState")
+	}
+
+	if !m.GetReadStateMock.invocationsDone() && afterGetReadStateCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.GetReadState but found %d calls",
+			mm_atomic.LoadUint64(&m.GetReadStateMock.expectedInvocations), afterGetReadStateCounter)
+	}
+}
+
+type mChatServiceMockHeartbeat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockHeartbeatExpectation
+	expectations       []*ChatServiceMockHeartbeatExpectation
+
+	callArgs []*ChatServiceMockHeartbeatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockHeartbeatExpectation specifies expectation struct of the ChatService.Heartbeat
+type ChatServiceMockHeartbeatExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockHeartbeatParams
+	paramPtrs *ChatServiceMockHeartbeatParamPtrs
+	results   *ChatServiceMockHeartbeatResults
+	Counter   uint64
+}
+
+// ChatServiceMockHeartbeatParams contains parameters of the ChatService.Heartbeat
+type ChatServiceMockHeartbeatParams struct {
+	ctx context.Context
+}
+
+// ChatServiceMockHeartbeatParamPtrs contains pointers to parameters of the ChatService.Heartbeat
+type ChatServiceMockHeartbeatParamPtrs struct {
+	ctx *context.Context
+}
+
+// ChatServiceMockHeartbeatResults contains results of the ChatService.Heartbeat
+type ChatServiceMockHeartbeatResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmHeartbeat *mChatServiceMockHeartbeat) Optional() *mChatServiceMockHeartbeat {
+	mmHeartbeat.optional = true
+	return mmHeartbeat
+}
+
+// Expect sets up expected params for ChatService.Heartbeat
+func (mmHeartbeat *mChatServiceMockHeartbeat) Expect(ctx context.Context) *mChatServiceMockHeartbeat {
+	if mmHeartbeat.mock.funcHeartbeat != nil {
+		mmHeartbeat.mock.t.Fatalf("ChatServiceMock.Heartbeat mock is already set by Set")
+	}
+
+	if mmHeartbeat.defaultExpectation == nil {
+		mmHeartbeat.defaultExpectation = &ChatServiceMockHeartbeatExpectation{}
+	}
+
+	if mmHeartbeat.defaultExpectation.paramPtrs != nil {
+		mmHeartbeat.mock.t.Fatalf("ChatServiceMock.Heartbeat mock is already set by ExpectParams functions")
+	}
+
+	mmHeartbeat.defaultExpectation.params = &ChatServiceMockHeartbeatParams{ctx}
+	for _, e := range mmHeartbeat.expectations {
+		if minimock.Equal(e.params, mmHeartbeat.defaultExpectation.params) {
+			mmHeartbeat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHeartbeat.defaultExpectation.params)
+		}
+	}
+
+	return mmHeartbeat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.Heartbeat
+func (mmHeartbeat *mChatServiceMockHeartbeat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockHeartbeat {
+	if mmHeartbeat.mock.funcHeartbeat != nil {
+		mmHeartbeat.mock.t.Fatalf("ChatServiceMock.Heartbeat mock is already set by Set")
+	}
+
+	if mmHeartbeat.defaultExpectation == nil {
+		mmHeartbeat.defaultExpectation = &ChatServiceMockHeartbeatExpectation{}
+	}
+
+	if mmHeartbeat.defaultExpectation.params != nil {
+		mmHeartbeat.mock.t.Fatalf("ChatServiceMock.Heartbeat mock is already set by Expect")
+	}
+
+	if mmHeartbeat.defaultExpectation.paramPtrs == nil {
+		mmHeartbeat.defaultExpectation.paramPtrs = &ChatServiceMockHeartbeatParamPtrs{}
+	}
+	mmHeartbeat.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmHeartbeat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.Heartbeat
+func (mmHeartbeat *mChatServiceMockHeartbeat) Inspect(f func(ctx context.Context)) *mChatServiceMockHeartbeat {
+	if mmHeartbeat.mock.inspectFuncHeartbeat != nil {
+		mmHeartbeat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.Heartbeat")
+	}
+
+	mmHeartbeat.mock.inspectFuncHeartbeat = f
+
+	return mmHeartbeat
+}
+
+// Return sets up results that will be returned by ChatService.Heartbeat
+func (mmHeartbeat *mChatServiceMockHeartbeat) Return(err error) *ChatServiceMock {
+	if mmHeartbeat.mock.funcHeartbeat != nil {
+		mmHeartbeat.mock.t.Fatalf("ChatServiceMock.Heartbeat mock is already set by Set")
+	}
+
+	if mmHeartbeat.defaultExpectation == nil {
+		mmHeartbeat.defaultExpectation = &ChatServiceMockHeartbeatExpectation{mock: mmHeartbeat.mock}
+	}
+	mmHeartbeat.defaultExpectation.results = &ChatServiceMockHeartbeatResults{err}
+	return mmHeartbeat.mock
+}
+
+// Set uses given function f to mock the ChatService.Heartbeat method
+func (mmHeartbeat *mChatServiceMockHeartbeat) Set(f func(ctx context.Context) (err error)) *ChatServiceMock {
+	if mmHeartbeat.defaultExpectation != nil {
+		mmHeartbeat.mock.t.Fatalf("Default expectation is already set for the ChatService.Heartbeat method")
+	}
+
+	if len(mmHeartbeat.expectations) > 0 {
+		mmHeartbeat.mock.t.Fatalf("Some expectations are already set for the ChatService.Heartbeat method")
+	}
+
+	mmHeartbeat.mock.funcHeartbeat = f
+	return mmHeartbeat.mock
+}
+
+// When sets expectation for the ChatService.Heartbeat which will trigger the result defined by the following
+// Then helper
+func (mmHeartbeat *mChatServiceMockHeartbeat) When(ctx context.Context) *ChatServiceMockHeartbeatExpectation {
+	if mmHeartbeat.mock.funcHeartbeat != nil {
+		mmHeartbeat.mock.t.Fatalf("ChatServiceMock.Heartbeat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockHeartbeatExpectation{
+		mock:   mmHeartbeat.mock,
+		params: &ChatServiceMockHeartbeatParams{ctx},
+	}
+	mmHeartbeat.expectations = append(mmHeartbeat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.Heartbeat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockHeartbeatExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockHeartbeatResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.Heartbeat should be invoked
+func (mmHeartbeat *mChatServiceMockHeartbeat) Times(n uint64) *mChatServiceMockHeartbeat {
+	if n == 0 {
+		mmHeartbeat.mock.t.Fatalf("Times of ChatServiceMock.Heartbeat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmHeartbeat.expectedInvocations, n)
+	return mmHeartbeat
+}
+
+func (mmHeartbeat *mChatServiceMockHeartbeat) invocationsDone() bool {
+	if len(mmHeartbeat.expectations) == 0 && mmHeartbeat.defaultExpectation == nil && mmHeartbeat.mock.funcHeartbeat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmHeartbeat.mock.afterHeartbeatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHeartbeat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Heartbeat implements service.ChatService
+func (mmHeartbeat *ChatServiceMock) Heartbeat(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmHeartbeat.beforeHeartbeatCounter, 1)
+	defer mm_atomic.AddUint64(&mmHeartbeat.afterHeartbeatCounter, 1)
+
+	if mmHeartbeat.inspectFuncHeartbeat != nil {
+		mmHeartbeat.inspectFuncHeartbeat(ctx)
+	}
+
+	mm_params := ChatServiceMockHeartbeatParams{ctx}
+
+	// Record call args
+	mmHeartbeat.HeartbeatMock.mutex.Lock()
+	mmHeartbeat.HeartbeatMock.callArgs = append(mmHeartbeat.HeartbeatMock.callArgs, &mm_params)
+	mmHeartbeat.HeartbeatMock.mutex.Unlock()
+
+	for _, e := range mmHeartbeat.HeartbeatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmHeartbeat.HeartbeatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHeartbeat.HeartbeatMock.defaultExpectation.Counter, 1)
+		mm_want := mmHeartbeat.HeartbeatMock.defaultExpectation.params
+		mm_want_ptrs := mmHeartbeat.HeartbeatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockHeartbeatParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmHeartbeat.t.Errorf("ChatServiceMock.Heartbeat got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHeartbeat.t.Errorf("ChatServiceMock.Heartbeat got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHeartbeat.HeartbeatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHeartbeat.t.Fatal("No results are set for the ChatServiceMock.Heartbeat")
+		}
+		return (*mm_results).err
+	}
+	if mmHeartbeat.funcHeartbeat != nil {
+		return mmHeartbeat.funcHeartbeat(ctx)
+	}
+	mmHeartbeat.t.Fatalf("Unexpected call to ChatServiceMock.Heartbeat. %v", ctx)
+	return
+}
+
+// HeartbeatAfterCounter returns a count of finished ChatServiceMock.Heartbeat invocations
+func (mmHeartbeat *ChatServiceMock) HeartbeatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHeartbeat.afterHeartbeatCounter)
+}
+
+// HeartbeatBeforeCounter returns a count of ChatServiceMock.Heartbeat invocations
+func (mmHeartbeat *ChatServiceMock) HeartbeatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHeartbeat.beforeHeartbeatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.Heartbeat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHeartbeat *mChatServiceMockHeartbeat) Calls() []*ChatServiceMockHeartbeatParams {
+	mmHeartbeat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockHeartbeatParams, len(mmHeartbeat.callArgs))
+	copy(argCopy, mmHeartbeat.callArgs)
+
+	mmHeartbeat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHeartbeatDone returns true if the count of the Heartbeat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockHeartbeatDone() bool {
+	if m.HeartbeatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.HeartbeatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.HeartbeatMock.invocationsDone()
+}
+
+// MinimockHeartbeatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockHeartbeatInspect() {
+	for _, e := range m.HeartbeatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.Heartbeat with params: %#v", *e.params)
+		}
+	}
+
+	afterHeartbeatCounter := mm_atomic.LoadUint64(&m.afterHeartbeatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HeartbeatMock.defaultExpectation != nil && afterHeartbeatCounter < 1 {
+		if m.HeartbeatMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.Heartbeat")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.Heartbeat with params: %#v", *m.HeartbeatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHeartbeat != nil && afterHeartbeatCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.Heartbeat")
+	}
+
+	if !m.HeartbeatMock.invocationsDone() && afterHeartbeatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.Heartbeat but found %d calls",
+			mm_atomic.LoadUint64(&m.HeartbeatMock.expectedInvocations), afterHeartbeatCounter)
+	}
+}
+
+type mChatServiceMockJoinByInvite struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockJoinByInviteExpectation
+	expectations       []*ChatServiceMockJoinByInviteExpectation
+
+	callArgs []*ChatServiceMockJoinByInviteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockJoinByInviteExpectation specifies expectation struct of the ChatService.JoinByInvite
+type ChatServiceMockJoinByInviteExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockJoinByInviteParams
+	paramPtrs *ChatServiceMockJoinByInviteParamPtrs
+	results   *ChatServiceMockJoinByInviteResults
+	Counter   uint64
+}
+
+// ChatServiceMockJoinByInviteParams contains parameters of the ChatService.JoinByInvite
+type ChatServiceMockJoinByInviteParams struct {
+	ctx   context.Context
+	token string
+}
+
+// ChatServiceMockJoinByInviteParamPtrs contains pointers to parameters of the ChatService.JoinByInvite
+type ChatServiceMockJoinByInviteParamPtrs struct {
+	ctx   *context.Context
+	token *string
+}
+
+// ChatServiceMockJoinByInviteResults contains results of the ChatService.JoinByInvite
+type ChatServiceMockJoinByInviteResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmJoinByInvite *mChatServiceMockJoinByInvite) Optional() *mChatServiceMockJoinByInvite {
+	mmJoinByInvite.optional = true
+	return mmJoinByInvite
+}
+
+// Expect sets up expected params for ChatService.JoinByInvite
+func (mmJoinByInvite *mChatServiceMockJoinByInvite) Expect(ctx context.Context, token string) *mChatServiceMockJoinByInvite {
+	if mmJoinByInvite.mock.funcJoinByInvite != nil {
+		mmJoinByInvite.mock.t.Fatalf("ChatServiceMock.JoinByInvite mock is already set by Set")
+	}
+
+	if mmJoinByInvite.defaultExpectation == nil {
+		mmJoinByInvite.defaultExpectation = &ChatServiceMockJoinByInviteExpectation{}
+	}
+
+	if mmJoinByInvite.defaultExpectation.paramPtrs != nil {
+		mmJoinByInvite.mock.t.Fatalf("ChatServiceMock.JoinByInvite mock is already set by ExpectParams functions")
+	}
+
+	mmJoinByInvite.defaultExpectation.params = &ChatServiceMockJoinByInviteParams{ctx, token}
+	for _, e := range mmJoinByInvite.expectations {
+		if minimock.Equal(e.params, mmJoinByInvite.defaultExpectation.params) {
+			mmJoinByInvite.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmJoinByInvite.defaultExpectation.params)
+		}
+	}
+
+	return mmJoinByInvite
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.JoinByInvite
+func (mmJoinByInvite *mChatServiceMockJoinByInvite) ExpectCtxParam1(ctx context.Context) *mChatServiceMockJoinByInvite {
+	if mmJoinByInvite.mock.funcJoinByInvite != nil {
+		mmJoinByInvite.mock.t.Fatalf("ChatServiceMock.JoinByInvite mock is already set by Set")
+	}
+
+	if mmJoinByInvite.defaultExpectation == nil {
+		mmJoinByInvite.defaultExpectation = &ChatServiceMockJoinByInviteExpectation{}
+	}
+
+	if mmJoinByInvite.defaultExpectation.params != nil {
+		mmJoinByInvite.mock.t.Fatalf("ChatServiceMock.JoinByInvite mock is already set by Expect")
+	}
+
+	if mmJoinByInvite.defaultExpectation.paramPtrs == nil {
+		mmJoinByInvite.defaultExpectation.paramPtrs = &ChatServiceMockJoinByInviteParamPtrs{}
+	}
+	mmJoinByInvite.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmJoinByInvite
+}
+
+// ExpectTokenParam2 sets up expected param token for ChatService.JoinByInvite
+func (mmJoinByInvite *mChatServiceMockJoinByInvite) ExpectTokenParam2(token string) *mChatServiceMockJoinByInvite {
+	if mmJoinByInvite.mock.funcJoinByInvite != nil {
+		mmJoinByInvite.mock.t.Fatalf("ChatServiceMock.JoinByInvite mock is already set by Set")
+	}
+
+	if mmJoinByInvite.defaultExpectation == nil {
+		mmJoinByInvite.defaultExpectation = &ChatServiceMockJoinByInviteExpectation{}
+	}
+
+	if mmJoinByInvite.defaultExpectation.params != nil {
+		mmJoinByInvite.mock.t.Fatalf("ChatServiceMock.JoinByInvite mock is already set by Expect")
+	}
+
+	if mmJoinByInvite.defaultExpectation.paramPtrs == nil {
+		mmJoinByInvite.defaultExpectation.paramPtrs = &ChatServiceMockJoinByInviteParamPtrs{}
+	}
+	mmJoinByInvite.defaultExpectation.paramPtrs.token = &token
+
+	return mmJoinByInvite
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.JoinByInvite
+func (mmJoinByInvite *mChatServiceMockJoinByInvite) Inspect(f func(ctx context.Context, token string)) *mChatServiceMockJoinByInvite {
+	if mmJoinByInvite.mock.inspectFuncJoinByInvite != nil {
+		mmJoinByInvite.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.JoinByInvite")
+	}
+
+	mmJoinByInvite.mock.inspectFuncJoinByInvite = f
+
+	return mmJoinByInvite
+}
+
+// Return sets up results that will be returned by ChatService.JoinByInvite
+func (mmJoinByInvite *mChatServiceMockJoinByInvite) Return(i1 int64, err error) *ChatServiceMock {
+	if mmJoinByInvite.mock.funcJoinByInvite != nil {
+		mmJoinByInvite.mock.t.Fatalf("ChatServiceMock.JoinByInvite mock is already set by Set")
+	}
+
+	if mmJoinByInvite.defaultExpectation == nil {
+		mmJoinByInvite.defaultExpectation = &ChatServiceMockJoinByInviteExpectation{mock: mmJoinByInvite.mock}
+	}
+	mmJoinByInvite.defaultExpectation.results = &ChatServiceMockJoinByInviteResults{i1, err}
+	return mmJoinByInvite.mock
+}
+
+// Set uses given function f to mock the ChatService.JoinByInvite method
+func (mmJoinByInvite *mChatServiceMockJoinByInvite) Set(f func(ctx context.Context, token string) (i1 int64, err error)) *ChatServiceMock {
+	if mmJoinByInvite.defaultExpectation != nil {
+		mmJoinByInvite.mock.t.Fatalf("Default expectation is already set for the ChatService.JoinByInvite method")
+	}
+
+	if len(mmJoinByInvite.expectations) > 0 {
+		mmJoinByInvite.mock.t.Fatalf("Some expectations are already set for the ChatService.JoinByInvite method")
+	}
+
+	mmJoinByInvite.mock.funcJoinByInvite = f
+	return mmJoinByInvite.mock
+}
+
+// When sets expectation for the ChatService.JoinByInvite which will trigger the result defined by the following
+// Then helper
+func (mmJoinByInvite *mChatServiceMockJoinByInvite) When(ctx context.Context, token string) *ChatServiceMockJoinByInviteExpectation {
+	if mmJoinByInvite.mock.funcJoinByInvite != nil {
+		mmJoinByInvite.mock.t.Fatalf("ChatServiceMock.JoinByInvite mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockJoinByInviteExpectation{
+		mock:   mmJoinByInvite.mock,
+		params: &ChatServiceMockJoinByInviteParams{ctx, token},
+	}
+	mmJoinByInvite.expectations = append(mmJoinByInvite.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.JoinByInvite return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockJoinByInviteExpectation) Then(i1 int64, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockJoinByInviteResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.JoinByInvite should be invoked
+func (mmJoinByInvite *mChatServiceMockJoinByInvite) Times(n uint64) *mChatServiceMockJoinByInvite {
+	if n == 0 {
+		mmJoinByInvite.mock.t.Fatalf("Times of ChatServiceMock.JoinByInvite mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmJoinByInvite.expectedInvocations, n)
+	return mmJoinByInvite
+}
+
+func (mmJoinByInvite *mChatServiceMockJoinByInvite) invocationsDone() bool {
+	if len(mmJoinByInvite.expectations) == 0 && mmJoinByInvite.defaultExpectation == nil && mmJoinByInvite.mock.funcJoinByInvite == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmJoinByInvite.mock.afterJoinByInviteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmJoinByInvite.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// JoinByInvite implements service.ChatService
+func (mmJoinByInvite *ChatServiceMock) JoinByInvite(ctx context.Context, token string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmJoinByInvite.beforeJoinByInviteCounter, 1)
+	defer mm_atomic.AddUint64(&mmJoinByInvite.afterJoinByInviteCounter, 1)
+
+	if mmJoinByInvite.inspectFuncJoinByInvite != nil {
+		mmJoinByInvite.inspectFuncJoinByInvite(ctx, token)
+	}
+
+	mm_params := ChatServiceMockJoinByInviteParams{ctx, token}
+
+	// Record call args
+	mmJoinByInvite.JoinByInviteMock.mutex.Lock()
+	mmJoinByInvite.JoinByInviteMock.callArgs = append(mmJoinByInvite.JoinByInviteMock.callArgs, &mm_params)
+	mmJoinByInvite.JoinByInviteMock.mutex.Unlock()
+
+	for _, e := range mmJoinByInvite.JoinByInviteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmJoinByInvite.JoinByInviteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmJoinByInvite.JoinByInviteMock.defaultExpectation.Counter, 1)
+		mm_want := mmJoinByInvite.JoinByInviteMock.defaultExpectation.params
+		mm_want_ptrs := mmJoinByInvite.JoinByInviteMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockJoinByInviteParams{ctx, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmJoinByInvite.t.Errorf("ChatServiceMock.JoinByInvite got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmJoinByInvite.t.Errorf("ChatServiceMock.JoinByInvite got unexpected parameter token, want: %#v, got: %#v%s\n", *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmJoinByInvite.t.Errorf("ChatServiceMock.JoinByInvite got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmJoinByInvite.JoinByInviteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmJoinByInvite.t.Fatal("No results are set for the ChatServiceMock.JoinByInvite")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmJoinByInvite.funcJoinByInvite != nil {
+		return mmJoinByInvite.funcJoinByInvite(ctx, token)
+	}
+	mmJoinByInvite.t.Fatalf("Unexpected call to ChatServiceMock.JoinByInvite. %v %v", ctx, token)
+	return
+}
+
+// JoinByInviteAfterCounter returns a count of finished ChatServiceMock.JoinByInvite invocations
+func (mmJoinByInvite *ChatServiceMock) JoinByInviteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmJoinByInvite.afterJoinByInviteCounter)
+}
+
+// JoinByInviteBeforeCounter returns a count of ChatServiceMock.JoinByInvite invocations
+func (mmJoinByInvite *ChatServiceMock) JoinByInviteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmJoinByInvite.beforeJoinByInviteCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.JoinByInvite.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmJoinByInvite *mChatServiceMockJoinByInvite) Calls() []*ChatServiceMockJoinByInviteParams {
+	mmJoinByInvite.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockJoinByInviteParams, len(mmJoinByInvite.callArgs))
+	copy(argCopy, mmJoinByInvite.callArgs)
+
+	mmJoinByInvite.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockJoinByInviteDone returns true if the count of the JoinByInvite invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockJoinByInviteDone() bool {
+	if m.JoinByInviteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.JoinByInviteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.JoinByInviteMock.invocationsDone()
+}
+
+// MinimockJoinByInviteInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockJoinByInviteInspect() {
+	for _, e := range m.JoinByInviteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.JoinByInvite with params: %#v", *e.params)
+		}
+	}
+
+	afterJoinByInviteCounter := mm_atomic.LoadUint64(&m.afterJoinByInviteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.JoinByInviteMock.defaultExpectation != nil && afterJoinByInviteCounter < 1 {
+		if m.JoinByInviteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.JoinByInvite")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.JoinByInvite with params: %#v", *m.JoinByInviteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcJoinByInvite != nil && afterJoinByInviteCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.JoinByInvite")
+	}
+
+	if !m.JoinByInviteMock.invocationsDone() && afterJoinByInviteCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.JoinByInvite but found %d calls",
+			mm_atomic.LoadUint64(&m.JoinByInviteMock.expectedInvocations), afterJoinByInviteCounter)
+	}
+}
+
+type mChatServiceMockLeaveChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockLeaveChatExpectation
+	expectations       []*ChatServiceMockLeaveChatExpectation
+
+	callArgs []*ChatServiceMockLeaveChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockLeaveChatExpectation specifies expectation struct of the ChatService.LeaveChat
+type ChatServiceMockLeaveChatExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockLeaveChatParams
+	paramPtrs *ChatServiceMockLeaveChatParamPtrs
+	results   *ChatServiceMockLeaveChatResults
+	Counter   uint64
+}
+
+// ChatServiceMockLeaveChatParams contains parameters of the ChatService.LeaveChat
+type ChatServiceMockLeaveChatParams struct {
+	ctx    context.Context
+	chatID int64
+	userID int64
+}
+
+// ChatServiceMockLeaveChatParamPtrs contains pointers to parameters of the ChatService.LeaveChat
+type ChatServiceMockLeaveChatParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+	userID *int64
+}
+
+// ChatServiceMockLeaveChatResults contains results of the ChatService.LeaveChat
+type ChatServiceMockLeaveChatResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLeaveChat *mChatServiceMockLeaveChat) Optional() *mChatServiceMockLeaveChat {
+	mmLeaveChat.optional = true
+	return mmLeaveChat
+}
+
+// Expect sets up expected params for ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) Expect(ctx context.Context, chatID int64, userID int64) *mChatServiceMockLeaveChat {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	if mmLeaveChat.defaultExpectation == nil {
+		mmLeaveChat.defaultExpectation = &ChatServiceMockLeaveChatExpectation{}
+	}
+
+	if mmLeaveChat.defaultExpectation.paramPtrs != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by ExpectParams functions")
+	}
+
+	mmLeaveChat.defaultExpectation.params = &ChatServiceMockLeaveChatParams{ctx, chatID, userID}
+	for _, e := range mmLeaveChat.expectations {
+		if minimock.Equal(e.params, mmLeaveChat.defaultExpectation.params) {
+			mmLeaveChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLeaveChat.defaultExpectation.params)
+		}
+	}
+
+	return mmLeaveChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockLeaveChat {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	if mmLeaveChat.defaultExpectation == nil {
+		mmLeaveChat.defaultExpectation = &ChatServiceMockLeaveChatExpectation{}
+	}
+
+	if mmLeaveChat.defaultExpectation.params != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Expect")
+	}
+
+	if mmLeaveChat.defaultExpectation.paramPtrs == nil {
+		mmLeaveChat.defaultExpectation.paramPtrs = &ChatServiceMockLeaveChatParamPtrs{}
+	}
+	mmLeaveChat.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmLeaveChat
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) ExpectChatIDParam2(chatID int64) *mChatServiceMockLeaveChat {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	if mmLeaveChat.defaultExpectation == nil {
+		mmLeaveChat.defaultExpectation = &ChatServiceMockLeaveChatExpectation{}
+	}
+
+	if mmLeaveChat.defaultExpectation.params != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Expect")
+	}
+
+	if mmLeaveChat.defaultExpectation.paramPtrs == nil {
+		mmLeaveChat.defaultExpectation.paramPtrs = &ChatServiceMockLeaveChatParamPtrs{}
+	}
+	mmLeaveChat.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmLeaveChat
+}
+
+// ExpectUserIDParam3 sets up expected param userID for ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) ExpectUserIDParam3(userID int64) *mChatServiceMockLeaveChat {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	if mmLeaveChat.defaultExpectation == nil {
+		mmLeaveChat.defaultExpectation = &ChatServiceMockLeaveChatExpectation{}
+	}
+
+	if mmLeaveChat.defaultExpectation.params != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Expect")
+	}
+
+	if mmLeaveChat.defaultExpectation.paramPtrs == nil {
+		mmLeaveChat.defaultExpectation.paramPtrs = &ChatServiceMockLeaveChatParamPtrs{}
+	}
+	mmLeaveChat.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmLeaveChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) Inspect(f func(ctx context.Context, chatID int64, userID int64)) *mChatServiceMockLeaveChat {
+	if mmLeaveChat.mock.inspectFuncLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.LeaveChat")
+	}
+
+	mmLeaveChat.mock.inspectFuncLeaveChat = f
+
+	return mmLeaveChat
+}
+
+// Return sets up results that will be returned by ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) Return(err error) *ChatServiceMock {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	if mmLeaveChat.defaultExpectation == nil {
+		mmLeaveChat.defaultExpectation = &ChatServiceMockLeaveChatExpectation{mock: mmLeaveChat.mock}
+	}
+	mmLeaveChat.defaultExpectation.results = &ChatServiceMockLeaveChatResults{err}
+	return mmLeaveChat.mock
+}
+
+// Set uses given function f to mock the ChatService.LeaveChat method
+func (mmLeaveChat *mChatServiceMockLeaveChat) Set(f func(ctx context.Context, chatID int64, userID int64) (err error)) *ChatServiceMock {
+	if mmLeaveChat.defaultExpectation != nil {
+		mmLeaveChat.mock.t.Fatalf("Default expectation is already set for the ChatService.LeaveChat method")
+	}
+
+	if len(mmLeaveChat.expectations) > 0 {
+		mmLeaveChat.mock.t.Fatalf("Some expectations are already set for the ChatService.LeaveChat method")
+	}
+
+	mmLeaveChat.mock.funcLeaveChat = f
+	return mmLeaveChat.mock
+}
+
+// When sets expectation for the ChatService.LeaveChat which will trigger the result defined by the following
+// Then helper
+func (mmLeaveChat *mChatServiceMockLeaveChat) When(ctx context.Context, chatID int64, userID int64) *ChatServiceMockLeaveChatExpectation {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockLeaveChatExpectation{
+		mock:   mmLeaveChat.mock,
+		params: &ChatServiceMockLeaveChatParams{ctx, chatID, userID},
+	}
+	mmLeaveChat.expectations = append(mmLeaveChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.LeaveChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockLeaveChatExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockLeaveChatResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.LeaveChat should be invoked
+func (mmLeaveChat *mChatServiceMockLeaveChat) Times(n uint64) *mChatServiceMockLeaveChat {
+	if n == 0 {
+		mmLeaveChat.mock.t.Fatalf("Times of ChatServiceMock.LeaveChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLeaveChat.expectedInvocations, n)
+	return mmLeaveChat
+}
+
+func (mmLeaveChat *mChatServiceMockLeaveChat) invocationsDone() bool {
+	if len(mmLeaveChat.expectations) == 0 && mmLeaveChat.defaultExpectation == nil && mmLeaveChat.mock.funcLeaveChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLeaveChat.mock.afterLeaveChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLeaveChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LeaveChat implements service.ChatService
+func (mmLeaveChat *ChatServiceMock) LeaveChat(ctx context.Context, chatID int64, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmLeaveChat.beforeLeaveChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmLeaveChat.afterLeaveChatCounter, 1)
+
+	if mmLeaveChat.inspectFuncLeaveChat != nil {
+		mmLeaveChat.inspectFuncLeaveChat(ctx, chatID, userID)
+	}
+
+	mm_params := ChatServiceMockLeaveChatParams{ctx, chatID, userID}
+
+	// Record call args
+	mmLeaveChat.LeaveChatMock.mutex.Lock()
+	mmLeaveChat.LeaveChatMock.callArgs = append(mmLeaveChat.LeaveChatMock.callArgs, &mm_params)
+	mmLeaveChat.LeaveChatMock.mutex.Unlock()
+
+	for _, e := range mmLeaveChat.LeaveChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLeaveChat.LeaveChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLeaveChat.LeaveChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmLeaveChat.LeaveChatMock.defaultExpectation.params
+		mm_want_ptrs := mmLeaveChat.LeaveChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockLeaveChatParams{ctx, chatID, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLeaveChat.t.Errorf("ChatServiceMock.LeaveChat got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmLeaveChat.t.Errorf("ChatServiceMock.LeaveChat got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmLeaveChat.t.Errorf("ChatServiceMock.LeaveChat got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLeaveChat.t.Errorf("ChatServiceMock.LeaveChat got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLeaveChat.LeaveChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLeaveChat.t.Fatal("No results are set for the ChatServiceMock.LeaveChat")
+		}
+		return (*mm_results).err
+	}
+	if mmLeaveChat.funcLeaveChat != nil {
+		return mmLeaveChat.funcLeaveChat(ctx, chatID, userID)
+	}
+	mmLeaveChat.t.Fatalf("Unexpected call to ChatServiceMock.LeaveChat. %v %v %v", ctx, chatID, userID)
+	return
+}
+
+// LeaveChatAfterCounter returns a count of finished ChatServiceMock.LeaveChat invocations
+func (mmLeaveChat *ChatServiceMock) LeaveChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeaveChat.afterLeaveChatCounter)
+}
+
+// LeaveChatBeforeCounter returns a count of ChatServiceMock.LeaveChat invocations
+func (mmLeaveChat *ChatServiceMock) LeaveChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeaveChat.beforeLeaveChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.LeaveChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLeaveChat *mChatServiceMockLeaveChat) Calls() []*ChatServiceMockLeaveChatParams {
+	mmLeaveChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockLeaveChatParams, len(mmLeaveChat.callArgs))
+	copy(argCopy, mmLeaveChat.callArgs)
+
+	mmLeaveChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLeaveChatDone returns true if the count of the LeaveChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockLeaveChatDone() bool {
+	if m.LeaveChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LeaveChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LeaveChatMock.invocationsDone()
+}
+
+// MinimockLeaveChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockLeaveChatInspect() {
+	for _, e := range m.LeaveChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.LeaveChat with params: %#v", *e.params)
+		}
+	}
+
+	afterLeaveChatCounter := mm_atomic.LoadUint64(&m.afterLeaveChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LeaveChatMock.defaultExpectation != nil && afterLeaveChatCounter < 1 {
+		if m.LeaveChatMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.LeaveChat")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.LeaveChat with params: %#v", *m.LeaveChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLeaveChat != nil && afterLeaveChatCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.LeaveChat")
+	}
+
+	if !m.LeaveChatMock.invocationsDone() && afterLeaveChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.LeaveChat but found %d calls",
+			mm_atomic.LoadUint64(&m.LeaveChatMock.expectedInvocations), afterLeaveChatCounter)
+	}
+}
+
+type mChatServiceMockListChats struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListChatsExpectation
+	expectations       []*ChatServiceMockListChatsExpectation
+
+	callArgs []*ChatServiceMockListChatsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockListChatsExpectation specifies expectation struct of the ChatService.ListChats
+type ChatServiceMockListChatsExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockListChatsParams
+	paramPtrs *ChatServiceMockListChatsParamPtrs
+	results   *ChatServiceMockListChatsResults
+	Counter   uint64
+}
+
+// ChatServiceMockListChatsParams contains parameters of the ChatService.ListChats
+type ChatServiceMockListChatsParams struct {
+	ctx       context.Context
+	userID    int64
+	pageSize  int32
+	pageToken string
+}
+
+// ChatServiceMockListChatsParamPtrs contains pointers to parameters of the ChatService.ListChats
+type ChatServiceMockListChatsParamPtrs struct {
+	ctx       *context.Context
+	userID    *int64
+	pageSize  *int32
+	pageToken *string
+}
+
+// ChatServiceMockListChatsResults contains results of the ChatService.ListChats
+type ChatServiceMockListChatsResults struct {
+	cp1 *model.ChatPage
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListChats *mChatServiceMockListChats) Optional() *mChatServiceMockListChats {
+	mmListChats.optional = true
+	return mmListChats
+}
+
+// Expect sets up expected params for ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) Expect(ctx context.Context, userID int64, pageSize int32, pageToken string) *mChatServiceMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
+	}
+
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatServiceMockListChatsExpectation{}
+	}
+
+	if mmListChats.defaultExpectation.paramPtrs != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by ExpectParams functions")
+	}
+
+	mmListChats.defaultExpectation.params = &ChatServiceMockListChatsParams{ctx, userID, pageSize, pageToken}
+	for _, e := range mmListChats.expectations {
+		if minimock.Equal(e.params, mmListChats.defaultExpectation.params) {
+			mmListChats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListChats.defaultExpectation.params)
+		}
+	}
+
+	return mmListChats
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
+	}
+
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatServiceMockListChatsExpectation{}
+	}
+
+	if mmListChats.defaultExpectation.params != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Expect")
+	}
+
+	if mmListChats.defaultExpectation.paramPtrs == nil {
+		mmListChats.defaultExpectation.paramPtrs = &ChatServiceMockListChatsParamPtrs{}
+	}
+	mmListChats.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListChats
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) ExpectUserIDParam2(userID int64) *mChatServiceMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
+	}
+
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatServiceMockListChatsExpectation{}
+	}
+
+	if mmListChats.defaultExpectation.params != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Expect")
+	}
+
+	if mmListChats.defaultExpectation.paramPtrs == nil {
+		mmListChats.defaultExpectation.paramPtrs = &ChatServiceMockListChatsParamPtrs{}
+	}
+	mmListChats.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmListChats
+}
+
+// ExpectPageSizeParam3 sets up expected param pageSize for ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) ExpectPageSizeParam3(pageSize int32) *mChatServiceMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
+	}
+
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatServiceMockListChatsExpectation{}
+	}
+
+	if mmListChats.defaultExpectation.params != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Expect")
+	}
+
+	if mmListChats.defaultExpectation.paramPtrs == nil {
+		mmListChats.defaultExpectation.paramPtrs = &ChatServiceMockListChatsParamPtrs{}
+	}
+	mmListChats.defaultExpectation.paramPtrs.pageSize = &pageSize
+
+	return mmListChats
+}
+
+// ExpectPageTokenParam4 sets up expected param pageToken for ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) ExpectPageTokenParam4(pageToken string) *mChatServiceMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
+	}
+
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatServiceMockListChatsExpectation{}
+	}
+
+	if mmListChats.defaultExpectation.params != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Expect")
+	}
+
+	if mmListChats.defaultExpectation.paramPtrs == nil {
+		mmListChats.defaultExpectation.paramPtrs = &ChatServiceMockListChatsParamPtrs{}
+	}
+	mmListChats.defaultExpectation.paramPtrs.pageToken = &pageToken
+
+	return mmListChats
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) Inspect(f func(ctx context.Context, userID int64, pageSize int32, pageToken string)) *mChatServiceMockListChats {
+	if mmListChats.mock.inspectFuncListChats != nil {
+		mmListChats.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListChats")
+	}
+
+	mmListChats.mock.inspectFuncListChats = f
+
+	return mmListChats
+}
+
+// Return sets up results that will be returned by ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) Return(cp1 *model.ChatPage, err error) *ChatServiceMock {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
+	}
+
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatServiceMockListChatsExpectation{mock: mmListChats.mock}
+	}
+	mmListChats.defaultExpectation.results = &ChatServiceMockListChatsResults{cp1, err}
+	return mmListChats.mock
+}
+
+// Set uses given function f to mock the ChatService.ListChats method
+func (mmListChats *mChatServiceMockListChats) Set(f func(ctx context.Context, userID int64, pageSize int32, pageToken string) (cp1 *model.ChatPage, err error)) *ChatServiceMock {
+	if mmListChats.defaultExpectation != nil {
+		mmListChats.mock.t.Fatalf("Default expectation is already set for the ChatService.ListChats method")
+	}
+
+	if len(mmListChats.expectations) > 0 {
+		mmListChats.mock.t.Fatalf("Some expectations are already set for the ChatService.ListChats method")
+	}
+
+	mmListChats.mock.funcListChats = f
+	return mmListChats.mock
+}
+
+// When sets expectation for the ChatService.ListChats which will trigger the result defined by the following
+// Then helper
+func (mmListChats *mChatServiceMockListChats) When(ctx context.Context, userID int64, pageSize int32, pageToken string) *ChatServiceMockListChatsExpectation {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListChatsExpectation{
+		mock:   mmListChats.mock,
+		params: &ChatServiceMockListChatsParams{ctx, userID, pageSize, pageToken},
+	}
+	mmListChats.expectations = append(mmListChats.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListChats return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListChatsExpectation) Then(cp1 *model.ChatPage, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListChatsResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListChats should be invoked
+func (mmListChats *mChatServiceMockListChats) Times(n uint64) *mChatServiceMockListChats {
+	if n == 0 {
+		mmListChats.mock.t.Fatalf("Times of ChatServiceMock.ListChats mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListChats.expectedInvocations, n)
+	return mmListChats
+}
+
+func (mmListChats *mChatServiceMockListChats) invocationsDone() bool {
+	if len(mmListChats.expectations) == 0 && mmListChats.defaultExpectation == nil && mmListChats.mock.funcListChats == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListChats.mock.afterListChatsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListChats.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListChats implements service.ChatService
+func (mmListChats *ChatServiceMock) ListChats(ctx context.Context, userID int64, pageSize int32, pageToken string) (cp1 *model.ChatPage, err error) {
+	mm_atomic.AddUint64(&mmListChats.beforeListChatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListChats.afterListChatsCounter, 1)
+
+	if mmListChats.inspectFuncListChats != nil {
+		mmListChats.inspectFuncListChats(ctx, userID, pageSize, pageToken)
+	}
+
+	mm_params := ChatServiceMockListChatsParams{ctx, userID, pageSize, pageToken}
+
+	// Record call args
+	mmListChats.ListChatsMock.mutex.Lock()
+	mmListChats.ListChatsMock.callArgs = append(mmListChats.ListChatsMock.callArgs, &mm_params)
+	mmListChats.ListChatsMock.mutex.Unlock()
+
+	for _, e := range mmListChats.ListChatsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmListChats.ListChatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListChats.ListChatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListChats.ListChatsMock.defaultExpectation.params
+		mm_want_ptrs := mmListChats.ListChatsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListChatsParams{ctx, userID, pageSize, pageToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListChats.t.Errorf("ChatServiceMock.ListChats got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListChats.t.Errorf("ChatServiceMock.ListChats got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.pageSize != nil && !minimock.Equal(*mm_want_ptrs.pageSize, mm_got.pageSize) {
+				mmListChats.t.Errorf("ChatServiceMock.ListChats got unexpected parameter pageSize, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageSize, mm_got.pageSize, minimock.Diff(*mm_want_ptrs.pageSize, mm_got.pageSize))
+			}
+
+			if mm_want_ptrs.pageToken != nil && !minimock.Equal(*mm_want_ptrs.pageToken, mm_got.pageToken) {
+				mmListChats.t.Errorf("ChatServiceMock.ListChats got unexpected parameter pageToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageToken, mm_got.pageToken, minimock.Diff(*mm_want_ptrs.pageToken, mm_got.pageToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListChats.t.Errorf("ChatServiceMock.ListChats got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListChats.ListChatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListChats.t.Fatal("No results are set for the ChatServiceMock.ListChats")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmListChats.funcListChats != nil {
+		return mmListChats.funcListChats(ctx, userID, pageSize, pageToken)
+	}
+	mmListChats.t.Fatalf("Unexpected call to ChatServiceMock.ListChats. %v %v %v %v", ctx, userID, pageSize, pageToken)
+	return
+}
+
+// ListChatsAfterCounter returns a count of finished ChatServiceMock.ListChats invocations
+func (mmListChats *ChatServiceMock) ListChatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChats.afterListChatsCounter)
+}
+
+// ListChatsBeforeCounter returns a count of ChatServiceMock.ListChats invocations
+func (mmListChats *ChatServiceMock) ListChatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChats.beforeListChatsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListChats.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListChats *mChatServiceMockListChats) Calls() []*ChatServiceMockListChatsParams {
+	mmListChats.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListChatsParams, len(mmListChats.callArgs))
+	copy(argCopy, mmListChats.callArgs)
+
+	mmListChats.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListChatsDone returns true if the count of the ListChats invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListChatsDone() bool {
+	if m.ListChatsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListChatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListChatsMock.invocationsDone()
+}
+
+// MinimockListChatsInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListChatsInspect() {
+	for _, e := range m.ListChatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListChats with params: %#v", *e.params)
+		}
+	}
+
+	afterListChatsCounter := mm_atomic.LoadUint64(&m.afterListChatsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListChatsMock.defaultExpectation != nil && afterListChatsCounter < 1 {
+		if m.ListChatsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.ListChats")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListChats with params: %#v", *m.ListChatsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListChats != nil && afterListChatsCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.ListChats")
+	}
+
+	if !m.ListChatsMock.invocationsDone() && afterListChatsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListChats but found %d calls",
+			mm_atomic.LoadUint64(&m.ListChatsMock.expectedInvocations), afterListChatsCounter)
+	}
+}
+
+type mChatServiceMockListMentions struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListMentionsExpectation
+	expectations       []*ChatServiceMockListMentionsExpectation
+
+	callArgs []*ChatServiceMockListMentionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockListMentionsExpectation specifies expectation struct of the ChatService.ListMentions
+type ChatServiceMockListMentionsExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockListMentionsParams
+	paramPtrs *ChatServiceMockListMentionsParamPtrs
+	results   *ChatServiceMockListMentionsResults
+	Counter   uint64
+}
+
+// ChatServiceMockListMentionsParams contains parameters of the ChatService.ListMentions
+type ChatServiceMockListMentionsParams struct {
+	ctx       context.Context
+	userID    int64
+	pageSize  int32
+	pageToken string
+}
+
+// ChatServiceMockListMentionsParamPtrs contains pointers to parameters of the ChatService.ListMentions
+type ChatServiceMockListMentionsParamPtrs struct {
+	ctx       *context.Context
+	userID    *int64
+	pageSize  *int32
+	pageToken *string
+}
+
+// ChatServiceMockListMentionsResults contains results of the ChatService.ListMentions
+type ChatServiceMockListMentionsResults struct {
+	mp1 *model.MentionPage
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListMentions *mChatServiceMockListMentions) Optional() *mChatServiceMockListMentions {
+	mmListMentions.optional = true
+	return mmListMentions
+}
+
+// Expect sets up expected params for ChatService.ListMentions
+func (mmListMentions *mChatServiceMockListMentions) Expect(ctx context.Context, userID int64, pageSize int32, pageToken string) *mChatServiceMockListMentions {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &ChatServiceMockListMentionsExpectation{}
+	}
+
+	if mmListMentions.defaultExpectation.paramPtrs != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by ExpectParams functions")
+	}
+
+	mmListMentions.defaultExpectation.params = &ChatServiceMockListMentionsParams{ctx, userID, pageSize, pageToken}
+	for _, e := range mmListMentions.expectations {
+		if minimock.Equal(e.params, mmListMentions.defaultExpectation.params) {
+			mmListMentions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListMentions.defaultExpectation.params)
+		}
+	}
+
+	return mmListMentions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListMentions
+func (mmListMentions *mChatServiceMockListMentions) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListMentions {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &ChatServiceMockListMentionsExpectation{}
+	}
+
+	if mmListMentions.defaultExpectation.params != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Expect")
+	}
+
+	if mmListMentions.defaultExpectation.paramPtrs == nil {
+		mmListMentions.defaultExpectation.paramPtrs = &ChatServiceMockListMentionsParamPtrs{}
+	}
+	mmListMentions.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListMentions
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ChatService.ListMentions
+func (mmListMentions *mChatServiceMockListMentions) ExpectUserIDParam2(userID int64) *mChatServiceMockListMentions {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &ChatServiceMockListMentionsExpectation{}
+	}
+
+	if mmListMentions.defaultExpectation.params != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Expect")
+	}
+
+	if mmListMentions.defaultExpectation.paramPtrs == nil {
+		mmListMentions.defaultExpectation.paramPtrs = &ChatServiceMockListMentionsParamPtrs{}
+	}
+	mmListMentions.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmListMentions
+}
+
+// ExpectPageSizeParam3 sets up expected param pageSize for ChatService.ListMentions
+func (mmListMentions *mChatServiceMockListMentions) ExpectPageSizeParam3(pageSize int32) *mChatServiceMockListMentions {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &ChatServiceMockListMentionsExpectation{}
+	}
+
+	if mmListMentions.defaultExpectation.params != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Expect")
+	}
+
+	if mmListMentions.defaultExpectation.paramPtrs == nil {
+		mmListMentions.defaultExpectation.paramPtrs = &ChatServiceMockListMentionsParamPtrs{}
+	}
+	mmListMentions.defaultExpectation.paramPtrs.pageSize = &pageSize
+
+	return mmListMentions
+}
+
+// ExpectPageTokenParam4 sets up expected param pageToken for ChatService.ListMentions
+func (mmListMentions *mChatServiceMockListMentions) ExpectPageTokenParam4(pageToken string) *mChatServiceMockListMentions {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &ChatServiceMockListMentionsExpectation{}
+	}
+
+	if mmListMentions.defaultExpectation.params != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Expect")
+	}
+
+	if mmListMentions.defaultExpectation.paramPtrs == nil {
+		mmListMentions.defaultExpectation.paramPtrs = &ChatServiceMockListMentionsParamPtrs{}
+	}
+	mmListMentions.defaultExpectation.paramPtrs.pageToken = &pageToken
+
+	return mmListMentions
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListMentions
+func (mmListMentions *mChatServiceMockListMentions) Inspect(f func(ctx context.Context, userID int64, pageSize int32, pageToken string)) *mChatServiceMockListMentions {
+	if mmListMentions.mock.inspectFuncListMentions != nil {
+		mmListMentions.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListMentions")
+	}
+
+	mmListMentions.mock.inspectFuncListMentions = f
+
+	return mmListMentions
+}
+
+// Return sets up results that will be returned by ChatService.ListMentions
+func (mmListMentions *mChatServiceMockListMentions) Return(mp1 *model.MentionPage, err error) *ChatServiceMock {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &ChatServiceMockListMentionsExpectation{mock: mmListMentions.mock}
+	}
+	mmListMentions.defaultExpectation.results = &ChatServiceMockListMentionsResults{mp1, err}
+	return mmListMentions.mock
+}
+
+// Set uses given function f to mock the ChatService.ListMentions method
+func (mmListMentions *mChatServiceMockListMentions) Set(f func(ctx context.Context, userID int64, pageSize int32, pageToken string) (mp1 *model.MentionPage, err error)) *ChatServiceMock {
+	if mmListMentions.defaultExpectation != nil {
+		mmListMentions.mock.t.Fatalf("Default expectation is already set for the ChatService.ListMentions method")
+	}
+
+	if len(mmListMentions.expectations) > 0 {
+		mmListMentions.mock.t.Fatalf("Some expectations are already set for the ChatService.ListMentions method")
+	}
+
+	mmListMentions.mock.funcListMentions = f
+	return mmListMentions.mock
+}
+
+// When sets expectation for the ChatService.ListMentions which will trigger the result defined by the following
+// Then helper
+func (mmListMentions *mChatServiceMockListMentions) When(ctx context.Context, userID int64, pageSize int32, pageToken string) *ChatServiceMockListMentionsExpectation {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListMentionsExpectation{
+		mock:   mmListMentions.mock,
+		params: &ChatServiceMockListMentionsParams{ctx, userID, pageSize, pageToken},
+	}
+	mmListMentions.expectations = append(mmListMentions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListMentions return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListMentionsExpectation) Then(mp1 *model.MentionPage, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListMentionsResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListMentions should be invoked
+func (mmListMentions *mChatServiceMockListMentions) Times(n uint64) *mChatServiceMockListMentions {
+	if n == 0 {
+		mmListMentions.mock.t.Fatalf("Times of ChatServiceMock.ListMentions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListMentions.expectedInvocations, n)
+	return mmListMentions
+}
+
+func (mmListMentions *mChatServiceMockListMentions) invocationsDone() bool {
+	if len(mmListMentions.expectations) == 0 && mmListMentions.defaultExpectation == nil && mmListMentions.mock.funcListMentions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListMentions.mock.afterListMentionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListMentions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListMentions implements service.ChatService
+func (mmListMentions *ChatServiceMock) ListMentions(ctx context.Context, userID int64, pageSize int32, pageToken string) (mp1 *model.MentionPage, err error) {
+	mm_atomic.AddUint64(&mmListMentions.beforeListMentionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListMentions.afterListMentionsCounter, 1)
+
+	if mmListMentions.inspectFuncListMentions != nil {
+		mmListMentions.inspectFuncListMentions(ctx, userID, pageSize, pageToken)
+	}
+
+	mm_params := ChatServiceMockListMentionsParams{ctx, userID, pageSize, pageToken}
+
+	// Record call args
+	mmListMentions.ListMentionsMock.mutex.Lock()
+	mmListMentions.ListMentionsMock.callArgs = append(mmListMentions.ListMentionsMock.callArgs, &mm_params)
+	mmListMentions.ListMentionsMock.mutex.Unlock()
+
+	for _, e := range mmListMentions.ListMentionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmListMentions.ListMentionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListMentions.ListMentionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListMentions.ListMentionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListMentions.ListMentionsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListMentionsParams{ctx, userID, pageSize, pageToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListMentions.t.Errorf("ChatServiceMock.ListMentions got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListMentions.t.Errorf("ChatServiceMock.ListMentions got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.pageSize != nil && !minimock.Equal(*mm_want_ptrs.pageSize, mm_got.pageSize) {
+				mmListMentions.t.Errorf("ChatServiceMock.ListMentions got unexpected parameter pageSize, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageSize, mm_got.pageSize, minimock.Diff(*mm_want_ptrs.pageSize, mm_got.pageSize))
+			}
+
+			if mm_want_ptrs.pageToken != nil && !minimock.Equal(*mm_want_ptrs.pageToken, mm_got.pageToken) {
+				mmListMentions.t.Errorf("ChatServiceMock.ListMentions got unexpected parameter pageToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageToken, mm_got.pageToken, minimock.Diff(*mm_want_ptrs.pageToken, mm_got.pageToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListMentions.t.Errorf("ChatServiceMock.ListMentions got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListMentions.ListMentionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListMentions.t.Fatal("No results are set for the ChatServiceMock.ListMentions")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmListMentions.funcListMentions != nil {
+		return mmListMentions.funcListMentions(ctx, userID, pageSize, pageToken)
+	}
+	mmListMentions.t.Fatalf("Unexpected call to ChatServiceMock.ListMentions. %v %v %v %v", ctx, userID, pageSize, pageToken)
+	return
+}
+
+// ListMentionsAfterCounter returns a count of finished ChatServiceMock.ListMentions invocations
+func (mmListMentions *ChatServiceMock) ListMentionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMentions.afterListMentionsCounter)
+}
+
+// ListMentionsBeforeCounter returns a count of ChatServiceMock.ListMentions invocations
+func (mmListMentions *ChatServiceMock) ListMentionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMentions.beforeListMentionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListMentions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListMentions *mChatServiceMockListMentions) Calls() []*ChatServiceMockListMentionsParams {
+	mmListMentions.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListMentionsParams, len(mmListMentions.callArgs))
+	copy(argCopy, mmListMentions.callArgs)
+
+	mmListMentions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListMentionsDone returns true if the count of the ListMentions invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListMentionsDone() bool {
+	if m.ListMentionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMentionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMentionsMock.invocationsDone()
+}
+
+// MinimockListMentionsInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListMentionsInspect() {
+	for _, e := range m.ListMentionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMentions with params: %#v", *e.params)
+		}
+	}
+
+	afterListMentionsCounter := mm_atomic.LoadUint64(&m.afterListMentionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMentionsMock.defaultExpectation != nil && afterListMentionsCounter < 1 {
+		if m.ListMentionsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.ListMentions")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMentions with params: %#v", *m.ListMentionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListMentions != nil && afterListMentionsCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.ListMentions")
+	}
+
+	if !m.ListMentionsMock.invocationsDone() && afterListMentionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListMentions but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMentionsMock.expectedInvocations), afterListMentionsCounter)
+	}
+}
+
+type mChatServiceMockListMessages struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListMessagesExpectation
+	expectations       []*ChatServiceMockListMessagesExpectation
+
+	callArgs []*ChatServiceMockListMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockListMessagesExpectation specifies expectation struct of the ChatService.ListMessages
+type ChatServiceMockListMessagesExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockListMessagesParams
+	paramPtrs *ChatServiceMockListMessagesParamPtrs
+	results   *ChatServiceMockListMessagesResults
+	Counter   uint64
+}
+
+// ChatServiceMockListMessagesParams contains parameters of the ChatService.ListMessages
+type ChatServiceMockListMessagesParams struct {
+	ctx   context.Context
+	query *model.MessageListQuery
+}
+
+// ChatServiceMockListMessagesParamPtrs contains pointers to parameters of the ChatService.ListMessages
+type ChatServiceMockListMessagesParamPtrs struct {
+	ctx   *context.Context
+	query **model.MessageListQuery
+}
+
+// ChatServiceMockListMessagesResults contains results of the ChatService.ListMessages
+type ChatServiceMockListMessagesResults struct {
+	mp1 *model.MessagePage
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListMessages *mChatServiceMockListMessages) Optional() *mChatServiceMockListMessages {
+	mmListMessages.optional = true
+	return mmListMessages
+}
+
+// Expect sets up expected params for ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) Expect(ctx context.Context, query *model.MessageListQuery) *mChatServiceMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by ExpectParams functions")
+	}
+
+	mmListMessages.defaultExpectation.params = &ChatServiceMockListMessagesParams{ctx, query}
+	for _, e := range mmListMessages.expectations {
+		if minimock.Equal(e.params, mmListMessages.defaultExpectation.params) {
+			mmListMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmListMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.params != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Expect")
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs == nil {
+		mmListMessages.defaultExpectation.paramPtrs = &ChatServiceMockListMessagesParamPtrs{}
+	}
+	mmListMessages.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListMessages
+}
+
+// ExpectQueryParam2 sets up expected param query for ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) ExpectQueryParam2(query *model.MessageListQuery) *mChatServiceMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.params != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Expect")
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs == nil {
+		mmListMessages.defaultExpectation.paramPtrs = &ChatServiceMockListMessagesParamPtrs{}
+	}
+	mmListMessages.defaultExpectation.paramPtrs.query = &query
+
+	return mmListMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) Inspect(f func(ctx context.Context, query *model.MessageListQuery)) *mChatServiceMockListMessages {
+	if mmListMessages.mock.inspectFuncListMessages != nil {
+		mmListMessages.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListMessages")
+	}
+
+	mmListMessages.mock.inspectFuncListMessages = f
+
+	return mmListMessages
+}
+
+// Return sets up results that will be returned by ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) Return(mp1 *model.MessagePage, err error) *ChatServiceMock {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{mock: mmListMessages.mock}
+	}
+	mmListMessages.defaultExpectation.results = &ChatServiceMockListMessagesResults{mp1, err}
+	return mmListMessages.mock
+}
+
+// Set uses given function f to mock the ChatService.ListMessages method
+func (mmListMessages *mChatServiceMockListMessages) Set(f func(ctx context.Context, query *model.MessageListQuery) (mp1 *model.MessagePage, err error)) *ChatServiceMock {
+	if mmListMessages.defaultExpectation != nil {
+		mmListMessages.mock.t.Fatalf("Default expectation is already set for the ChatService.ListMessages method")
+	}
+
+	if len(mmListMessages.expectations) > 0 {
+		mmListMessages.mock.t.Fatalf("Some expectations are already set for the ChatService.ListMessages method")
+	}
+
+	mmListMessages.mock.funcListMessages = f
+	return mmListMessages.mock
+}
+
+// When sets expectation for the ChatService.ListMessages which will trigger the result defined by the following
+// Then helper
+func (mmListMessages *mChatServiceMockListMessages) When(ctx context.Context, query *model.MessageListQuery) *ChatServiceMockListMessagesExpectation {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListMessagesExpectation{
+		mock:   mmListMessages.mock,
+		params: &ChatServiceMockListMessagesParams{ctx, query},
+	}
+	mmListMessages.expectations = append(mmListMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListMessages return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListMessagesExpectation) Then(mp1 *model.MessagePage, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListMessagesResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListMessages should be invoked
+func (mmListMessages *mChatServiceMockListMessages) Times(n uint64) *mChatServiceMockListMessages {
+	if n == 0 {
+		mmListMessages.mock.t.Fatalf("Times of ChatServiceMock.ListMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListMessages.expectedInvocations, n)
+	return mmListMessages
+}
+
+func (mmListMessages *mChatServiceMockListMessages) invocationsDone() bool {
+	if len(mmListMessages.expectations) == 0 && mmListMessages.defaultExpectation == nil && mmListMessages.mock.funcListMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListMessages.mock.afterListMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListMessages implements service.ChatService
+func (mmListMessages *ChatServiceMock) ListMessages(ctx context.Context, query *model.MessageListQuery) (mp1 *model.MessagePage, err error) {
+	mm_atomic.AddUint64(&mmListMessages.beforeListMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListMessages.afterListMessagesCounter, 1)
+
+	if mmListMessages.inspectFuncListMessages != nil {
+		mmListMessages.inspectFuncListMessages(ctx, query)
+	}
+
+	mm_params := ChatServiceMockListMessagesParams{ctx, query}
+
+	// Record call args
+	mmListMessages.ListMessagesMock.mutex.Lock()
+	mmListMessages.ListMessagesMock.callArgs = append(mmListMessages.ListMessagesMock.callArgs, &mm_params)
+	mmListMessages.ListMessagesMock.mutex.Unlock()
+
+	for _, e := range mmListMessages.ListMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmListMessages.ListMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListMessages.ListMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListMessages.ListMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmListMessages.ListMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListMessagesParams{ctx, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListMessages.t.Errorf("ChatServiceMock.ListMessages got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmListMessages.t.Errorf("ChatServiceMock.ListMessages got unexpected parameter query, want: %#v, got: %#v%s\n", *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListMessages.t.Errorf("ChatServiceMock.ListMessages got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListMessages.ListMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListMessages.t.Fatal("No results are set for the ChatServiceMock.ListMessages")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmListMessages.funcListMessages != nil {
+		return mmListMessages.funcListMessages(ctx, query)
+	}
+	mmListMessages.t.Fatalf("Unexpected call to ChatServiceMock.ListMessages. %v %v", ctx, query)
+	return
+}
+
+// ListMessagesAfterCounter returns a count of finished ChatServiceMock.ListMessages invocations
+func (mmListMessages *ChatServiceMock) ListMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessages.afterListMessagesCounter)
+}
+
+// ListMessagesBeforeCounter returns a count of ChatServiceMock.ListMessages invocations
+func (mmListMessages *ChatServiceMock) ListMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessages.beforeListMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListMessages *mChatServiceMockListMessages) Calls() []*ChatServiceMockListMessagesParams {
+	mmListMessages.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListMessagesParams, len(mmListMessages.callArgs))
+	copy(argCopy, mmListMessages.callArgs)
+
+	mmListMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListMessagesDone returns true if the count of the ListMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListMessagesDone() bool {
+	if m.ListMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMessagesMock.invocationsDone()
+}
+
+// MinimockListMessagesInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListMessagesInspect() {
+	for _, e := range m.ListMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMessages with params: %#v", *e.params)
+		}
+	}
+
+	afterListMessagesCounter := mm_atomic.LoadUint64(&m.afterListMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMessagesMock.defaultExpectation != nil && afterListMessagesCounter < 1 {
+		if m.ListMessagesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.ListMessages")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMessages with params: %#v", *m.ListMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListMessages != nil && afterListMessagesCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.ListMessages")
+	}
+
+	if !m.ListMessagesMock.invocationsDone() && afterListMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListMessages but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMessagesMock.expectedInvocations), afterListMessagesCounter)
+	}
+}
+
+type mChatServiceMockListPinned struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListPinnedExpectation
+	expectations       []*ChatServiceMockListPinnedExpectation
+
+	callArgs []*ChatServiceMockListPinnedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockListPinnedExpectation specifies expectation struct of the ChatService.ListPinned
+type ChatServiceMockListPinnedExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockListPinnedParams
+	paramPtrs *ChatServiceMockListPinnedParamPtrs
+	results   *ChatServiceMockListPinnedResults
+	Counter   uint64
+}
+
+// ChatServiceMockListPinnedParams contains parameters of the ChatService.ListPinned
+type ChatServiceMockListPinnedParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// ChatServiceMockListPinnedParamPtrs contains pointers to parameters of the ChatService.ListPinned
+type ChatServiceMockListPinnedParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// ChatServiceMockListPinnedResults contains results of the ChatService.ListPinned
+type ChatServiceMockListPinnedResults struct {
+	ppa1 []*model.PinnedMessage
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPinned *mChatServiceMockListPinned) Optional() *mChatServiceMockListPinned {
+	mmListPinned.optional = true
+	return mmListPinned
+}
+
+// Expect sets up expected params for ChatService.ListPinned
+func (mmListPinned *mChatServiceMockListPinned) Expect(ctx context.Context, chatID int64) *mChatServiceMockListPinned {
+	if mmListPinned.mock.funcListPinned != nil {
+		mmListPinned.mock.t.Fatalf("ChatServiceMock.ListPinned mock is already set by Set")
+	}
+
+	if mmListPinned.defaultExpectation == nil {
+		mmListPinned.defaultExpectation = &ChatServiceMockListPinnedExpectation{}
+	}
+
+	if mmListPinned.defaultExpectation.paramPtrs != nil {
+		mmListPinned.mock.t.Fatalf("ChatServiceMock.ListPinned mock is already set by ExpectParams functions")
+	}
+
+	mmListPinned.defaultExpectation.params = &ChatServiceMockListPinnedParams{ctx, chatID}
+	for _, e := range mmListPinned.expectations {
+		if minimock.Equal(e.params, mmListPinned.defaultExpectation.params) {
+			mmListPinned.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPinned.defaultExpectation.params)
+		}
+	}
+
+	return mmListPinned
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListPinned
+func (mmListPinned *mChatServiceMockListPinned) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListPinned {
+	if mmListPinned.mock.funcListPinned != nil {
+		mmListPinned.mock.t.Fatalf("ChatServiceMock.ListPinned mock is already set by Set")
+	}
+
+	if mmListPinned.defaultExpectation == nil {
+		mmListPinned.defaultExpectation = &ChatServiceMockListPinnedExpectation{}
+	}
+
+	if mmListPinned.defaultExpectation.params != nil {
+		mmListPinned.mock.t.Fatalf("ChatServiceMock.ListPinned mock is already set by Expect")
+	}
+
+	if mmListPinned.defaultExpectation.paramPtrs == nil {
+		mmListPinned.defaultExpectation.paramPtrs = &ChatServiceMockListPinnedParamPtrs{}
+	}
+	mmListPinned.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListPinned
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.ListPinned
+func (mmListPinned *mChatServiceMockListPinned) ExpectChatIDParam2(chatID int64) *mChatServiceMockListPinned {
+	if mmListPinned.mock.funcListPinned != nil {
+		mmListPinned.mock.t.Fatalf("ChatServiceMock.ListPinned mock is already set by Set")
+	}
+
+	if mmListPinned.defaultExpectation == nil {
+		mmListPinned.defaultExpectation = &ChatServiceMockListPinnedExpectation{}
+	}
+
+	if mmListPinned.defaultExpectation.params != nil {
+		mmListPinned.mock.t.Fatalf("ChatServiceMock.ListPinned mock is already set by Expect")
+	}
+
+	if mmListPinned.defaultExpectation.paramPtrs == nil {
+		mmListPinned.defaultExpectation.paramPtrs = &ChatServiceMockListPinnedParamPtrs{}
+	}
+	mmListPinned.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmListPinned
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListPinned
+func (mmListPinned *mChatServiceMockListPinned) Inspect(f func(ctx context.Context, chatID int64)) *mChatServiceMockListPinned {
+	if mmListPinned.mock.inspectFuncListPinned != nil {
+		mmListPinned.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListPinned")
+	}
+
+	mmListPinned.mock.inspectFuncListPinned = f
+
+	return mmListPinned
+}
+
+// Return sets up results that will be returned by ChatService.ListPinned
+func (mmListPinned *mChatServiceMockListPinned) Return(ppa1 []*model.PinnedMessage, err error) *ChatServiceMock {
+	if mmListPinned.mock.funcListPinned != nil {
+		mmListPinned.mock.t.Fatalf("ChatServiceMock.ListPinned mock is already set by Set")
+	}
+
+	if mmListPinned.defaultExpectation == nil {
+		mmListPinned.defaultExpectation = &ChatServiceMockListPinnedExpectation{mock: mmListPinned.mock}
+	}
+	mmListPinned.defaultExpectation.results = &ChatServiceMockListPinnedResults{ppa1, err}
+	return mmListPinned.mock
+}
+
+// Set uses given function f to mock the ChatService.ListPinned method
+func (mmListPinned *mChatServiceMockListPinned) Set(f func(ctx context.Context, chatID int64) (ppa1 []*model.PinnedMessage, err error)) *ChatServiceMock {
+	if mmListPinned.defaultExpectation != nil {
+		mmListPinned.mock.t.Fatalf("Default expectation is already set for the ChatService.ListPinned method")
+	}
+
+	if len(mmListPinned.expectations) > 0 {
+		mmListPinned.mock.t.Fatalf("Some expectations are already set for the ChatService.ListPinned method")
+	}
+
+	mmListPinned.mock.funcListPinned = f
+	return mmListPinned.mock
+}
+
+// When sets expectation for the ChatService.ListPinned which will trigger the result defined by the following
+// Then helper
+func (mmListPinned *mChatServiceMockListPinned) When(ctx context.Context, chatID int64) *ChatServiceMockListPinnedExpectation {
+	if mmListPinned.mock.funcListPinned != nil {
+		mmListPinned.mock.t.Fatalf("ChatServiceMock.ListPinned mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListPinnedExpectation{
+		mock:   mmListPinned.mock,
+		params: &ChatServiceMockListPinnedParams{ctx, chatID},
+	}
+	mmListPinned.expectations = append(mmListPinned.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListPinned return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListPinnedExpectation) Then(ppa1 []*model.PinnedMessage, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListPinnedResults{ppa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListPinned should be invoked
+func (mmListPinned *mChatServiceMockListPinned) Times(n uint64) *mChatServiceMockListPinned {
+	if n == 0 {
+		mmListPinned.mock.t.Fatalf("Times of ChatServiceMock.ListPinned mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPinned.expectedInvocations, n)
+	return mmListPinned
+}
+
+func (mmListPinned *mChatServiceMockListPinned) invocationsDone() bool {
+	if len(mmListPinned.expectations) == 0 && mmListPinned.defaultExpectation == nil && mmListPinned.mock.funcListPinned == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPinned.mock.afterListPinnedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPinned.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPinned implements service.ChatService
+func (mmListPinned *ChatServiceMock) ListPinned(ctx context.Context, chatID int64) (ppa1 []*model.PinnedMessage, err error) {
+	mm_atomic.AddUint64(&mmListPinned.beforeListPinnedCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPinned.afterListPinnedCounter, 1)
+
+	if mmListPinned.inspectFuncListPinned != nil {
+		mmListPinned.inspectFuncListPinned(ctx, chatID)
+	}
+
+	mm_params := ChatServiceMockListPinnedParams{ctx, chatID}
+
+	// Record call args
+	mmListPinned.ListPinnedMock.mutex.Lock()
+	mmListPinned.ListPinnedMock.callArgs = append(mmListPinned.ListPinnedMock.callArgs, &mm_params)
+	mmListPinned.ListPinnedMock.mutex.Unlock()
+
+	for _, e := range mmListPinned.ListPinnedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmListPinned.ListPinnedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPinned.ListPinnedMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPinned.ListPinnedMock.defaultExpectation.params
+		mm_want_ptrs := mmListPinned.ListPinnedMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListPinnedParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPinned.t.Errorf("ChatServiceMock.ListPinned got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmListPinned.t.Errorf("ChatServiceMock.ListPinned got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPinned.t.Errorf("ChatServiceMock.ListPinned got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPinned.ListPinnedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPinned.t.Fatal("No results are set for the ChatServiceMock.ListPinned")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmListPinned.funcListPinned != nil {
+		return mmListPinned.funcListPinned(ctx, chatID)
+	}
+	mmListPinned.t.Fatalf("Unexpected call to ChatServiceMock.ListPinned. %v %v", ctx, chatID)
+	return
+}
+
+// ListPinnedAfterCounter returns a count of finished ChatServiceMock.ListPinned invocations
+func (mmListPinned *ChatServiceMock) ListPinnedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPinned.afterListPinnedCounter)
+}
+
+// ListPinnedBeforeCounter returns a count of ChatServiceMock.ListPinned invocations
+func (mmListPinned *ChatServiceMock) ListPinnedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPinned.beforeListPinnedCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListPinned.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPinned *mChatServiceMockListPinned) Calls() []*ChatServiceMockListPinnedParams {
+	mmListPinned.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListPinnedParams, len(mmListPinned.callArgs))
+	copy(argCopy, mmListPinned.callArgs)
+
+	mmListPinned.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPinnedDone returns true if the count of the ListPinned invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListPinnedDone() bool {
+	if m.ListPinnedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPinnedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPinnedMock.invocationsDone()
+}
+
+// MinimockListPinnedInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListPinnedInspect() {
+	for _, e := range m.ListPinnedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListPinned with params: %#v", *e.params)
+		}
+	}
+
+	afterListPinnedCounter := mm_atomic.LoadUint64(&m.afterListPinnedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPinnedMock.defaultExpectation != nil && afterListPinnedCounter < 1 {
+		if m.ListPinnedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.ListPinned")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListPinned with params: %#v", *m.ListPinnedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPinned != nil && afterListPinnedCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.ListPinned")
+	}
+
+	if !m.ListPinnedMock.invocationsDone() && afterListPinnedCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListPinned but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPinnedMock.expectedInvocations), afterListPinnedCounter)
+	}
+}
+
+type mChatServiceMockListScheduledMessages struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListScheduledMessagesExpectation
+	expectations       []*ChatServiceMockListScheduledMessagesExpectation
+
+	callArgs []*ChatServiceMockListScheduledMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockListScheduledMessagesExpectation specifies expectation struct of the ChatService.ListScheduledMessages
+type ChatServiceMockListScheduledMessagesExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockListScheduledMessagesParams
+	paramPtrs *ChatServiceMockListScheduledMessagesParamPtrs
+	results   *ChatServiceMockListScheduledMessagesResults
+	Counter   uint64
+}
+
+// ChatServiceMockListScheduledMessagesParams contains parameters of the ChatService.ListScheduledMessages
+type ChatServiceMockListScheduledMessagesParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// ChatServiceMockListScheduledMessagesParamPtrs contains pointers to parameters of the ChatService.ListScheduledMessages
+type ChatServiceMockListScheduledMessagesParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// ChatServiceMockListScheduledMessagesResults contains results of the ChatService.ListScheduledMessages
+type ChatServiceMockListScheduledMessagesResults struct {
+	spa1 []*model.ScheduledMessage
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) Optional() *mChatServiceMockListScheduledMessages {
+	mmListScheduledMessages.optional = true
+	return mmListScheduledMessages
+}
+
+// Expect sets up expected params for ChatService.ListScheduledMessages
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) Expect(ctx context.Context, chatID int64) *mChatServiceMockListScheduledMessages {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ChatServiceMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	if mmListScheduledMessages.defaultExpectation == nil {
+		mmListScheduledMessages.defaultExpectation = &ChatServiceMockListScheduledMessagesExpectation{}
+	}
+
+	if mmListScheduledMessages.defaultExpectation.paramPtrs != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ChatServiceMock.ListScheduledMessages mock is already set by ExpectParams functions")
+	}
+
+	mmListScheduledMessages.defaultExpectation.params = &ChatServiceMockListScheduledMessagesParams{ctx, chatID}
+	for _, e := range mmListScheduledMessages.expectations {
+		if minimock.Equal(e.params, mmListScheduledMessages.defaultExpectation.params) {
+			mmListScheduledMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListScheduledMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmListScheduledMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListScheduledMessages
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListScheduledMessages {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ChatServiceMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	if mmListScheduledMessages.defaultExpectation == nil {
+		mmListScheduledMessages.defaultExpectation = &ChatServiceMockListScheduledMessagesExpectation{}
+	}
+
+	if mmListScheduledMessages.defaultExpectation.params != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ChatServiceMock.ListScheduledMessages mock is already set by Expect")
+	}
+
+	if mmListScheduledMessages.defaultExpectation.paramPtrs == nil {
+		mmListScheduledMessages.defaultExpectation.paramPtrs = &ChatServiceMockListScheduledMessagesParamPtrs{}
+	}
+	mmListScheduledMessages.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListScheduledMessages
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.ListScheduledMessages
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) ExpectChatIDParam2(chatID int64) *mChatServiceMockListScheduledMessages {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ChatServiceMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	if mmListScheduledMessages.defaultExpectation == nil {
+		mmListScheduledMessages.defaultExpectation = &ChatServiceMockListScheduledMessagesExpectation{}
+	}
+
+	if mmListScheduledMessages.defaultExpectation.params != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ChatServiceMock.ListScheduledMessages mock is already set by Expect")
+	}
+
+	if mmListScheduledMessages.defaultExpectation.paramPtrs == nil {
+		mmListScheduledMessages.defaultExpectation.paramPtrs = &ChatServiceMockListScheduledMessagesParamPtrs{}
+	}
+	mmListScheduledMessages.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmListScheduledMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListScheduledMessages
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) Inspect(f func(ctx context.Context, chatID int64)) *mChatServiceMockListScheduledMessages {
+	if mmListScheduledMessages.mock.inspectFuncListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListScheduledMessages")
+	}
+
+	mmListScheduledMessages.mock.inspectFuncListScheduledMessages = f
+
+	return mmListScheduledMessages
+}
+
+// Return sets up results that will be returned by ChatService.ListScheduledMessages
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) Return(spa1 []*model.ScheduledMessage, err error) *ChatServiceMock {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ChatServiceMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	if mmListScheduledMessages.defaultExpectation == nil {
+		mmListScheduledMessages.defaultExpectation = &ChatServiceMockListScheduledMessagesExpectation{mock: mmListScheduledMessages.mock}
+	}
+	mmListScheduledMessages.defaultExpectation.results = &ChatServiceMockListScheduledMessagesResults{spa1, err}
+	return mmListScheduledMessages.mock
+}
+
+// Set uses given function f to mock the ChatService.ListScheduledMessages method
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) Set(f func(ctx context.Context, chatID int64) (spa1 []*model.ScheduledMessage, err error)) *ChatServiceMock {
+	if mmListScheduledMessages.defaultExpectation != nil {
+		mmListScheduledMessages.mock.t.Fatalf("Default expectation is already set for the ChatService.ListScheduledMessages method")
+	}
+
+	if len(mmListScheduledMessages.expectations) > 0 {
+		mmListScheduledMessages.mock.t.Fatalf("Some expectations are already set for the ChatService.ListScheduledMessages method")
+	}
+
+	mmListScheduledMessages.mock.funcListScheduledMessages = f
+	return mmListScheduledMessages.mock
+}
+
+// When sets expectation for the ChatService.ListScheduledMessages which will trigger the result defined by the following
+// Then helper
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) When(ctx context.Context, chatID int64) *ChatServiceMockListScheduledMessagesExpectation {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ChatServiceMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListScheduledMessagesExpectation{
+		mock:   mmListScheduledMessages.mock,
+		params: &ChatServiceMockListScheduledMessagesParams{ctx, chatID},
+	}
+	mmListScheduledMessages.expectations = append(mmListScheduledMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListScheduledMessages return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListScheduledMessagesExpectation) Then(spa1 []*model.ScheduledMessage, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListScheduledMessagesResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListScheduledMessages should be invoked
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) Times(n uint64) *mChatServiceMockListScheduledMessages {
+	if n == 0 {
+		mmListScheduledMessages.mock.t.Fatalf("Times of ChatServiceMock.ListScheduledMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListScheduledMessages.expectedInvocations, n)
+	return mmListScheduledMessages
+}
+
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) invocationsDone() bool {
+	if len(mmListScheduledMessages.expectations) == 0 && mmListScheduledMessages.defaultExpectation == nil && mmListScheduledMessages.mock.funcListScheduledMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListScheduledMessages.mock.afterListScheduledMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListScheduledMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListScheduledMessages implements service.ChatService
+func (mmListScheduledMessages *ChatServiceMock) ListScheduledMessages(ctx context.Context, chatID int64) (spa1 []*model.ScheduledMessage, err error) {
+	mm_atomic.AddUint64(&mmListScheduledMessages.beforeListScheduledMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListScheduledMessages.afterListScheduledMessagesCounter, 1)
+
+	if mmListScheduledMessages.inspectFuncListScheduledMessages != nil {
+		mmListScheduledMessages.inspectFuncListScheduledMessages(ctx, chatID)
+	}
+
+	mm_params := ChatServiceMockListScheduledMessagesParams{ctx, chatID}
+
+	// Record call args
+	mmListScheduledMessages.ListScheduledMessagesMock.mutex.Lock()
+	mmListScheduledMessages.ListScheduledMessagesMock.callArgs = append(mmListScheduledMessages.ListScheduledMessagesMock.callArgs, &mm_params)
+	mmListScheduledMessages.ListScheduledMessagesMock.mutex.Unlock()
+
+	for _, e := range mmListScheduledMessages.ListScheduledMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListScheduledMessagesParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListScheduledMessages.t.Errorf("ChatServiceMock.ListScheduledMessages got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmListScheduledMessages.t.Errorf("ChatServiceMock.ListScheduledMessages got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListScheduledMessages.t.Errorf("ChatServiceMock.ListScheduledMessages got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListScheduledMessages.t.Fatal("No results are set for the ChatServiceMock.ListScheduledMessages")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmListScheduledMessages.funcListScheduledMessages != nil {
+		return mmListScheduledMessages.funcListScheduledMessages(ctx, chatID)
+	}
+	mmListScheduledMessages.t.Fatalf("Unexpected call to ChatServiceMock.ListScheduledMessages. %v %v", ctx, chatID)
+	return
+}
+
+// ListScheduledMessagesAfterCounter returns a count of finished ChatServiceMock.ListScheduledMessages invocations
+func (mmListScheduledMessages *ChatServiceMock) ListScheduledMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListScheduledMessages.afterListScheduledMessagesCounter)
+}
+
+// ListScheduledMessagesBeforeCounter returns a count of ChatServiceMock.ListScheduledMessages invocations
+func (mmListScheduledMessages *ChatServiceMock) ListScheduledMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListScheduledMessages.beforeListScheduledMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListScheduledMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) Calls() []*ChatServiceMockListScheduledMessagesParams {
+	mmListScheduledMessages.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListScheduledMessagesParams, len(mmListScheduledMessages.callArgs))
+	copy(argCopy, mmListScheduledMessages.callArgs)
+
+	mmListScheduledMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListScheduledMessagesDone returns true if the count of the ListScheduledMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListScheduledMessagesDone() bool {
+	if m.ListScheduledMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListScheduledMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListScheduledMessagesMock.invocationsDone()
+}
+
+// MinimockListScheduledMessagesInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListScheduledMessagesInspect() {
+	for _, e := range m.ListScheduledMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListScheduledMessages with params: %#v", *e.params)
+		}
+	}
+
+	afterListScheduledMessagesCounter := mm_atomic.LoadUint64(&m.afterListScheduledMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListScheduledMessagesMock.defaultExpectation != nil && afterListScheduledMessagesCounter < 1 {
+		if m.ListScheduledMessagesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.ListScheduledMessages")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListScheduledMessages with params: %#v", *m.ListScheduledMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListScheduledMessages != nil && afterListScheduledMessagesCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.ListScheduledMessages")
+	}
+
+	if !m.ListScheduledMessagesMock.invocationsDone() && afterListScheduledMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListScheduledMessages but found %d calls",
+			mm_atomic.LoadUint64(&m.ListScheduledMessagesMock.expectedInvocations), afterListScheduledMessagesCounter)
+	}
+}
+
+type mChatServiceMockListThread struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListThreadExpectation
+	expectations       []*ChatServiceMockListThreadExpectation
+
+	callArgs []*ChatServiceMockListThreadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockListThreadExpectation specifies expectation struct of the ChatService.ListThread
+type ChatServiceMockListThreadExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockListThreadParams
+	paramPtrs *ChatServiceMockListThreadParamPtrs
+	results   *ChatServiceMockListThreadResults
+	Counter   uint64
+}
+
+// ChatServiceMockListThreadParams contains parameters of the ChatService.ListThread
+type ChatServiceMockListThreadParams struct {
+	ctx   context.Context
+	query *model.ThreadQuery
+}
+
+// ChatServiceMockListThreadParamPtrs contains pointers to parameters of the ChatService.ListThread
+type ChatServiceMockListThreadParamPtrs struct {
+	ctx   *context.Context
+	query **model.ThreadQuery
+}
+
+// ChatServiceMockListThreadResults contains results of the ChatService.ListThread
+type ChatServiceMockListThreadResults struct {
+	tp1 *model.ThreadPage
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListThread *mChatServiceMockListThread) Optional() *mChatServiceMockListThread {
+	mmListThread.optional = true
+	return mmListThread
+}
+
+// Expect sets up expected params for ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) Expect(ctx context.Context, query *model.ThreadQuery) *mChatServiceMockListThread {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	if mmListThread.defaultExpectation == nil {
+		mmListThread.defaultExpectation = &ChatServiceMockListThreadExpectation{}
+	}
+
+	if mmListThread.defaultExpectation.paramPtrs != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by ExpectParams functions")
+	}
+
+	mmListThread.defaultExpectation.params = &ChatServiceMockListThreadParams{ctx, query}
+	for _, e := range mmListThread.expectations {
+		if minimock.Equal(e.params, mmListThread.defaultExpectation.params) {
+			mmListThread.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListThread.defaultExpectation.params)
+		}
+	}
+
+	return mmListThread
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListThread {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	if mmListThread.defaultExpectation == nil {
+		mmListThread.defaultExpectation = &ChatServiceMockListThreadExpectation{}
+	}
+
+	if mmListThread.defaultExpectation.params != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Expect")
+	}
+
+	if mmListThread.defaultExpectation.paramPtrs == nil {
+		mmListThread.defaultExpectation.paramPtrs = &ChatServiceMockListThreadParamPtrs{}
+	}
+	mmListThread.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListThread
+}
+
+// ExpectQueryParam2 sets up expected param query for ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) ExpectQueryParam2(query *model.ThreadQuery) *mChatServiceMockListThread {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	if mmListThread.defaultExpectation == nil {
+		mmListThread.defaultExpectation = &ChatServiceMockListThreadExpectation{}
+	}
+
+	if mmListThread.defaultExpectation.params != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Expect")
+	}
+
+	if mmListThread.defaultExpectation.paramPtrs == nil {
+		mmListThread.defaultExpectation.paramPtrs = &ChatServiceMockListThreadParamPtrs{}
+	}
+	mmListThread.defaultExpectation.paramPtrs.query = &query
+
+	return mmListThread
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) Inspect(f func(ctx context.Context, query *model.ThreadQuery)) *mChatServiceMockListThread {
+	if mmListThread.mock.inspectFuncListThread != nil {
+		mmListThread.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListThread")
+	}
+
+	mmListThread.mock.inspectFuncListThread = f
+
+	return mmListThread
+}
+
+// Return sets up results that will be returned by ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) Return(tp1 *model.ThreadPage, err error) *ChatServiceMock {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	if mmListThread.defaultExpectation == nil {
+		mmListThread.defaultExpectation = &ChatServiceMockListThreadExpectation{mock: mmListThread.mock}
+	}
+	mmListThread.defaultExpectation.results = &ChatServiceMockListThreadResults{tp1, err}
+	return mmListThread.mock
+}
+
+// Set uses given function f to mock the ChatService.ListThread method
+func (mmListThread *mChatServiceMockListThread) Set(f func(ctx context.Context, query *model.ThreadQuery) (tp1 *model.ThreadPage, err error)) *ChatServiceMock {
+	if mmListThread.defaultExpectation != nil {
+		mmListThread.mock.t.Fatalf("Default expectation is already set for the ChatService.ListThread method")
+	}
+
+	if len(mmListThread.expectations) > 0 {
+		mmListThread.mock.t.Fatalf("Some expectations are already set for the ChatService.ListThread method")
+	}
+
+	mmListThread.mock.funcListThread = f
+	return mmListThread.mock
+}
+
+// When sets expectation for the ChatService.ListThread which will trigger the result defined by the following
+// Then helper
+func (mmListThread *mChatServiceMockListThread) When(ctx context.Context, query *model.ThreadQuery) *ChatServiceMockListThreadExpectation {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListThreadExpectation{
+		mock:   mmListThread.mock,
+		params: &ChatServiceMockListThreadParams{ctx, query},
+	}
+	mmListThread.expectations = append(mmListThread.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListThread return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListThreadExpectation) Then(tp1 *model.ThreadPage, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListThreadResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListThread should be invoked
+func (mmListThread *mChatServiceMockListThread) Times(n uint64) *mChatServiceMockListThread {
+	if n == 0 {
+		mmListThread.mock.t.Fatalf("Times of ChatServiceMock.ListThread mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListThread.expectedInvocations, n)
+	return mmListThread
+}
+
+func (mmListThread *mChatServiceMockListThread) invocationsDone() bool {
+	if len(mmListThread.expectations) == 0 && mmListThread.defaultExpectation == nil && mmListThread.mock.funcListThread == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListThread.mock.afterListThreadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListThread.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListThread implements service.ChatService
+func (mmListThread *ChatServiceMock) ListThread(ctx context.Context, query *model.ThreadQuery) (tp1 *model.ThreadPage, err error) {
+	mm_atomic.AddUint64(&mmListThread.beforeListThreadCounter, 1)
+	defer mm_atomic.AddUint64(&mmListThread.afterListThreadCounter, 1)
+
+	if mmListThread.inspectFuncListThread != nil {
+		mmListThread.inspectFuncListThread(ctx, query)
+	}
+
+	mm_params := ChatServiceMockListThreadParams{ctx, query}
+
+	// Record call args
+	mmListThread.ListThreadMock.mutex.Lock()
+	mmListThread.ListThreadMock.callArgs = append(mmListThread.ListThreadMock.callArgs, &mm_params)
+	mmListThread.ListThreadMock.mutex.Unlock()
+
+	for _, e := range mmListThread.ListThreadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmListThread.ListThreadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListThread.ListThreadMock.defaultExpectation.Counter, 1)
+		mm_want := mmListThread.ListThreadMock.defaultExpectation.params
+		mm_want_ptrs := mmListThread.ListThreadMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListThreadParams{ctx, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListThread.t.Errorf("ChatServiceMock.ListThread got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmListThread.t.Errorf("ChatServiceMock.ListThread got unexpected parameter query, want: %#v, got: %#v%s\n", *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListThread.t.Errorf("ChatServiceMock.ListThread got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListThread.ListThreadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListThread.t.Fatal("No results are set for the ChatServiceMock.ListThread")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmListThread.funcListThread != nil {
+		return mmListThread.funcListThread(ctx, query)
+	}
+	mmListThread.t.Fatalf("Unexpected call to ChatServiceMock.ListThread. %v %v", ctx, query)
+	return
+}
+
+// ListThreadAfterCounter returns a count of finished ChatServiceMock.ListThread invocations
+func (mmListThread *ChatServiceMock) ListThreadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListThread.afterListThreadCounter)
+}
+
+// ListThreadBeforeCounter returns a count of ChatServiceMock.ListThread invocations
+func (mmListThread *ChatServiceMock) ListThreadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListThread.beforeListThreadCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListThread.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListThread *mChatServiceMockListThread) Calls() []*ChatServiceMockListThreadParams {
+	mmListThread.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListThreadParams, len(mmListThread.callArgs))
+	copy(argCopy, mmListThread.callArgs)
+
+	mmListThread.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListThreadDone returns true if the count of the ListThread invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListThreadDone() bool {
+	if m.ListThreadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListThreadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListThreadMock.invocationsDone()
+}
+
+// MinimockListThreadInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListThreadInspect() {
+	for _, e := range m.ListThreadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListThread with params: %#v", *e.params)
+		}
+	}
+
+	afterListThreadCounter := mm_atomic.LoadUint64(&m.afterListThreadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListThreadMock.defaultExpectation != nil && afterListThreadCounter < 1 {
+		if m.ListThreadMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.ListThread")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListThread with params: %#v", *m.ListThreadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListThread != nil && afterListThreadCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.ListThread")
+	}
+
+	if !m.ListThreadMock.invocationsDone() && afterListThreadCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListThread but found %d calls",
+			mm_atomic.LoadUint64(&m.ListThreadMock.expectedInvocations), afterListThreadCounter)
+	}
+}
+
+type mChatServiceMockMarkRead struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockMarkReadExpectation
+	expectations       []*ChatServiceMockMarkReadExpectation
+
+	callArgs []*ChatServiceMockMarkReadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockMarkReadExpectation specifies expectation struct of the ChatService.MarkRead
+type ChatServiceMockMarkReadExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockMarkReadParams
+	paramPtrs *ChatServiceMockMarkReadParamPtrs
+	results   *ChatServiceMockMarkReadResults
+	Counter   uint64
+}
+
+// ChatServiceMockMarkReadParams contains parameters of the ChatService.MarkRead
+type ChatServiceMockMarkReadParams struct {
+	ctx     context.Context
+	chatID  int64
+	upToSeq int64
+}
+
+// ChatServiceMockMarkReadParamPtrs contains pointers to parameters of the ChatService.MarkRead
+type ChatServiceMockMarkReadParamPtrs struct {
+	ctx     *context.Context
+	chatID  *int64
+	upToSeq *int64
+}
+
+// ChatServiceMockMarkReadResults contains results of the ChatService.MarkRead
+type ChatServiceMockMarkReadResults struct {
+	rp1 *model.ReadMark
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkRead *mChatServiceMockMarkRead) Optional() *mChatServiceMockMarkRead {
+	mmMarkRead.optional = true
+	return mmMarkRead
+}
+
+// Expect sets up expected params for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) Expect(ctx context.Context, chatID int64, upToSeq int64) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by ExpectParams functions")
+	}
+
+	mmMarkRead.defaultExpectation.params = &ChatServiceMockMarkReadParams{ctx, chatID, upToSeq}
+	for _, e := range mmMarkRead.expectations {
+		if minimock.Equal(e.params, mmMarkRead.defaultExpectation.params) {
+			mmMarkRead.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkRead.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkRead
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) ExpectCtxParam1(ctx context.Context) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatServiceMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmMarkRead
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) ExpectChatIDParam2(chatID int64) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatServiceMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmMarkRead
+}
+
+// ExpectUpToSeqParam3 sets up expected param upToSeq for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) ExpectUpToSeqParam3(upToSeq int64) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatServiceMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.upToSeq = &upToSeq
+
+	return mmMarkRead
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) Inspect(f func(ctx context.Context, chatID int64, upToSeq int64)) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.inspectFuncMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.MarkRead")
+	}
+
+	mmMarkRead.mock.inspectFuncMarkRead = f
+
+	return mmMarkRead
+}
+
+// Return sets up results that will be returned by ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) Return(rp1 *model.ReadMark, err error) *ChatServiceMock {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{mock: mmMarkRead.mock}
+	}
+	mmMarkRead.defaultExpectation.results = &ChatServiceMockMarkReadResults{rp1, err}
+	return mmMarkRead.mock
+}
+
+// Set uses given function f to mock the ChatService.MarkRead method
+func (mmMarkRead *mChatServiceMockMarkRead) Set(f func(ctx context.Context, chatID int64, upToSeq int64) (rp1 *model.ReadMark, err error)) *ChatServiceMock {
+	if mmMarkRead.defaultExpectation != nil {
+		mmMarkRead.mock.t.Fatalf("Default expectation is already set for the ChatService.MarkRead method")
+	}
+
+	if len(mmMarkRead.expectations) > 0 {
+		mmMarkRead.mock.t.Fatalf("Some expectations are already set for the ChatService.MarkRead method")
+	}
+
+	mmMarkRead.mock.funcMarkRead = f
+	return mmMarkRead.mock
+}
+
+// When sets expectation for the ChatService.MarkRead which will trigger the result defined by the following
+// Then helper
+func (mmMarkRead *mChatServiceMockMarkRead) When(ctx context.Context, chatID int64, upToSeq int64) *ChatServiceMockMarkReadExpectation {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockMarkReadExpectation{
+		mock:   mmMarkRead.mock,
+		params: &ChatServiceMockMarkReadParams{ctx, chatID, upToSeq},
+	}
+	mmMarkRead.expectations = append(mmMarkRead.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.MarkRead return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockMarkReadExpectation) Then(rp1 *model.ReadMark, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockMarkReadResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.MarkRead should be invoked
+func (mmMarkRead *mChatServiceMockMarkRead) Times(n uint64) *mChatServiceMockMarkRead {
+	if n == 0 {
+		mmMarkRead.mock.t.Fatalf("Times of ChatServiceMock.MarkRead mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkRead.expectedInvocations, n)
+	return mmMarkRead
+}
+
+func (mmMarkRead *mChatServiceMockMarkRead) invocationsDone() bool {
+	if len(mmMarkRead.expectations) == 0 && mmMarkRead.defaultExpectation == nil && mmMarkRead.mock.funcMarkRead == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkRead.mock.afterMarkReadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkRead.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkRead implements service.ChatService
+func (mmMarkRead *ChatServiceMock) MarkRead(ctx context.Context, chatID int64, upToSeq int64) (rp1 *model.ReadMark, err error) {
+	mm_atomic.AddUint64(&mmMarkRead.beforeMarkReadCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkRead.afterMarkReadCounter, 1)
+
+	if mmMarkRead.inspectFuncMarkRead != nil {
+		mmMarkRead.inspectFuncMarkRead(ctx, chatID, upToSeq)
+	}
+
+	mm_params := ChatServiceMockMarkReadParams{ctx, chatID, upToSeq}
+
+	// Record call args
+	mmMarkRead.MarkReadMock.mutex.Lock()
+	mmMarkRead.MarkReadMock.callArgs = append(mmMarkRead.MarkReadMock.callArgs, &mm_params)
+	mmMarkRead.MarkReadMock.mutex.Unlock()
+
+	for _, e := range mmMarkRead.MarkReadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmMarkRead.MarkReadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkRead.MarkReadMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkRead.MarkReadMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkRead.MarkReadMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockMarkReadParams{ctx, chatID, upToSeq}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.upToSeq != nil && !minimock.Equal(*mm_want_ptrs.upToSeq, mm_got.upToSeq) {
+				mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameter upToSeq, want: %#v, got: %#v%s\n", *mm_want_ptrs.upToSeq, mm_got.upToSeq, minimock.Diff(*mm_want_ptrs.upToSeq, mm_got.upToSeq))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkRead.MarkReadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkRead.t.Fatal("No results are set for the ChatServiceMock.MarkRead")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmMarkRead.funcMarkRead != nil {
+		return mmMarkRead.funcMarkRead(ctx, chatID, upToSeq)
+	}
+	mmMarkRead.t.Fatalf("Unexpected call to ChatServiceMock.MarkRead. %v %v %v", ctx, chatID, upToSeq)
+	return
+}
+
+// MarkReadAfterCounter returns a count of finished ChatServiceMock.MarkRead invocations
+func (mmMarkRead *ChatServiceMock) MarkReadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRead.afterMarkReadCounter)
+}
+
+// MarkReadBeforeCounter returns a count of ChatServiceMock.MarkRead invocations
+func (mmMarkRead *ChatServiceMock) MarkReadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRead.beforeMarkReadCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.MarkRead.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkRead *mChatServiceMockMarkRead) Calls() []*ChatServiceMockMarkReadParams {
+	mmMarkRead.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockMarkReadParams, len(mmMarkRead.callArgs))
+	copy(argCopy, mmMarkRead.callArgs)
+
+	mmMarkRead.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkReadDone returns true if the count of the MarkRead invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockMarkReadDone() bool {
+	if m.MarkReadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkReadMock.invocationsDone()
+}
+
+// MinimockMarkReadInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockMarkReadInspect() {
+	for _, e := range m.MarkReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.MarkRead with params: %#v", *e.params)
+		}
+	}
+
+	afterMarkReadCounter := mm_atomic.LoadUint64(&m.afterMarkReadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkReadMock.defaultExpectation != nil && afterMarkReadCounter < 1 {
+		if m.MarkReadMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.MarkRead")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.MarkRead with params: %#v", *m.MarkReadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkRead != nil && afterMarkReadCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.MarkRead")
+	}
+
+	if !m.MarkReadMock.invocationsDone() && afterMarkReadCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.MarkRead but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkReadMock.expectedInvocations), afterMarkReadCounter)
+	}
+}
+
+type mChatServiceMockOpenAttachment struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockOpenAttachmentExpectation
+	expectations       []*ChatServiceMockOpenAttachmentExpectation
+
+	callArgs []*ChatServiceMockOpenAttachmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockOpenAttachmentExpectation specifies expectation struct of the ChatService.OpenAttachment
+type ChatServiceMockOpenAttachmentExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockOpenAttachmentParams
+	paramPtrs *ChatServiceMockOpenAttachmentParamPtrs
+	results   *ChatServiceMockOpenAttachmentResults
+	Counter   uint64
+}
+
+// ChatServiceMockOpenAttachmentParams contains parameters of the ChatService.OpenAttachment
+type ChatServiceMockOpenAttachmentParams struct {
+	ctx          context.Context
+	attachmentID string
+}
+
+// ChatServiceMockOpenAttachmentParamPtrs contains pointers to parameters of the ChatService.OpenAttachment
+type ChatServiceMockOpenAttachmentParamPtrs struct {
+	ctx          *context.Context
+	attachmentID *string
+}
+
+// ChatServiceMockOpenAttachmentResults contains results of the ChatService.OpenAttachment
+type ChatServiceMockOpenAttachmentResults struct {
+	ap1 *model.Attachment
+	r2  io.ReadCloser
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) Optional() *mChatServiceMockOpenAttachment {
+	mmOpenAttachment.optional = true
+	return mmOpenAttachment
+}
+
+// Expect sets up expected params for ChatService.OpenAttachment
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) Expect(ctx context.Context, attachmentID string) *mChatServiceMockOpenAttachment {
+	if mmOpenAttachment.mock.funcOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by Set")
+	}
+
+	if mmOpenAttachment.defaultExpectation == nil {
+		mmOpenAttachment.defaultExpectation = &ChatServiceMockOpenAttachmentExpectation{}
+	}
+
+	if mmOpenAttachment.defaultExpectation.paramPtrs != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by ExpectParams functions")
+	}
+
+	mmOpenAttachment.defaultExpectation.params = &ChatServiceMockOpenAttachmentParams{ctx, attachmentID}
+	for _, e := range mmOpenAttachment.expectations {
+		if minimock.Equal(e.params, mmOpenAttachment.defaultExpectation.params) {
+			mmOpenAttachment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmOpenAttachment.defaultExpectation.params)
+		}
+	}
+
+	return mmOpenAttachment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.OpenAttachment
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) ExpectCtxParam1(ctx context.Context) *mChatServiceMockOpenAttachment {
+	if mmOpenAttachment.mock.funcOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by Set")
+	}
+
+	if mmOpenAttachment.defaultExpectation == nil {
+		mmOpenAttachment.defaultExpectation = &ChatServiceMockOpenAttachmentExpectation{}
+	}
+
+	if mmOpenAttachment.defaultExpectation.params != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by Expect")
+	}
+
+	if mmOpenAttachment.defaultExpectation.paramPtrs == nil {
+		mmOpenAttachment.defaultExpectation.paramPtrs = &ChatServiceMockOpenAttachmentParamPtrs{}
+	}
+	mmOpenAttachment.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmOpenAttachment
+}
+
+// ExpectAttachmentIDParam2 sets up expected param attachmentID for ChatService.OpenAttachment
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) ExpectAttachmentIDParam2(attachmentID string) *mChatServiceMockOpenAttachment {
+	if mmOpenAttachment.mock.funcOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by Set")
+	}
+
+	if mmOpenAttachment.defaultExpectation == nil {
+		mmOpenAttachment.defaultExpectation = &ChatServiceMockOpenAttachmentExpectation{}
+	}
+
+	if mmOpenAttachment.defaultExpectation.params != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by Expect")
+	}
+
+	if mmOpenAttachment.defaultExpectation.paramPtrs == nil {
+		mmOpenAttachment.defaultExpectation.paramPtrs = &ChatServiceMockOpenAttachmentParamPtrs{}
+	}
+	mmOpenAttachment.defaultExpectation.paramPtrs.attachmentID = &attachmentID
+
+	return mmOpenAttachment
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.OpenAttachment
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) Inspect(f func(ctx context.Context, attachmentID string)) *mChatServiceMockOpenAttachment {
+	if mmOpenAttachment.mock.inspectFuncOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.OpenAttachment")
+	}
+
+	mmOpenAttachment.mock.inspectFuncOpenAttachment = f
+
+	return mmOpenAttachment
+}
+
+// Return sets up results that will be returned by ChatService.OpenAttachment
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) Return(ap1 *model.Attachment, r2 io.ReadCloser, err error) *ChatServiceMock {
+	if mmOpenAttachment.mock.funcOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by Set")
+	}
+
+	if mmOpenAttachment.defaultExpectation == nil {
+		mmOpenAttachment.defaultExpectation = &ChatServiceMockOpenAttachmentExpectation{mock: mmOpenAttachment.mock}
+	}
+	mmOpenAttachment.defaultExpectation.results = &ChatServiceMockOpenAttachmentResults{ap1, r2, err}
+	return mmOpenAttachment.mock
+}
+
+// Set uses given function f to mock the ChatService.OpenAttachment method
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) Set(f func(ctx context.Context, attachmentID string) (ap1 *model.Attachment, r2 io.ReadCloser, err error)) *ChatServiceMock {
+	if mmOpenAttachment.defaultExpectation != nil {
+		mmOpenAttachment.mock.t.Fatalf("Default expectation is already set for the ChatService.OpenAttachment method")
+	}
+
+	if len(mmOpenAttachment.expectations) > 0 {
+		mmOpenAttachment.mock.t.Fatalf("Some expectations are already set for the ChatService.OpenAttachment method")
+	}
+
+	mmOpenAttachment.mock.funcOpenAttachment = f
+	return mmOpenAttachment.mock
+}
+
+// When sets expectation for the ChatService.OpenAttachment which will trigger the result defined by the following
+// Then helper
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) When(ctx context.Context, attachmentID string) *ChatServiceMockOpenAttachmentExpectation {
+	if mmOpenAttachment.mock.funcOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockOpenAttachmentExpectation{
+		mock:   mmOpenAttachment.mock,
+		params: &ChatServiceMockOpenAttachmentParams{ctx, attachmentID},
+	}
+	mmOpenAttachment.expectations = append(mmOpenAttachment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.OpenAttachment return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockOpenAttachmentExpectation) Then(ap1 *model.Attachment, r2 io.ReadCloser, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockOpenAttachmentResults{ap1, r2, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.OpenAttachment should be invoked
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) Times(n uint64) *mChatServiceMockOpenAttachment {
+	if n == 0 {
+		mmOpenAttachment.mock.t.Fatalf("Times of ChatServiceMock.OpenAttachment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmOpenAttachment.expectedInvocations, n)
+	return mmOpenAttachment
+}
+
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) invocationsDone() bool {
+	if len(mmOpenAttachment.expectations) == 0 && mmOpenAttachment.defaultExpectation == nil && mmOpenAttachment.mock.funcOpenAttachment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmOpenAttachment.mock.afterOpenAttachmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmOpenAttachment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// OpenAttachment implements service.ChatService
+func (mmOpenAttachment *ChatServiceMock) OpenAttachment(ctx context.Context, attachmentID string) (ap1 *model.Attachment, r2 io.ReadCloser, err error) {
+	mm_atomic.AddUint64(&mmOpenAttachment.beforeOpenAttachmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmOpenAttachment.afterOpenAttachmentCounter, 1)
+
+	if mmOpenAttachment.inspectFuncOpenAttachment != nil {
+		mmOpenAttachment.inspectFuncOpenAttachment(ctx, attachmentID)
+	}
+
+	mm_params := ChatServiceMockOpenAttachmentParams{ctx, attachmentID}
+
+	// Record call args
+	mmOpenAttachment.OpenAttachmentMock.mutex.Lock()
+	mmOpenAttachment.OpenAttachmentMock.callArgs = append(mmOpenAttachment.OpenAttachmentMock.callArgs, &mm_params)
+	mmOpenAttachment.OpenAttachmentMock.mutex.Unlock()
+
+	for _, e := range mmOpenAttachment.OpenAttachmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.r2, e.results.err
+		}
+	}
+
+	if mmOpenAttachment.OpenAttachmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmOpenAttachment.OpenAttachmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmOpenAttachment.OpenAttachmentMock.defaultExpectation.params
+		mm_want_ptrs := mmOpenAttachment.OpenAttachmentMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockOpenAttachmentParams{ctx, attachmentID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmOpenAttachment.t.Errorf("ChatServiceMock.OpenAttachment got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.attachmentID != nil && !minimock.Equal(*mm_want_ptrs.attachmentID, mm_got.attachmentID) {
+				mmOpenAttachment.t.Errorf("ChatServiceMock.OpenAttachment got unexpected parameter attachmentID, want: %#v, got: %#v%s\n", *mm_want_ptrs.attachmentID, mm_got.attachmentID, minimock.Diff(*mm_want_ptrs.attachmentID, mm_got.attachmentID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmOpenAttachment.t.Errorf("ChatServiceMock.OpenAttachment got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmOpenAttachment.OpenAttachmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmOpenAttachment.t.Fatal("No results are set for the ChatServiceMock.OpenAttachment")
+		}
+		return (*mm_results).ap1, (*mm_results).r2, (*mm_results).err
+	}
+	if mmOpenAttachment.funcOpenAttachment != nil {
+		return mmOpenAttachment.funcOpenAttachment(ctx, attachmentID)
+	}
+	mmOpenAttachment.t.Fatalf("Unexpected call to ChatServiceMock.OpenAttachment. %v %v", ctx, attachmentID)
+	return
+}
+
+// OpenAttachmentAfterCounter returns a count of finished ChatServiceMock.OpenAttachment invocations
+func (mmOpenAttachment *ChatServiceMock) OpenAttachmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpenAttachment.afterOpenAttachmentCounter)
+}
+
+// OpenAttachmentBeforeCounter returns a count of ChatServiceMock.OpenAttachment invocations
+func (mmOpenAttachment *ChatServiceMock) OpenAttachmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpenAttachment.beforeOpenAttachmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.OpenAttachment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) Calls() []*ChatServiceMockOpenAttachmentParams {
+	mmOpenAttachment.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockOpenAttachmentParams, len(mmOpenAttachment.callArgs))
+	copy(argCopy, mmOpenAttachment.callArgs)
+
+	mmOpenAttachment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockOpenAttachmentDone returns true if the count of the OpenAttachment invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockOpenAttachmentDone() bool {
+	if m.OpenAttachmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.OpenAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.OpenAttachmentMock.invocationsDone()
+}
+
+// MinimockOpenAttachmentInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockOpenAttachmentInspect() {
+	for _, e := range m.OpenAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.OpenAttachment with params: %#v", *e.params)
+		}
+	}
+
+	afterOpenAttachmentCounter := mm_atomic.LoadUint64(&m.afterOpenAttachmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OpenAttachmentMock.defaultExpectation != nil && afterOpenAttachmentCounter < 1 {
+		if m.OpenAttachmentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.OpenAttachment")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.OpenAttachment with params: %#v", *m.OpenAttachmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOpenAttachment != nil && afterOpenAttachmentCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.OpenAttachment")
+	}
+
+	if !m.OpenAttachmentMock.invocationsDone() && afterOpenAttachmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.OpenAttachment but found %d calls",
+			mm_atomic.LoadUint64(&m.OpenAttachmentMock.expectedInvocations), afterOpenAttachmentCounter)
+	}
+}
+
+type mChatServiceMockPinMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockPinMessageExpectation
+	expectations       []*ChatServiceMockPinMessageExpectation
+
+	callArgs []*ChatServiceMockPinMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockPinMessageExpectation specifies expectation struct of the ChatService.PinMessage
+type ChatServiceMockPinMessageExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockPinMessageParams
+	paramPtrs *ChatServiceMockPinMessageParamPtrs
+	results   *ChatServiceMockPinMessageResults
+	Counter   uint64
+}
+
+// ChatServiceMockPinMessageParams contains parameters of the ChatService.PinMessage
+type ChatServiceMockPinMessageParams struct {
+	ctx       context.Context
+	chatID    int64
+	messageID string
+}
+
+// ChatServiceMockPinMessageParamPtrs contains pointers to parameters of the ChatService.PinMessage
+type ChatServiceMockPinMessageParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	messageID *string
+}
+
+// ChatServiceMockPinMessageResults contains results of the ChatService.PinMessage
+type ChatServiceMockPinMessageResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPinMessage *mChatServiceMockPinMessage) Optional() *mChatServiceMockPinMessage {
+	mmPinMessage.optional = true
+	return mmPinMessage
+}
+
+// Expect sets up expected params for ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) Expect(ctx context.Context, chatID int64, messageID string) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by ExpectParams functions")
+	}
+
+	mmPinMessage.defaultExpectation.params = &ChatServiceMockPinMessageParams{ctx, chatID, messageID}
+	for _, e := range mmPinMessage.expectations {
+		if minimock.Equal(e.params, mmPinMessage.defaultExpectation.params) {
+			mmPinMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPinMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmPinMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &ChatServiceMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmPinMessage
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) ExpectChatIDParam2(chatID int64) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &ChatServiceMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmPinMessage
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) ExpectMessageIDParam3(messageID string) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &ChatServiceMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.messageID = &messageID
+
+	return mmPinMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) Inspect(f func(ctx context.Context, chatID int64, messageID string)) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.inspectFuncPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.PinMessage")
+	}
+
+	mmPinMessage.mock.inspectFuncPinMessage = f
+
+	return mmPinMessage
+}
+
+// Return sets up results that will be returned by ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) Return(err error) *ChatServiceMock {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{mock: mmPinMessage.mock}
+	}
+	mmPinMessage.defaultExpectation.results = &ChatServiceMockPinMessageResults{err}
+	return mmPinMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.PinMessage method
+func (mmPinMessage *mChatServiceMockPinMessage) Set(f func(ctx context.Context, chatID int64, messageID string) (err error)) *ChatServiceMock {
+	if mmPinMessage.defaultExpectation != nil {
+		mmPinMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.PinMessage method")
+	}
+
+	if len(mmPinMessage.expectations) > 0 {
+		mmPinMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.PinMessage method")
+	}
+
+	mmPinMessage.mock.funcPinMessage = f
+	return mmPinMessage.mock
+}
+
+// When sets expectation for the ChatService.PinMessage which will trigger the result defined by the following
+// Then helper
+func (mmPinMessage *mChatServiceMockPinMessage) When(ctx context.Context, chatID int64, messageID string) *ChatServiceMockPinMessageExpectation {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockPinMessageExpectation{
+		mock:   mmPinMessage.mock,
+		params: &ChatServiceMockPinMessageParams{ctx, chatID, messageID},
+	}
+	mmPinMessage.expectations = append(mmPinMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.PinMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockPinMessageExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockPinMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.PinMessage should be invoked
+func (mmPinMessage *mChatServiceMockPinMessage) Times(n uint64) *mChatServiceMockPinMessage {
+	if n == 0 {
+		mmPinMessage.mock.t.Fatalf("Times of ChatServiceMock.PinMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPinMessage.expectedInvocations, n)
+	return mmPinMessage
+}
+
+func (mmPinMessage *mChatServiceMockPinMessage) invocationsDone() bool {
+	if len(mmPinMessage.expectations) == 0 && mmPinMessage.defaultExpectation == nil && mmPinMessage.mock.funcPinMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPinMessage.mock.afterPinMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPinMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PinMessage implements service.ChatService
+func (mmPinMessage *ChatServiceMock) PinMessage(ctx context.Context, chatID int64, messageID string) (err error) {
+	mm_atomic.AddUint64(&mmPinMessage.beforePinMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmPinMessage.afterPinMessageCounter, 1)
+
+	if mmPinMessage.inspectFuncPinMessage != nil {
+		mmPinMessage.inspectFuncPinMessage(ctx, chatID, messageID)
+	}
+
+	mm_params := ChatServiceMockPinMessageParams{ctx, chatID, messageID}
+
+	// Record call args
+	mmPinMessage.PinMessageMock.mutex.Lock()
+	mmPinMessage.PinMessageMock.callArgs = append(mmPinMessage.PinMessageMock.callArgs, &mm_params)
+	mmPinMessage.PinMessageMock.mutex.Unlock()
+
+	for _, e := range mmPinMessage.PinMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPinMessage.PinMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPinMessage.PinMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmPinMessage.PinMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmPinMessage.PinMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockPinMessageParams{ctx, chatID, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPinMessage.t.Errorf("ChatServiceMock.PinMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmPinMessage.t.Errorf("ChatServiceMock.PinMessage got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmPinMessage.t.Errorf("ChatServiceMock.PinMessage got unexpected parameter messageID, want: %#v, got: %#v%s\n", *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPinMessage.t.Errorf("ChatServiceMock.PinMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPinMessage.PinMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPinMessage.t.Fatal("No results are set for the ChatServiceMock.PinMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmPinMessage.funcPinMessage != nil {
+		return mmPinMessage.funcPinMessage(ctx, chatID, messageID)
+	}
+	mmPinMessage.t.Fatalf("Unexpected call to ChatServiceMock.PinMessage. %v %v %v", ctx, chatID, messageID)
+	return
+}
+
+// PinMessageAfterCounter returns a count of finished ChatServiceMock.PinMessage invocations
+func (mmPinMessage *ChatServiceMock) PinMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPinMessage.afterPinMessageCounter)
+}
+
+// PinMessageBeforeCounter returns a count of ChatServiceMock.PinMessage invocations
+func (mmPinMessage *ChatServiceMock) PinMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPinMessage.beforePinMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.PinMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPinMessage *mChatServiceMockPinMessage) Calls() []*ChatServiceMockPinMessageParams {
+	mmPinMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockPinMessageParams, len(mmPinMessage.callArgs))
+	copy(argCopy, mmPinMessage.callArgs)
+
+	mmPinMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPinMessageDone returns true if the count of the PinMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockPinMessageDone() bool {
+	if m.PinMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PinMessageMock.invocationsDone()
+}
+
+// MinimockPinMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockPinMessageInspect() {
+	for _, e := range m.PinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.PinMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterPinMessageCounter := mm_atomic.LoadUint64(&m.afterPinMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PinMessageMock.defaultExpectation != nil && afterPinMessageCounter < 1 {
+		if m.PinMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.PinMessage")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.PinMessage with params: %#v", *m.PinMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPinMessage != nil && afterPinMessageCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.PinMessage")
+	}
+
+	if !m.PinMessageMock.invocationsDone() && afterPinMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.PinMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.PinMessageMock.expectedInvocations), afterPinMessageCounter)
+	}
+}
+
+type mChatServiceMockPublishMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockPublishMessageExpectation
+	expectations       []*ChatServiceMockPublishMessageExpectation
+
+	callArgs []*ChatServiceMockPublishMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockPublishMessageExpectation specifies expectation struct of the ChatService.PublishMessage
+type ChatServiceMockPublishMessageExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockPublishMessageParams
+	paramPtrs *ChatServiceMockPublishMessageParamPtrs
+
+	Counter uint64
+}
+
+// ChatServiceMockPublishMessageParams contains parameters of the ChatService.PublishMessage
+type ChatServiceMockPublishMessageParams struct {
+	message *model.Message
+}
+
+// ChatServiceMockPublishMessageParamPtrs contains pointers to parameters of the ChatService.PublishMessage
+type ChatServiceMockPublishMessageParamPtrs struct {
+	message **model.Message
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPublishMessage *mChatServiceMockPublishMessage) Optional() *mChatServiceMockPublishMessage {
+	mmPublishMessage.optional = true
+	return mmPublishMessage
+}
+
+// Expect sets up expected params for ChatService.PublishMessage
+func (mmPublishMessage *mChatServiceMockPublishMessage) Expect(message *model.Message) *mChatServiceMockPublishMessage {
+	if mmPublishMessage.mock.funcPublishMessage != nil {
+		mmPublishMessage.mock.t.Fatalf("ChatServiceMock.PublishMessage mock is already set by Set")
+	}
+
+	if mmPublishMessage.defaultExpectation == nil {
+		mmPublishMessage.defaultExpectation = &ChatServiceMockPublishMessageExpectation{}
+	}
+
+	if mmPublishMessage.defaultExpectation.paramPtrs != nil {
+		mmPublishMessage.mock.t.Fatalf("ChatServiceMock.PublishMessage mock is already set by ExpectParams functions")
+	}
+
+	mmPublishMessage.defaultExpectation.params = &ChatServiceMockPublishMessageParams{message}
+	for _, e := range mmPublishMessage.expectations {
+		if minimock.Equal(e.params, mmPublishMessage.defaultExpectation.params) {
+			mmPublishMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPublishMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmPublishMessage
+}
+
+// ExpectMessageParam1 sets up expected param message for ChatService.PublishMessage
+func (mmPublishMessage *mChatServiceMockPublishMessage) ExpectMessageParam1(message *model.Message) *mChatServiceMockPublishMessage {
+	if mmPublishMessage.mock.funcPublishMessage != nil {
+		mmPublishMessage.mock.t.Fatalf("ChatServiceMock.PublishMessage mock is already set by Set")
+	}
+
+	if mmPublishMessage.defaultExpectation == nil {
+		mmPublishMessage.defaultExpectation = &ChatServiceMockPublishMessageExpectation{}
+	}
+
+	if mmPublishMessage.defaultExpectation.params != nil {
+		mmPublishMessage.mock.t.Fatalf("ChatServiceMock.PublishMessage mock is already set by Expect")
+	}
+
+	if mmPublishMessage.defaultExpectation.paramPtrs == nil {
+		mmPublishMessage.defaultExpectation.paramPtrs = &ChatServiceMockPublishMessageParamPtrs{}
+	}
+	mmPublishMessage.defaultExpectation.paramPtrs.message = &message
+
+	return mmPublishMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.PublishMessage
+func (mmPublishMessage *mChatServiceMockPublishMessage) Inspect(f func(message *model.Message)) *mChatServiceMockPublishMessage {
+	if mmPublishMessage.mock.inspectFuncPublishMessage != nil {
+		mmPublishMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.PublishMessage")
+	}
+
+	mmPublishMessage.mock.inspectFuncPublishMessage = f
+
+	return mmPublishMessage
+}
+
+// Return sets up results that will be returned by ChatService.PublishMessage
+func (mmPublishMessage *mChatServiceMockPublishMessage) Return() *ChatServiceMock {
+	if mmPublishMessage.mock.funcPublishMessage != nil {
+		mmPublishMessage.mock.t.Fatalf("ChatServiceMock.PublishMessage mock is already set by Set")
+	}
+
+	if mmPublishMessage.defaultExpectation == nil {
+		mmPublishMessage.defaultExpectation = &ChatServiceMockPublishMessageExpectation{mock: mmPublishMessage.mock}
+	}
+
+	return mmPublishMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.PublishMessage method
+func (mmPublishMessage *mChatServiceMockPublishMessage) Set(f func(message *model.Message)) *ChatServiceMock {
+	if mmPublishMessage.defaultExpectation != nil {
+		mmPublishMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.PublishMessage method")
+	}
+
+	if len(mmPublishMessage.expectations) > 0 {
+		mmPublishMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.PublishMessage method")
+	}
+
+	mmPublishMessage.mock.funcPublishMessage = f
+	return mmPublishMessage.mock
+}
+
+// Times sets number of times ChatService.PublishMessage should be invoked
+func (mmPublishMessage *mChatServiceMockPublishMessage) Times(n uint64) *mChatServiceMockPublishMessage {
+	if n == 0 {
+		mmPublishMessage.mock.t.Fatalf("Times of ChatServiceMock.PublishMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPublishMessage.expectedInvocations, n)
+	return mmPublishMessage
+}
+
+func (mmPublishMessage *mChatServiceMockPublishMessage) invocationsDone() bool {
+	if len(mmPublishMessage.expectations) == 0 && mmPublishMessage.defaultExpectation == nil && mmPublishMessage.mock.funcPublishMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPublishMessage.mock.afterPublishMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPublishMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PublishMessage implements service.ChatService
+func (mmPublishMessage *ChatServiceMock) PublishMessage(message *model.Message) {
+	mm_atomic.AddUint64(&mmPublishMessage.beforePublishMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmPublishMessage.afterPublishMessageCounter, 1)
+
+	if mmPublishMessage.inspectFuncPublishMessage != nil {
+		mmPublishMessage.inspectFuncPublishMessage(message)
+	}
+
+	mm_params := ChatServiceMockPublishMessageParams{message}
+
+	// Record call args
+	mmPublishMessage.PublishMessageMock.mutex.Lock()
+	mmPublishMessage.PublishMessageMock.callArgs = append(mmPublishMessage.PublishMessageMock.callArgs, &mm_params)
+	mmPublishMessage.PublishMessageMock.mutex.Unlock()
+
+	for _, e := range mmPublishMessage.PublishMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmPublishMessage.PublishMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPublishMessage.PublishMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmPublishMessage.PublishMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmPublishMessage.PublishMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockPublishMessageParams{message}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.message != nil && !minimock.Equal(*mm_want_ptrs.message, mm_got.message) {
+				mmPublishMessage.t.Errorf("ChatServiceMock.PublishMessage got unexpected parameter message, want: %#v, got: %#v%s\n", *mm_want_ptrs.message, mm_got.message, minimock.Diff(*mm_want_ptrs.message, mm_got.message))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPublishMessage.t.Errorf("ChatServiceMock.PublishMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmPublishMessage.funcPublishMessage != nil {
+		mmPublishMessage.funcPublishMessage(message)
+		return
+	}
+	mmPublishMessage.t.Fatalf("Unexpected call to ChatServiceMock.PublishMessage. %v", message)
+
+}
+
+// PublishMessageAfterCounter returns a count of finished ChatServiceMock.PublishMessage invocations
+func (mmPublishMessage *ChatServiceMock) PublishMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublishMessage.afterPublishMessageCounter)
+}
+
+// PublishMessageBeforeCounter returns a count of ChatServiceMock.PublishMessage invocations
+func (mmPublishMessage *ChatServiceMock) PublishMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublishMessage.beforePublishMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.PublishMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPublishMessage *mChatServiceMockPublishMessage) Calls() []*ChatServiceMockPublishMessageParams {
+	mmPublishMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockPublishMessageParams, len(mmPublishMessage.callArgs))
+	copy(argCopy, mmPublishMessage.callArgs)
+
+	mmPublishMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPublishMessageDone returns true if the count of the PublishMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockPublishMessageDone() bool {
+	if m.PublishMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PublishMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PublishMessageMock.invocationsDone()
+}
+
+// MinimockPublishMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockPublishMessageInspect() {
+	for _, e := range m.PublishMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.PublishMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterPublishMessageCounter := mm_atomic.LoadUint64(&m.afterPublishMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PublishMessageMock.defaultExpectation != nil && afterPublishMessageCounter < 1 {
+		if m.PublishMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.PublishMessage")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.PublishMessage with params: %#v", *m.PublishMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPublishMessage != nil && afterPublishMessageCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.PublishMessage")
+	}
+
+	if !m.PublishMessageMock.invocationsDone() && afterPublishMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.PublishMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.PublishMessageMock.expectedInvocations), afterPublishMessageCounter)
+	}
+}
+
+type mChatServiceMockPurgeExpiredMessages struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockPurgeExpiredMessagesExpectation
+	expectations       []*ChatServiceMockPurgeExpiredMessagesExpectation
+
+	callArgs []*ChatServiceMockPurgeExpiredMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockPurgeExpiredMessagesExpectation specifies expectation struct of the ChatService.PurgeExpiredMessages
+type ChatServiceMockPurgeExpiredMessagesExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockPurgeExpiredMessagesParams
+	paramPtrs *ChatServiceMockPurgeExpiredMessagesParamPtrs
+	results   *ChatServiceMockPurgeExpiredMessagesResults
+	Counter   uint64
+}
+
+// ChatServiceMockPurgeExpiredMessagesParams contains parameters of the ChatService.PurgeExpiredMessages
+type ChatServiceMockPurgeExpiredMessagesParams struct {
+	ctx   context.Context
+	limit uint64
+}
+
+// ChatServiceMockPurgeExpiredMessagesParamPtrs contains pointers to parameters of the ChatService.PurgeExpiredMessages
+type ChatServiceMockPurgeExpiredMessagesParamPtrs struct {
+	ctx   *context.Context
+	limit *uint64
+}
+
+// ChatServiceMockPurgeExpiredMessagesResults contains results of the ChatService.PurgeExpiredMessages
+type ChatServiceMockPurgeExpiredMessagesResults struct {
+	i1  int
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeExpiredMessages *mChatServiceMockPurgeExpiredMessages) Optional() *mChatServiceMockPurgeExpiredMessages {
+	mmPurgeExpiredMessages.optional = true
+	return mmPurgeExpiredMessages
+}
+
+// Expect sets up expected params for ChatService.PurgeExpiredMessages
+func (mmPurgeExpiredMessages *mChatServiceMockPurgeExpiredMessages) Expect(ctx context.Context, limit uint64) *mChatServiceMockPurgeExpiredMessages {
+	if mmPurgeExpiredMessages.mock.funcPurgeExpiredMessages != nil {
+		mmPurgeExpiredMessages.mock.t.Fatalf("ChatServiceMock.PurgeExpiredMessages mock is already set by Set")
+	}
+
+	if mmPurgeExpiredMessages.defaultExpectation == nil {
+		mmPurgeExpiredMessages.defaultExpectation = &ChatServiceMockPurgeExpiredMessagesExpectation{}
+	}
+
+	if mmPurgeExpiredMessages.defaultExpectation.paramPtrs != nil {
+		mmPurgeExpiredMessages.mock.t.Fatalf("ChatServiceMock.PurgeExpiredMessages mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeExpiredMessages.defaultExpectation.params = &ChatServiceMockPurgeExpiredMessagesParams{ctx, limit}
+	for _, e := range mmPurgeExpiredMessages.expectations {
+		if minimock.Equal(e.params, mmPurgeExpiredMessages.defaultExpectation.params) {
+			mmPurgeExpiredMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeExpiredMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeExpiredMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.PurgeExpiredMessages
+func (mmPurgeExpiredMessages *mChatServiceMockPurgeExpiredMessages) ExpectCtxParam1(ctx context.Context) *mChatServiceMockPurgeExpiredMessages {
+	if mmPurgeExpiredMessages.mock.funcPurgeExpiredMessages != nil {
+		mmPurgeExpiredMessages.mock.t.Fatalf("ChatServiceMock.PurgeExpiredMessages mock is already set by Set")
+	}
+
+	if mmPurgeExpiredMessages.defaultExpectation == nil {
+		mmPurgeExpiredMessages.defaultExpectation = &ChatServiceMockPurgeExpiredMessagesExpectation{}
+	}
+
+	if mmPurgeExpiredMessages.defaultExpectation.params != nil {
+		mmPurgeExpiredMessages.mock.t.Fatalf("ChatServiceMock.PurgeExpiredMessages mock is already set by Expect")
+	}
+
+	if mmPurgeExpiredMessages.defaultExpectation.paramPtrs == nil {
+		mmPurgeExpiredMessages.defaultExpectation.paramPtrs = &ChatServiceMockPurgeExpiredMessagesParamPtrs{}
+	}
+	mmPurgeExpiredMessages.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmPurgeExpiredMessages
+}
+
+// ExpectLimitParam2 sets up expected param limit for ChatService.PurgeExpiredMessages
+func (mmPurgeExpiredMessages *mChatServiceMockPurgeExpiredMessages) ExpectLimitParam2(limit uint64) *mChatServiceMockPurgeExpiredMessages {
+	if mmPurgeExpiredMessages.mock.funcPurgeExpiredMessages != nil {
+		mmPurgeExpiredMessages.mock.t.Fatalf("ChatServiceMock.PurgeExpiredMessages mock is already set by Set")
+	}
+
+	if mmPurgeExpiredMessages.defaultExpectation == nil {
+		mmPurgeExpiredMessages.defaultExpectation = &ChatServiceMockPurgeExpiredMessagesExpectation{}
+	}
+
+	if mmPurgeExpiredMessages.defaultExpectation.params != nil {
+		mmPurgeExpiredMessages.mock.t.Fatalf("ChatServiceMock.PurgeExpiredMessages mock is already set by Expect")
+	}
+
+	if mmPurgeExpiredMessages.defaultExpectation.paramPtrs == nil {
+		mmPurgeExpiredMessages.defaultExpectation.paramPtrs = &ChatServiceMockPurgeExpiredMessagesParamPtrs{}
+	}
+	mmPurgeExpiredMessages.defaultExpectation.paramPtrs.limit = &limit
+
+	return mmPurgeExpiredMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.PurgeExpiredMessages
+func (mmPurgeExpiredMessages *mChatServiceMockPurgeExpiredMessages) Inspect(f func(ctx context.Context, limit uint64)) *mChatServiceMockPurgeExpiredMessages {
+	if mmPurgeExpiredMessages.mock.inspectFuncPurgeExpiredMessages != nil {
+		mmPurgeExpiredMessages.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.PurgeExpiredMessages")
+	}
+
+	mmPurgeExpiredMessages.mock.inspectFuncPurgeExpiredMessages = f
+
+	return mmPurgeExpiredMessages
+}
+
+// Return sets up results that will be returned by ChatService.PurgeExpiredMessages
+func (mmPurgeExpiredMessages *mChatServiceMockPurgeExpiredMessages) Return(i1 int, err error) *ChatServiceMock {
+	if mmPurgeExpiredMessages.mock.funcPurgeExpiredMessages != nil {
+		mmPurgeExpiredMessages.mock.t.Fatalf("ChatServiceMock.PurgeExpiredMessages mock is already set by Set")
+	}
+
+	if mmPurgeExpiredMessages.defaultExpectation == nil {
+		mmPurgeExpiredMessages.defaultExpectation = &ChatServiceMockPurgeExpiredMessagesExpectation{mock: mmPurgeExpiredMessages.mock}
+	}
+	mmPurgeExpiredMessages.defaultExpectation.results = &ChatServiceMockPurgeExpiredMessagesResults{i1, err}
+	return mmPurgeExpiredMessages.mock
+}
+
+// Set uses given function f to mock the ChatService.PurgeExpiredMessages method
+func (mmPurgeExpiredMessages *mChatServiceMockPurgeExpiredMessages) Set(f func(ctx context.Context, limit uint64) (i1 int, err error)) *ChatServiceMock {
+	if mmPurgeExpiredMessages.defaultExpectation != nil {
+		mmPurgeExpiredMessages.mock.t.Fatalf("Default expectation is already set for the ChatService.PurgeExpiredMessages method")
+	}
+
+	if len(mmPurgeExpiredMessages.expectations) > 0 {
+		mmPurgeExpiredMessages.mock.t.Fatalf("Some expectations are already set for the ChatService.PurgeExpiredMessages method")
+	}
+
+	mmPurgeExpiredMessages.mock.funcPurgeExpiredMessages = f
+	return mmPurgeExpiredMessages.mock
+}
+
+// When sets expectation for the ChatService.PurgeExpiredMessages which will trigger the result defined by the following
+// Then helper
+func (mmPurgeExpiredMessages *mChatServiceMockPurgeExpiredMessages) When(ctx context.Context, limit uint64) *ChatServiceMockPurgeExpiredMessagesExpectation {
+	if mmPurgeExpiredMessages.mock.funcPurgeExpiredMessages != nil {
+		mmPurgeExpiredMessages.mock.t.Fatalf("ChatServiceMock.PurgeExpiredMessages mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockPurgeExpiredMessagesExpectation{
+		mock:   mmPurgeExpiredMessages.mock,
+		params: &ChatServiceMockPurgeExpiredMessagesParams{ctx, limit},
+	}
+	mmPurgeExpiredMessages.expectations = append(mmPurgeExpiredMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.PurgeExpiredMessages return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockPurgeExpiredMessagesExpectation) Then(i1 int, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockPurgeExpiredMessagesResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.PurgeExpiredMessages should be invoked
+func (mmPurgeExpiredMessages *mChatServiceMockPurgeExpiredMessages) Times(n uint64) *mChatServiceMockPurgeExpiredMessages {
+	if n == 0 {
+		mmPurgeExpiredMessages.mock.t.Fatalf("Times of ChatServiceMock.PurgeExpiredMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeExpiredMessages.expectedInvocations, n)
+	return mmPurgeExpiredMessages
+}
+
+func (mmPurgeExpiredMessages *mChatServiceMockPurgeExpiredMessages) invocationsDone() bool {
+	if len(mmPurgeExpiredMessages.expectations) == 0 && mmPurgeExpiredMessages.defaultExpectation == nil && mmPurgeExpiredMessages.mock.funcPurgeExpiredMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeExpiredMessages.mock.afterPurgeExpiredMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeExpiredMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeExpiredMessages implements service.ChatService
+func (mmPurgeExpiredMessages *ChatServiceMock) PurgeExpiredMessages(ctx context.Context, limit uint64) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmPurgeExpiredMessages.beforePurgeExpiredMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeExpiredMessages.afterPurgeExpiredMessagesCounter, 1)
+
+	if mmPurgeExpiredMessages.inspectFuncPurgeExpiredMessages != nil {
+		mmPurgeExpiredMessages.inspectFuncPurgeExpiredMessages(ctx, limit)
+	}
+
+	mm_params := ChatServiceMockPurgeExpiredMessagesParams{ctx, limit}
+
+	// Record call args
+	mmPurgeExpiredMessages.PurgeExpiredMessagesMock.mutex.Lock()
+	mmPurgeExpiredMessages.PurgeExpiredMessagesMock.callArgs = append(mmPurgeExpiredMessages.PurgeExpiredMessagesMock.callArgs, &mm_params)
+	mmPurgeExpiredMessages.PurgeExpiredMessagesMock.mutex.Unlock()
+
+	for _, e := range mmPurgeExpiredMessages.PurgeExpiredMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeExpiredMessages.PurgeExpiredMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeExpiredMessages.PurgeExpiredMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeExpiredMessages.PurgeExpiredMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeExpiredMessages.PurgeExpiredMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockPurgeExpiredMessagesParams{ctx, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeExpiredMessages.t.Errorf("ChatServiceMock.PurgeExpiredMessages got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmPurgeExpiredMessages.t.Errorf("ChatServiceMock.PurgeExpiredMessages got unexpected parameter limit, want: %#v, got: %#v%s\n", *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeExpiredMessages.t.Errorf("ChatServiceMock.PurgeExpiredMessages got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeExpiredMessages.PurgeExpiredMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeExpiredMessages.t.Fatal("No results are set for the ChatServiceMock.PurgeExpiredMessages")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeExpiredMessages.funcPurgeExpiredMessages != nil {
+		return mmPurgeExpiredMessages.funcPurgeExpiredMessages(ctx, limit)
+	}
+	mmPurgeExpiredMessages.t.Fatalf("Unexpected call to ChatServiceMock.PurgeExpiredMessages. %v %v", ctx, limit)
+	return
+}
+
+// PurgeExpiredMessagesAfterCounter returns a count of finished ChatServiceMock.PurgeExpiredMessages invocations
+func (mmPurgeExpiredMessages *ChatServiceMock) PurgeExpiredMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeExpiredMessages.afterPurgeExpiredMessagesCounter)
+}
+
+// PurgeExpiredMessagesBeforeCounter returns a count of ChatServiceMock.PurgeExpiredMessages invocations
+func (mmPurgeExpiredMessages *ChatServiceMock) PurgeExpiredMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeExpiredMessages.beforePurgeExpiredMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.PurgeExpiredMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeExpiredMessages *mChatServiceMockPurgeExpiredMessages) Calls() []*ChatServiceMockPurgeExpiredMessagesParams {
+	mmPurgeExpiredMessages.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockPurgeExpiredMessagesParams, len(mmPurgeExpiredMessages.callArgs))
+	copy(argCopy, mmPurgeExpiredMessages.callArgs)
+
+	mmPurgeExpiredMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeExpiredMessagesDone returns true if the count of the PurgeExpiredMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockPurgeExpiredMessagesDone() bool {
+	if m.PurgeExpiredMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeExpiredMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeExpiredMessagesMock.invocationsDone()
+}
+
+// MinimockPurgeExpiredMessagesInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockPurgeExpiredMessagesInspect() {
+	for _, e := range m.PurgeExpiredMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.PurgeExpiredMessages with params: %#v", *e.params)
+		}
+	}
+
+	afterPurgeExpiredMessagesCounter := mm_atomic.LoadUint64(&m.afterPurgeExpiredMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeExpiredMessagesMock.defaultExpectation != nil && afterPurgeExpiredMessagesCounter < 1 {
+		if m.PurgeExpiredMessagesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.PurgeExpiredMessages")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.PurgeExpiredMessages with params: %#v", *m.PurgeExpiredMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeExpiredMessages != nil && afterPurgeExpiredMessagesCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.PurgeExpiredMessages")
+	}
+
+	if !m.PurgeExpiredMessagesMock.invocationsDone() && afterPurgeExpiredMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.PurgeExpiredMessages but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeExpiredMessagesMock.expectedInvocations), afterPurgeExpiredMessagesCounter)
+	}
+}
+
+type mChatServiceMockPurgeStaleUploads struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockPurgeStaleUploadsExpectation
+	expectations       []*ChatServiceMockPurgeStaleUploadsExpectation
+
+	callArgs []*ChatServiceMockPurgeStaleUploadsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockPurgeStaleUploadsExpectation specifies expectation struct of the ChatService.PurgeStaleUploads
+type ChatServiceMockPurgeStaleUploadsExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockPurgeStaleUploadsParams
+	paramPtrs *ChatServiceMockPurgeStaleUploadsParamPtrs
+	results   *ChatServiceMockPurgeStaleUploadsResults
+	Counter   uint64
+}
+
+// ChatServiceMockPurgeStaleUploadsParams contains parameters of the ChatService.PurgeStaleUploads
+type ChatServiceMockPurgeStaleUploadsParams struct {
+	ctx            context.Context
+	uploadedBefore time.Time
+	limit          uint64
+}
+
+// ChatServiceMockPurgeStaleUploadsParamPtrs contains pointers to parameters of the ChatService.PurgeStaleUploads
+type ChatServiceMockPurgeStaleUploadsParamPtrs struct {
+	ctx            *context.Context
+	uploadedBefore *time.Time
+	limit          *uint64
+}
+
+// ChatServiceMockPurgeStaleUploadsResults contains results of the ChatService.PurgeStaleUploads
+type ChatServiceMockPurgeStaleUploadsResults struct {
+	i1  int
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeStaleUploads *mChatServiceMockPurgeStaleUploads) Optional() *mChatServiceMockPurgeStaleUploads {
+	mmPurgeStaleUploads.optional = true
+	return mmPurgeStaleUploads
+}
+
+// Expect sets up expected params for ChatService.PurgeStaleUploads
+func (mmPurgeStaleUploads *mChatServiceMockPurgeStaleUploads) Expect(ctx context.Context, uploadedBefore time.Time, limit uint64) *mChatServiceMockPurgeStaleUploads {
+	if mmPurgeStaleUploads.mock.funcPurgeStaleUploads != nil {
+		mmPurgeStaleUploads.mock.t.Fatalf("ChatServiceMock.PurgeStaleUploads mock is already set by Set")
+	}
+
+	if mmPurgeStaleUploads.defaultExpectation == nil {
+		mmPurgeStaleUploads.defaultExpectation = &ChatServiceMockPurgeStaleUploadsExpectation{}
+	}
+
+	if mmPurgeStaleUploads.defaultExpectation.paramPtrs != nil {
+		mmPurgeStaleUploads.mock.t.Fatalf("ChatServiceMock.PurgeStaleUploads mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeStaleUploads.defaultExpectation.params = &ChatServiceMockPurgeStaleUploadsParams{ctx, uploadedBefore, limit}
+	for _, e := range mmPurgeStaleUploads.expectations {
+		if minimock.Equal(e.params, mmPurgeStaleUploads.defaultExpectation.params) {
+			mmPurgeStaleUploads.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeStaleUploads.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeStaleUploads
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.PurgeStaleUploads
+func (mmPurgeStaleUploads *mChatServiceMockPurgeStaleUploads) ExpectCtxParam1(ctx context.Context) *mChatServiceMockPurgeStaleUploads {
+	if mmPurgeStaleUploads.mock.funcPurgeStaleUploads != nil {
+		mmPurgeStaleUploads.mock.t.Fatalf("ChatServiceMock.PurgeStaleUploads mock is already set by Set")
+	}
+
+	if mmPurgeStaleUploads.defaultExpectation == nil {
+		mmPurgeStaleUploads.defaultExpectation = &ChatServiceMockPurgeStaleUploadsExpectation{}
+	}
+
+	if mmPurgeStaleUploads.defaultExpectation.params != nil {
+		mmPurgeStaleUploads.mock.t.Fatalf("ChatServiceMock.PurgeStaleUploads mock is already set by Expect")
+	}
+
+	if mmPurgeStaleUploads.defaultExpectation.paramPtrs == nil {
+		mmPurgeStaleUploads.defaultExpectation.paramPtrs = &ChatServiceMockPurgeStaleUploadsParamPtrs{}
+	}
+	mmPurgeStaleUploads.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmPurgeStaleUploads
+}
+
+// ExpectUploadedBeforeParam2 sets up expected param uploadedBefore for ChatService.PurgeStaleUploads
+func (mmPurgeStaleUploads *mChatServiceMockPurgeStaleUploads) ExpectUploadedBeforeParam2(uploadedBefore time.Time) *mChatServiceMockPurgeStaleUploads {
+	if mmPurgeStaleUploads.mock.funcPurgeStaleUploads != nil {
+		mmPurgeStaleUploads.mock.t.Fatalf("ChatServiceMock.PurgeStaleUploads mock is already set by Set")
+	}
+
+	if mmPurgeStaleUploads.defaultExpectation == nil {
+		mmPurgeStaleUploads.defaultExpectation = &ChatServiceMockPurgeStaleUploadsExpectation{}
+	}
+
+	if mmPurgeStaleUploads.defaultExpectation.params != nil {
+		mmPurgeStaleUploads.mock.t.Fatalf("ChatServiceMock.PurgeStaleUploads mock is already set by Expect")
+	}
+
+	if mmPurgeStaleUploads.defaultExpectation.paramPtrs == nil {
+		mmPurgeStaleUploads.defaultExpectation.paramPtrs = &ChatServiceMockPurgeStaleUploadsParamPtrs{}
+	}
+	mmPurgeStaleUploads.defaultExpectation.paramPtrs.uploadedBefore = &uploadedBefore
+
+	return mmPurgeStaleUploads
+}
+
+// ExpectLimitParam3 sets up expected param limit for ChatService.PurgeStaleUploads
+func (mmPurgeStaleUploads *mChatServiceMockPurgeStaleUploads) ExpectLimitParam3(limit uint64) *mChatServiceMockPurgeStaleUploads {
+	if mmPurgeStaleUploads.mock.funcPurgeStaleUploads != nil {
+		mmPurgeStaleUploads.mock.t.Fatalf("ChatServiceMock.PurgeStaleUploads mock is already set by Set")
+	}
+
+	if mmPurgeStaleUploads.defaultExpectation == nil {
+		mmPurgeStaleUploads.defaultExpectation = &ChatServiceMockPurgeStaleUploadsExpectation{}
+	}
+
+	if mmPurgeStaleUploads.defaultExpectation.params != nil {
+		mmPurgeStaleUploads.mock.t.Fatalf("ChatServiceMock.PurgeStaleUploads mock is already set by Expect")
+	}
+
+	if mmPurgeStaleUploads.defaultExpectation.paramPtrs == nil {
+		mmPurgeStaleUploads.defaultExpectation.paramPtrs = &ChatServiceMockPurgeStaleUploadsParamPtrs{}
+	}
+	mmPurgeStaleUploads.defaultExpectation.paramPtrs.limit = &limit
+
+	return mmPurgeStaleUploads
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.PurgeStaleUploads
+func (mmPurgeStaleUploads *mChatServiceMockPurgeStaleUploads) Inspect(f func(ctx context.Context, uploadedBefore time.Time, limit uint64)) *mChatServiceMockPurgeStaleUploads {
+	if mmPurgeStaleUploads.mock.inspectFuncPurgeStaleUploads != nil {
+		mmPurgeStaleUploads.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.PurgeStaleUploads")
+	}
+
+	mmPurgeStaleUploads.mock.inspectFuncPurgeStaleUploads = f
+
+	return mmPurgeStaleUploads
+}
+
+// Return sets up results that will be returned by ChatService.PurgeStaleUploads
+func (mmPurgeStaleUploads *mChatServiceMockPurgeStaleUploads) Return(i1 int, err error) *ChatServiceMock {
+	if mmPurgeStaleUploads.mock.funcPurgeStaleUploads != nil {
+		mmPurgeStaleUploads.mock.t.Fatalf("ChatServiceMock.PurgeStaleUploads mock is already set by Set")
+	}
+
+	if mmPurgeStaleUploads.defaultExpectation == nil {
+		mmPurgeStaleUploads.defaultExpectation = &ChatServiceMockPurgeStaleUploadsExpectation{mock: mmPurgeStaleUploads.mock}
+	}
+	mmPurgeStaleUploads.defaultExpectation.results = &ChatServiceMockPurgeStaleUploadsResults{i1, err}
+	return mmPurgeStaleUploads.mock
+}
+
+// Set uses given function f to mock the ChatService.PurgeStaleUploads method
+func (mmPurgeStaleUploads *mChatServiceMockPurgeStaleUploads) Set(f func(ctx context.Context, uploadedBefore time.Time, limit uint64) (i1 int, err error)) *ChatServiceMock {
+	if mmPurgeStaleUploads.defaultExpectation != nil {
+		mmPurgeStaleUploads.mock.t.Fatalf("Default expectation is already set for the ChatService.PurgeStaleUploads method")
+	}
+
+	if len(mmPurgeStaleUploads.expectations) > 0 {
+		mmPurgeStaleUploads.mock.t.Fatalf("Some expectations are already set for the ChatService.PurgeStaleUploads method")
+	}
+
+	mmPurgeStaleUploads.mock.funcPurgeStaleUploads = f
+	return mmPurgeStaleUploads.mock
+}
+
+// When sets expectation for the ChatService.PurgeStaleUploads which will trigger the result defined by the following
+// Then helper
+func (mmPurgeStaleUploads *mChatServiceMockPurgeStaleUploads) When(ctx context.Context, uploadedBefore time.Time, limit uint64) *ChatServiceMockPurgeStaleUploadsExpectation {
+	if mmPurgeStaleUploads.mock.funcPurgeStaleUploads != nil {
+		mmPurgeStaleUploads.mock.t.Fatalf("ChatServiceMock.PurgeStaleUploads mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockPurgeStaleUploadsExpectation{
+		mock:   mmPurgeStaleUploads.mock,
+		params: &ChatServiceMockPurgeStaleUploadsParams{ctx, uploadedBefore, limit},
+	}
+	mmPurgeStaleUploads.expectations = append(mmPurgeStaleUploads.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.PurgeStaleUploads return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockPurgeStaleUploadsExpectation) Then(i1 int, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockPurgeStaleUploadsResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.PurgeStaleUploads should be invoked
+func (mmPurgeStaleUploads *mChatServiceMockPurgeStaleUploads) Times(n uint64) *mChatServiceMockPurgeStaleUploads {
+	if n == 0 {
+		mmPurgeStaleUploads.mock.t.Fatalf("Times of ChatServiceMock.PurgeStaleUploads mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeStaleUploads.expectedInvocations, n)
+	return mmPurgeStaleUploads
+}
+
+func (mmPurgeStaleUploads *mChatServiceMockPurgeStaleUploads) invocationsDone() bool {
+	if len(mmPurgeStaleUploads.expectations) == 0 && mmPurgeStaleUploads.defaultExpectation == nil && mmPurgeStaleUploads.mock.funcPurgeStaleUploads == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeStaleUploads.mock.afterPurgeStaleUploadsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeStaleUploads.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeStaleUploads implements service.ChatService
+func (mmPurgeStaleUploads *ChatServiceMock) PurgeStaleUploads(ctx context.Context, uploadedBefore time.Time, limit uint64) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmPurgeStaleUploads.beforePurgeStaleUploadsCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeStaleUploads.afterPurgeStaleUploadsCounter, 1)
+
+	if mmPurgeStaleUploads.inspectFuncPurgeStaleUploads != nil {
+		mmPurgeStaleUploads.inspectFuncPurgeStaleUploads(ctx, uploadedBefore, limit)
+	}
+
+	mm_params := ChatServiceMockPurgeStaleUploadsParams{ctx, uploadedBefore, limit}
+
+	// Record call args
+	mmPurgeStaleUploads.PurgeStaleUploadsMock.mutex.Lock()
+	mmPurgeStaleUploads.PurgeStaleUploadsMock.callArgs = append(mmPurgeStaleUploads.PurgeStaleUploadsMock.callArgs, &mm_params)
+	mmPurgeStaleUploads.PurgeStaleUploadsMock.mutex.Unlock()
+
+	for _, e := range mmPurgeStaleUploads.PurgeStaleUploadsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeStaleUploads.PurgeStaleUploadsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeStaleUploads.PurgeStaleUploadsMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeStaleUploads.PurgeStaleUploadsMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeStaleUploads.PurgeStaleUploadsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockPurgeStaleUploadsParams{ctx, uploadedBefore, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeStaleUploads.t.Errorf("ChatServiceMock.PurgeStaleUploads got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.uploadedBefore != nil && !minimock.Equal(*mm_want_ptrs.uploadedBefore, mm_got.uploadedBefore) {
+				mmPurgeStaleUploads.t.Errorf("ChatServiceMock.PurgeStaleUploads got unexpected parameter uploadedBefore, want: %#v, got: %#v%s\n", *mm_want_ptrs.uploadedBefore, mm_got.uploadedBefore, minimock.Diff(*mm_want_ptrs.uploadedBefore, mm_got.uploadedBefore))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmPurgeStaleUploads.t.Errorf("ChatServiceMock.PurgeStaleUploads got unexpected parameter limit, want: %#v, got: %#v%s\n", *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeStaleUploads.t.Errorf("ChatServiceMock.PurgeStaleUploads got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeStaleUploads.PurgeStaleUploadsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeStaleUploads.t.Fatal("No results are set for the ChatServiceMock.PurgeStaleUploads")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeStaleUploads.funcPurgeStaleUploads != nil {
+		return mmPurgeStaleUploads.funcPurgeStaleUploads(ctx, uploadedBefore, limit)
+	}
+	mmPurgeStaleUploads.t.Fatalf("Unexpected call to ChatServiceMock.PurgeStaleUploads. %v %v %v", ctx, uploadedBefore, limit)
+	return
+}
+
+// PurgeStaleUploadsAfterCounter returns a count of finished ChatServiceMock.PurgeStaleUploads invocations
+func (mmPurgeStaleUploads *ChatServiceMock) PurgeStaleUploadsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeStaleUploads.afterPurgeStaleUploadsCounter)
+}
+
+// PurgeStaleUploadsBeforeCounter returns a count of ChatServiceMock.PurgeStaleUploads invocations
+func (mmPurgeStaleUploads *ChatServiceMock) PurgeStaleUploadsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeStaleUploads.beforePurgeStaleUploadsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.PurgeStaleUploads.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeStaleUploads *mChatServiceMockPurgeStaleUploads) Calls() []*ChatServiceMockPurgeStaleUploadsParams {
+	mmPurgeStaleUploads.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockPurgeStaleUploadsParams, len(mmPurgeStaleUploads.callArgs))
+	copy(argCopy, mmPurgeStaleUploads.callArgs)
+
+	mmPurgeStaleUploads.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeStaleUploadsDone returns true if the count of the PurgeStaleUploads invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockPurgeStaleUploadsDone() bool {
+	if m.PurgeStaleUploadsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeStaleUploadsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeStaleUploadsMock.invocationsDone()
+}
+
+// MinimockPurgeStaleUploadsInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockPurgeStaleUploadsInspect() {
+	for _, e := range m.PurgeStaleUploadsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.PurgeStaleUploads with params: %#v", *e.params)
+		}
+	}
+
+	afterPurgeStaleUploadsCounter := mm_atomic.LoadUint64(&m.afterPurgeStaleUploadsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeStaleUploadsMock.defaultExpectation != nil && afterPurgeStaleUploadsCounter < 1 {
+		if m.PurgeStaleUploadsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.PurgeStaleUploads")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.PurgeStaleUploads with params: %#v", *m.PurgeStaleUploadsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeStaleUploads != nil && afterPurgeStaleUploadsCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.PurgeStaleUploads")
+	}
+
+	if !m.PurgeStaleUploadsMock.invocationsDone() && afterPurgeStaleUploadsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.PurgeStaleUploads but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeStaleUploadsMock.expectedInvocations), afterPurgeStaleUploadsCounter)
+	}
+}
+
+type mChatServiceMockRemoveMembers struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockRemoveMembersExpectation
+	expectations       []*ChatServiceMockRemoveMembersExpectation
+
+	callArgs []*ChatServiceMockRemoveMembersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockRemoveMembersExpectation specifies expectation struct of the ChatService.RemoveMembers
+type ChatServiceMockRemoveMembersExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockRemoveMembersParams
+	paramPtrs *ChatServiceMockRemoveMembersParamPtrs
+	results   *ChatServiceMockRemoveMembersResults
+	Counter   uint64
+}
+
+// ChatServiceMockRemoveMembersParams contains parameters of the ChatService.RemoveMembers
+type ChatServiceMockRemoveMembersParams struct {
+	ctx     context.Context
+	chatID  int64
+	userIDs []int64
+}
+
+// ChatServiceMockRemoveMembersParamPtrs contains pointers to parameters of the ChatService.RemoveMembers
+type ChatServiceMockRemoveMembersParamPtrs struct {
+	ctx     *context.Context
+	chatID  *int64
+	userIDs *[]int64
+}
+
+// ChatServiceMockRemoveMembersResults contains results of the ChatService.RemoveMembers
+type ChatServiceMockRemoveMembersResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) Optional() *mChatServiceMockRemoveMembers {
+	mmRemoveMembers.optional = true
+	return mmRemoveMembers
+}
+
+// Expect sets up expected params for ChatService.RemoveMembers
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) Expect(ctx context.Context, chatID int64, userIDs []int64) *mChatServiceMockRemoveMembers {
+	if mmRemoveMembers.mock.funcRemoveMembers != nil {
+		mmRemoveMembers.mock.t.Fatalf("ChatServiceMock.RemoveMembers mock is already set by Set")
+	}
+
+	if mmRemoveMembers.defaultExpectation == nil {
+		mmRemoveMembers.defaultExpectation = &ChatServiceMockRemoveMembersExpectation{}
+	}
+
+	if mmRemoveMembers.defaultExpectation.paramPtrs != nil {
+		mmRemoveMembers.mock.t.Fatalf("ChatServiceMock.RemoveMembers mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveMembers.defaultExpectation.params = &ChatServiceMockRemoveMembersParams{ctx, chatID, userIDs}
+	for _, e := range mmRemoveMembers.expectations {
+		if minimock.Equal(e.params, mmRemoveMembers.defaultExpectation.params) {
+			mmRemoveMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveMembers.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveMembers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.RemoveMembers
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) ExpectCtxParam1(ctx context.Context) *mChatServiceMockRemoveMembers {
+	if mmRemoveMembers.mock.funcRemoveMembers != nil {
+		mmRemoveMembers.mock.t.Fatalf("ChatServiceMock.RemoveMembers mock is already set by Set")
+	}
+
+	if mmRemoveMembers.defaultExpectation == nil {
+		mmRemoveMembers.defaultExpectation = &ChatServiceMockRemoveMembersExpectation{}
+	}
+
+	if mmRemoveMembers.defaultExpectation.params != nil {
+		mmRemoveMembers.mock.t.Fatalf("ChatServiceMock.RemoveMembers mock is already set by Expect")
+	}
+
+	if mmRemoveMembers.defaultExpectation.paramPtrs == nil {
+		mmRemoveMembers.defaultExpectation.paramPtrs = &ChatServiceMockRemoveMembersParamPtrs{}
+	}
+	mmRemoveMembers.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRemoveMembers
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.RemoveMembers
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) ExpectChatIDParam2(chatID int64) *mChatServiceMockRemoveMembers {
+	if mmRemoveMembers.mock.funcRemoveMembers != nil {
+		mmRemoveMembers.mock.t.Fatalf("ChatServiceMock.RemoveMembers mock is already set by Set")
+	}
+
+	if mmRemoveMembers.defaultExpectation == nil {
+		mmRemoveMembers.defaultExpectation = &ChatServiceMockRemoveMembersExpectation{}
+	}
+
+	if mmRemoveMembers.defaultExpectation.params != nil {
+		mmRemoveMembers.mock.t.Fatalf("ChatServiceMock.RemoveMembers mock is already set by Expect")
+	}
+
+	if mmRemoveMembers.defaultExpectation.paramPtrs == nil {
+		mmRemoveMembers.defaultExpectation.paramPtrs = &ChatServiceMockRemoveMembersParamPtrs{}
+	}
+	mmRemoveMembers.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmRemoveMembers
+}
+
+// ExpectUserIDsParam3 sets up expected param userIDs for ChatService.RemoveMembers
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) ExpectUserIDsParam3(userIDs []int64) *mChatServiceMockRemoveMembers {
+	if mmRemoveMembers.mock.funcRemoveMembers != nil {
+		mmRemoveMembers.mock.t.Fatalf("ChatServiceMock.RemoveMembers mock is already set by Set")
+	}
+
+	if mmRemoveMembers.defaultExpectation == nil {
+		mmRemoveMembers.defaultExpectation = &ChatServiceMockRemoveMembersExpectation{}
+	}
+
+	if mmRemoveMembers.defaultExpectation.params != nil {
+		mmRemoveMembers.mock.t.Fatalf("ChatServiceMock.RemoveMembers mock is already set by Expect")
+	}
+
+	if mmRemoveMembers.defaultExpectation.paramPtrs == nil {
+		mmRemoveMembers.defaultExpectation.paramPtrs = &ChatServiceMockRemoveMembersParamPtrs{}
+	}
+	mmRemoveMembers.defaultExpectation.paramPtrs.userIDs = &userIDs
+
+	return mmRemoveMembers
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.RemoveMembers
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) Inspect(f func(ctx context.Context, chatID int64, userIDs []int64)) *mChatServiceMockRemoveMembers {
+	if mmRemoveMembers.mock.inspectFuncRemoveMembers != nil {
+		mmRemoveMembers.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.RemoveMembers")
+	}
+
+	mmRemoveMembers.mock.inspectFuncRemoveMembers = f
+
+	return mmRemoveMembers
+}
+
+// Return sets up results that will be returned by ChatService.RemoveMembers
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) Return(err error) *ChatServiceMock {
+	if mmRemoveMembers.mock.funcRemoveMembers != nil {
+		mmRemoveMembers.mock.t.Fatalf("ChatServiceMock.RemoveMembers mock is already set by Set")
+	}
+
+	if mmRemoveMembers.defaultExpectation == nil {
+		mmRemoveMembers.defaultExpectation = &ChatServiceMockRemoveMembersExpectation{mock: mmRemoveMembers.mock}
+	}
+	mmRemoveMembers.defaultExpectation.results = &ChatServiceMockRemoveMembersResults{err}
+	return mmRemoveMembers.mock
+}
+
+// Set uses given function f to mock the ChatService.RemoveMembers method
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) Set(f func(ctx context.Context, chatID int64, userIDs []int64) (err error)) *ChatServiceMock {
+	if mmRemoveMembers.defaultExpectation != nil {
+		mmRemoveMembers.mock.t.Fatalf("Default expectation is already set for the ChatService.RemoveMembers method")
+	}
+
+	if len(mmRemoveMembers.expectations) > 0 {
+		mmRemoveMembers.mock.t.Fatalf("Some expectations are already set for the ChatService.RemoveMembers method")
+	}
+
+	mmRemoveMembers.mock.funcRemoveMembers = f
+	return mmRemoveMembers.mock
+}
+
+// When sets expectation for the ChatService.RemoveMembers which will trigger the result defined by the following
+// Then helper
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) When(ctx context.Context, chatID int64, userIDs []int64) *ChatServiceMockRemoveMembersExpectation {
+	if mmRemoveMembers.mock.funcRemoveMembers != nil {
+		mmRemoveMembers.mock.t.Fatalf("ChatServiceMock.RemoveMembers mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockRemoveMembersExpectation{
+		mock:   mmRemoveMembers.mock,
+		params: &ChatServiceMockRemoveMembersParams{ctx, chatID, userIDs},
+	}
+	mmRemoveMembers.expectations = append(mmRemoveMembers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.RemoveMembers return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockRemoveMembersExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockRemoveMembersResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.RemoveMembers should be invoked
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) Times(n uint64) *mChatServiceMockRemoveMembers {
+	if n == 0 {
+		mmRemoveMembers.mock.t.Fatalf("Times of ChatServiceMock.RemoveMembers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveMembers.expectedInvocations, n)
+	return mmRemoveMembers
+}
+
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) invocationsDone() bool {
+	if len(mmRemoveMembers.expectations) == 0 && mmRemoveMembers.defaultExpectation == nil && mmRemoveMembers.mock.funcRemoveMembers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveMembers.mock.afterRemoveMembersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveMembers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveMembers implements service.ChatService
+func (mmRemoveMembers *ChatServiceMock) RemoveMembers(ctx context.Context, chatID int64, userIDs []int64) (err error) {
+	mm_atomic.AddUint64(&mmRemoveMembers.beforeRemoveMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveMembers.afterRemoveMembersCounter, 1)
+
+	if mmRemoveMembers.inspectFuncRemoveMembers != nil {
+		mmRemoveMembers.inspectFuncRemoveMembers(ctx, chatID, userIDs)
+	}
+
+	mm_params := ChatServiceMockRemoveMembersParams{ctx, chatID, userIDs}
+
+	// Record call args
+	mmRemoveMembers.RemoveMembersMock.mutex.Lock()
+	mmRemoveMembers.RemoveMembersMock.callArgs = append(mmRemoveMembers.RemoveMembersMock.callArgs, &mm_params)
+	mmRemoveMembers.RemoveMembersMock.mutex.Unlock()
+
+	for _, e := range mmRemoveMembers.RemoveMembersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveMembers.RemoveMembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveMembers.RemoveMembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveMembers.RemoveMembersMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveMembers.RemoveMembersMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockRemoveMembersParams{ctx, chatID, userIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveMembers.t.Errorf("ChatServiceMock.RemoveMembers got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmRemoveMembers.t.Errorf("ChatServiceMock.RemoveMembers got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.userIDs != nil && !minimock.Equal(*mm_want_ptrs.userIDs, mm_got.userIDs) {
+				mmRemoveMembers.t.Errorf("ChatServiceMock.RemoveMembers got unexpected parameter userIDs, want: %#v, got: %#v%s\n", *mm_want_ptrs.userIDs, mm_got.userIDs, minimock.Diff(*mm_want_ptrs.userIDs, mm_got.userIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveMembers.t.Errorf("ChatServiceMock.RemoveMembers got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveMembers.RemoveMembersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveMembers.t.Fatal("No results are set for the ChatServiceMock.RemoveMembers")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveMembers.funcRemoveMembers != nil {
+		return mmRemoveMembers.funcRemoveMembers(ctx, chatID, userIDs)
+	}
+	mmRemoveMembers.t.Fatalf("Unexpected call to ChatServiceMock.RemoveMembers. %v %v %v", ctx, chatID, userIDs)
+	return
+}
+
+// RemoveMembersAfterCounter returns a count of finished ChatServiceMock.RemoveMembers invocations
+func (mmRemoveMembers *ChatServiceMock) RemoveMembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMembers.afterRemoveMembersCounter)
+}
+
+// RemoveMembersBeforeCounter returns a count of ChatServiceMock.RemoveMembers invocations
+func (mmRemoveMembers *ChatServiceMock) RemoveMembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMembers.beforeRemoveMembersCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.RemoveMembers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) Calls() []*ChatServiceMockRemoveMembersParams {
+	mmRemoveMembers.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockRemoveMembersParams, len(mmRemoveMembers.callArgs))
+	copy(argCopy, mmRemoveMembers.callArgs)
+
+	mmRemoveMembers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveMembersDone returns true if the count of the RemoveMembers invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockRemoveMembersDone() bool {
+	if m.RemoveMembersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveMembersMock.invocationsDone()
+}
+
+// MinimockRemoveMembersInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockRemoveMembersInspect() {
+	for _, e := range m.RemoveMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveMembers with params: %#v", *e.params)
+		}
+	}
+
+	afterRemoveMembersCounter := mm_atomic.LoadUint64(&m.afterRemoveMembersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveMembersMock.defaultExpectation != nil && afterRemoveMembersCounter < 1 {
+		if m.RemoveMembersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.RemoveMembers")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveMembers with params: %#v", *m.RemoveMembersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveMembers != nil && afterRemoveMembersCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.RemoveMembers")
+	}
+
+	if !m.RemoveMembersMock.invocationsDone() && afterRemoveMembersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.RemoveMembers but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveMembersMock.expectedInvocations), afterRemoveMembersCounter)
+	}
+}
+
+type mChatServiceMockRemoveReaction struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockRemoveReactionExpectation
+	expectations       []*ChatServiceMockRemoveReactionExpectation
+
+	callArgs []*ChatServiceMockRemoveReactionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockRemoveReactionExpectation specifies expectation struct of the ChatService.RemoveReaction
+type ChatServiceMockRemoveReactionExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockRemoveReactionParams
+	paramPtrs *ChatServiceMockRemoveReactionParamPtrs
+	results   *ChatServiceMockRemoveReactionResults
+	Counter   uint64
+}
+
+// ChatServiceMockRemoveReactionParams contains parameters of the ChatService.RemoveReaction
+type ChatServiceMockRemoveReactionParams struct {
+	ctx      context.Context
+	reaction *model.Reaction
+}
+
+// ChatServiceMockRemoveReactionParamPtrs contains pointers to parameters of the ChatService.RemoveReaction
+type ChatServiceMockRemoveReactionParamPtrs struct {
+	ctx      *context.Context
+	reaction **model.Reaction
+}
+
+// ChatServiceMockRemoveReactionResults contains results of the ChatService.RemoveReaction
+type ChatServiceMockRemoveReactionResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Optional() *mChatServiceMockRemoveReaction {
+	mmRemoveReaction.optional = true
+	return mmRemoveReaction
+}
+
+// Expect sets up expected params for ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Expect(ctx context.Context, reaction *model.Reaction) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveReaction.defaultExpectation.params = &ChatServiceMockRemoveReactionParams{ctx, reaction}
+	for _, e := range mmRemoveReaction.expectations {
+		if minimock.Equal(e.params, mmRemoveReaction.defaultExpectation.params) {
+			mmRemoveReaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveReaction.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveReaction
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) ExpectCtxParam1(ctx context.Context) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ChatServiceMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRemoveReaction
+}
+
+// ExpectReactionParam2 sets up expected param reaction for ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) ExpectReactionParam2(reaction *model.Reaction) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ChatServiceMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.reaction = &reaction
+
+	return mmRemoveReaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Inspect(f func(ctx context.Context, reaction *model.Reaction)) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.inspectFuncRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.RemoveReaction")
+	}
+
+	mmRemoveReaction.mock.inspectFuncRemoveReaction = f
+
+	return mmRemoveReaction
+}
+
+// Return sets up results that will be returned by ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Return(err error) *ChatServiceMock {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{mock: mmRemoveReaction.mock}
+	}
+	mmRemoveReaction.defaultExpectation.results = &ChatServiceMockRemoveReactionResults{err}
+	return mmRemoveReaction.mock
+}
+
+// Set uses given function f to mock the ChatService.RemoveReaction method
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Set(f func(ctx context.Context, reaction *model.Reaction) (err error)) *ChatServiceMock {
+	if mmRemoveReaction.defaultExpectation != nil {
+		mmRemoveReaction.mock.t.Fatalf("Default expectation is already set for the ChatService.RemoveReaction method")
+	}
+
+	if len(mmRemoveReaction.expectations) > 0 {
+		mmRemoveReaction.mock.t.Fatalf("Some expectations are already set for the ChatService.RemoveReaction method")
+	}
+
+	mmRemoveReaction.mock.funcRemoveReaction = f
+	return mmRemoveReaction.mock
+}
+
+// When sets expectation for the ChatService.RemoveReaction which will trigger the result defined by the following
+// Then helper
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) When(ctx context.Context, reaction *model.Reaction) *ChatServiceMockRemoveReactionExpectation {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockRemoveReactionExpectation{
+		mock:   mmRemoveReaction.mock,
+		params: &ChatServiceMockRemoveReactionParams{ctx, reaction},
+	}
+	mmRemoveReaction.expectations = append(mmRemoveReaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.RemoveReaction return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockRemoveReactionExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockRemoveReactionResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.RemoveReaction should be invoked
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Times(n uint64) *mChatServiceMockRemoveReaction {
+	if n == 0 {
+		mmRemoveReaction.mock.t.Fatalf("Times of ChatServiceMock.RemoveReaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveReaction.expectedInvocations, n)
+	return mmRemoveReaction
+}
+
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) invocationsDone() bool {
+	if len(mmRemoveReaction.expectations) == 0 && mmRemoveReaction.defaultExpectation == nil && mmRemoveReaction.mock.funcRemoveReaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveReaction.mock.afterRemoveReactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveReaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveReaction implements service.ChatService
+func (mmRemoveReaction *ChatServiceMock) RemoveReaction(ctx context.Context, reaction *model.Reaction) (err error) {
+	mm_atomic.AddUint64(&mmRemoveReaction.beforeRemoveReactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveReaction.afterRemoveReactionCounter, 1)
+
+	if mmRemoveReaction.inspectFuncRemoveReaction != nil {
+		mmRemoveReaction.inspectFuncRemoveReaction(ctx, reaction)
+	}
+
+	mm_params := ChatServiceMockRemoveReactionParams{ctx, reaction}
+
+	// Record call args
+	mmRemoveReaction.RemoveReactionMock.mutex.Lock()
+	mmRemoveReaction.RemoveReactionMock.callArgs = append(mmRemoveReaction.RemoveReactionMock.callArgs, &mm_params)
+	mmRemoveReaction.RemoveReactionMock.mutex.Unlock()
+
+	for _, e := range mmRemoveReaction.RemoveReactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveReaction.RemoveReactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveReaction.RemoveReactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveReaction.RemoveReactionMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveReaction.RemoveReactionMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockRemoveReactionParams{ctx, reaction}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveReaction.t.Errorf("ChatServiceMock.RemoveReaction got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.reaction != nil && !minimock.Equal(*mm_want_ptrs.reaction, mm_got.reaction) {
+				mmRemoveReaction.t.Errorf("ChatServiceMock.RemoveReaction got unexpected parameter reaction, want: %#v, got: %#v%s\n", *mm_want_ptrs.reaction, mm_got.reaction, minimock.Diff(*mm_want_ptrs.reaction, mm_got.reaction))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveReaction.t.Errorf("ChatServiceMock.RemoveReaction got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveReaction.RemoveReactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveReaction.t.Fatal("No results are set for the ChatServiceMock.RemoveReaction")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveReaction.funcRemoveReaction != nil {
+		return mmRemoveReaction.funcRemoveReaction(ctx, reaction)
+	}
+	mmRemoveReaction.t.Fatalf("Unexpected call to ChatServiceMock.RemoveReaction. %v %v", ctx, reaction)
+	return
+}
+
+// RemoveReactionAfterCounter returns a count of finished ChatServiceMock.RemoveReaction invocations
+func (mmRemoveReaction *ChatServiceMock) RemoveReactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveReaction.afterRemoveReactionCounter)
+}
+
+// RemoveReactionBeforeCounter returns a count of ChatServiceMock.RemoveReaction invocations
+func (mmRemoveReaction *ChatServiceMock) RemoveReactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveReaction.beforeRemoveReactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.RemoveReaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Calls() []*ChatServiceMockRemoveReactionParams {
+	mmRemoveReaction.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockRemoveReactionParams, len(mmRemoveReaction.callArgs))
+	copy(argCopy, mmRemoveReaction.callArgs)
+
+	mmRemoveReaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveReactionDone returns true if the count of the RemoveReaction invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockRemoveReactionDone() bool {
+	if m.RemoveReactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveReactionMock.invocationsDone()
+}
+
+// MinimockRemoveReactionInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockRemoveReactionInspect() {
+	for _, e := range m.RemoveReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveReaction with params: %#v", *e.params)
+		}
+	}
+
+	afterRemoveReactionCounter := mm_atomic.LoadUint64(&m.afterRemoveReactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveReactionMock.defaultExpectation != nil && afterRemoveReactionCounter < 1 {
+		if m.RemoveReactionMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.RemoveReaction")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveReaction with params: %#v", *m.RemoveReactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveReaction != nil && afterRemoveReactionCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.RemoveReaction")
+	}
+
+	if !m.RemoveReactionMock.invocationsDone() && afterRemoveReactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.RemoveReaction but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveReactionMock.expectedInvocations), afterRemoveReactionCounter)
+	}
+}
+
+type mChatServiceMockRenameChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockRenameChatExpectation
+	expectations       []*ChatServiceMockRenameChatExpectation
+
+	callArgs []*ChatServiceMockRenameChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockRenameChatExpectation specifies expectation struct of the ChatService.RenameChat
+type ChatServiceMockRenameChatExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockRenameChatParams
+	paramPtrs *ChatServiceMockRenameChatParamPtrs
+	results   *ChatServiceMockRenameChatResults
+	Counter   uint64
+}
+
+// ChatServiceMockRenameChatParams contains parameters of the ChatService.RenameChat
+type ChatServiceMockRenameChatParams struct {
+	ctx    context.Context
+	chatID int64
+	name   string
+}
+
+// ChatServiceMockRenameChatParamPtrs contains pointers to parameters of the ChatService.RenameChat
+type ChatServiceMockRenameChatParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+	name   *string
+}
+
+// ChatServiceMockRenameChatResults contains results of the ChatService.RenameChat
+type ChatServiceMockRenameChatResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRenameChat *mChatServiceMockRenameChat) Optional() *mChatServiceMockRenameChat {
+	mmRenameChat.optional = true
+	return mmRenameChat
+}
+
+// Expect sets up expected params for ChatService.RenameChat
+func (mmRenameChat *mChatServiceMockRenameChat) Expect(ctx context.Context, chatID int64, name string) *mChatServiceMockRenameChat {
+	if mmRenameChat.mock.funcRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Set")
+	}
+
+	if mmRenameChat.defaultExpectation == nil {
+		mmRenameChat.defaultExpectation = &ChatServiceMockRenameChatExpectation{}
+	}
+
+	if mmRenameChat.defaultExpectation.paramPtrs != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by ExpectParams functions")
+	}
+
+	mmRenameChat.defaultExpectation.params = &ChatServiceMockRenameChatParams{ctx, chatID, name}
+	for _, e := range mmRenameChat.expectations {
+		if minimock.Equal(e.params, mmRenameChat.defaultExpectation.params) {
+			mmRenameChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRenameChat.defaultExpectation.params)
+		}
+	}
+
+	return mmRenameChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.RenameChat
+func (mmRenameChat *mChatServiceMockRenameChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockRenameChat {
+	if mmRenameChat.mock.funcRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Set")
+	}
+
+	if mmRenameChat.defaultExpectation == nil {
+		mmRenameChat.defaultExpectation = &ChatServiceMockRenameChatExpectation{}
+	}
+
+	if mmRenameChat.defaultExpectation.params != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Expect")
+	}
+
+	if mmRenameChat.defaultExpectation.paramPtrs == nil {
+		mmRenameChat.defaultExpectation.paramPtrs = &ChatServiceMockRenameChatParamPtrs{}
+	}
+	mmRenameChat.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRenameChat
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.RenameChat
+func (mmRenameChat *mChatServiceMockRenameChat) ExpectChatIDParam2(chatID int64) *mChatServiceMockRenameChat {
+	if mmRenameChat.mock.funcRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Set")
+	}
+
+	if mmRenameChat.defaultExpectation == nil {
+		mmRenameChat.defaultExpectation = &ChatServiceMockRenameChatExpectation{}
+	}
+
+	if mmRenameChat.defaultExpectation.params != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Expect")
+	}
+
+	if mmRenameChat.defaultExpectation.paramPtrs == nil {
+		mmRenameChat.defaultExpectation.paramPtrs = &ChatServiceMockRenameChatParamPtrs{}
+	}
+	mmRenameChat.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmRenameChat
+}
+
+// ExpectNameParam3 sets up expected param name for ChatService.RenameChat
+func (mmRenameChat *mChatServiceMockRenameChat) ExpectNameParam3(name string) *mChatServiceMockRenameChat {
+	if mmRenameChat.mock.funcRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Set")
+	}
+
+	if mmRenameChat.defaultExpectation == nil {
+		mmRenameChat.defaultExpectation = &ChatServiceMockRenameChatExpectation{}
+	}
+
+	if mmRenameChat.defaultExpectation.params != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Expect")
+	}
+
+	if mmRenameChat.defaultExpectation.paramPtrs == nil {
+		mmRenameChat.defaultExpectation.paramPtrs = &ChatServiceMockRenameChatParamPtrs{}
+	}
+	mmRenameChat.defaultExpectation.paramPtrs.name = &name
+
+	return mmRenameChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.RenameChat
+func (mmRenameChat *mChatServiceMockRenameChat) Inspect(f func(ctx context.Context, chatID int64, name string)) *mChatServiceMockRenameChat {
+	if mmRenameChat.mock.inspectFuncRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.RenameChat")
+	}
+
+	mmRenameChat.mock.inspectFuncRenameChat = f
+
+	return mmRenameChat
+}
+
+// Return sets up results that will be returned by ChatService.RenameChat
+func (mmRenameChat *mChatServiceMockRenameChat) Return(err error) *ChatServiceMock {
+	if mmRenameChat.mock.funcRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Set")
+	}
+
+	if mmRenameChat.defaultExpectation == nil {
+		mmRenameChat.defaultExpectation = &ChatServiceMockRenameChatExpectation{mock: mmRenameChat.mock}
+	}
+	mmRenameChat.defaultExpectation.results = &ChatServiceMockRenameChatResults{err}
+	return mmRenameChat.mock
+}
+
+// Set uses given function f to mock the ChatService.RenameChat method
+func (mmRenameChat *mChatServiceMockRenameChat) Set(f func(ctx context.Context, chatID int64, name string) (err error)) *ChatServiceMock {
+	if mmRenameChat.defaultExpectation != nil {
+		mmRenameChat.mock.t.Fatalf("Default expectation is already set for the ChatService.RenameChat method")
+	}
+
+	if len(mmRenameChat.expectations) > 0 {
+		mmRenameChat.mock.t.Fatalf("Some expectations are already set for the ChatService.RenameChat method")
+	}
+
+	mmRenameChat.mock.funcRenameChat = f
+	return mmRenameChat.mock
+}
+
+// When sets expectation for the ChatService.RenameChat which will trigger the result defined by the following
+// Then helper
+func (mmRenameChat *mChatServiceMockRenameChat) When(ctx context.Context, chatID int64, name string) *ChatServiceMockRenameChatExpectation {
+	if mmRenameChat.mock.funcRenameChat != nil {
+		mmRenameChat.mock.t.Fatalf("ChatServiceMock.RenameChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockRenameChatExpectation{
+		mock:   mmRenameChat.mock,
+		params: &ChatServiceMockRenameChatParams{ctx, chatID, name},
+	}
+	mmRenameChat.expectations = append(mmRenameChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.RenameChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockRenameChatExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockRenameChatResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.RenameChat should be invoked
+func (mmRenameChat *mChatServiceMockRenameChat) Times(n uint64) *mChatServiceMockRenameChat {
+	if n == 0 {
+		mmRenameChat.mock.t.Fatalf("Times of ChatServiceMock.RenameChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRenameChat.expectedInvocations, n)
+	return mmRenameChat
+}
+
+func (mmRenameChat *mChatServiceMockRenameChat) invocationsDone() bool {
+	if len(mmRenameChat.expectations) == 0 && mmRenameChat.defaultExpectation == nil && mmRenameChat.mock.funcRenameChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRenameChat.mock.afterRenameChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRenameChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RenameChat implements service.ChatService
+func (mmRenameChat *ChatServiceMock) RenameChat(ctx context.Context, chatID int64, name string) (err error) {
+	mm_atomic.AddUint64(&mmRenameChat.beforeRenameChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmRenameChat.afterRenameChatCounter, 1)
+
+	if mmRenameChat.inspectFuncRenameChat != nil {
+		mmRenameChat.inspectFuncRenameChat(ctx, chatID, name)
+	}
+
+	mm_params := ChatServiceMockRenameChatParams{ctx, chatID, name}
+
+	// Record call args
+	mmRenameChat.RenameChatMock.mutex.Lock()
+	mmRenameChat.RenameChatMock.callArgs = append(mmRenameChat.RenameChatMock.callArgs, &mm_params)
+	mmRenameChat.RenameChatMock.mutex.Unlock()
+
+	for _, e := range mmRenameChat.RenameChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRenameChat.RenameChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRenameChat.RenameChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmRenameChat.RenameChatMock.defaultExpectation.params
+		mm_want_ptrs := mmRenameChat.RenameChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockRenameChatParams{ctx, chatID, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRenameChat.t.Errorf("ChatServiceMock.RenameChat got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmRenameChat.t.Errorf("ChatServiceMock.RenameChat got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmRenameChat.t.Errorf("ChatServiceMock.RenameChat got unexpected parameter name, want: %#v, got: %#v%s\n", *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRenameChat.t.Errorf("ChatServiceMock.RenameChat got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRenameChat.RenameChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRenameChat.t.Fatal("No results are set for the ChatServiceMock.RenameChat")
+		}
+		return (*mm_results).err
+	}
+	if mmRenameChat.funcRenameChat != nil {
+		return mmRenameChat.funcRenameChat(ctx, chatID, name)
+	}
+	mmRenameChat.t.Fatalf("Unexpected call to ChatServiceMock.RenameChat. %v %v %v", ctx, chatID, name)
+	return
+}
+
+// RenameChatAfterCounter returns a count of finished ChatServiceMock.RenameChat invocations
+func (mmRenameChat *ChatServiceMock) RenameChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRenameChat.afterRenameChatCounter)
+}
+
+// RenameChatBeforeCounter returns a count of ChatServiceMock.RenameChat invocations
+func (mmRenameChat *ChatServiceMock) RenameChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRenameChat.beforeRenameChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.RenameChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRenameChat *mChatServiceMockRenameChat) Calls() []*ChatServiceMockRenameChatParams {
+	mmRenameChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockRenameChatParams, len(mmRenameChat.callArgs))
+	copy(argCopy, mmRenameChat.callArgs)
+
+	mmRenameChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRenameChatDone returns true if the count of the RenameChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockRenameChatDone() bool {
+	if m.RenameChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RenameChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RenameChatMock.invocationsDone()
+}
+
+// MinimockRenameChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockRenameChatInspect() {
+	for _, e := range m.RenameChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.RenameChat with params: %#v", *e.params)
+		}
+	}
+
+	afterRenameChatCounter := mm_atomic.LoadUint64(&m.afterRenameChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RenameChatMock.defaultExpectation != nil && afterRenameChatCounter < 1 {
+		if m.RenameChatMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.RenameChat")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.RenameChat with params: %#v", *m.RenameChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRenameChat != nil && afterRenameChatCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.RenameChat")
+	}
+
+	if !m.RenameChatMock.invocationsDone() && afterRenameChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.RenameChat but found %d calls",
+			mm_atomic.LoadUint64(&m.RenameChatMock.expectedInvocations), afterRenameChatCounter)
+	}
+}
+
+type mChatServiceMockRevokeInviteLink struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockRevokeInviteLinkExpectation
+	expectations       []*ChatServiceMockRevokeInviteLinkExpectation
+
+	callArgs []*ChatServiceMockRevokeInviteLinkParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockRevokeInviteLinkExpectation specifies expectation struct of the ChatService.RevokeInviteLink
+type ChatServiceMockRevokeInviteLinkExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockRevokeInviteLinkParams
+	paramPtrs *ChatServiceMockRevokeInviteLinkParamPtrs
+	results   *ChatServiceMockRevokeInviteLinkResults
+	Counter   uint64
+}
+
+// ChatServiceMockRevokeInviteLinkParams contains parameters of the ChatService.RevokeInviteLink
+type ChatServiceMockRevokeInviteLinkParams struct {
+	ctx      context.Context
+	chatID   int64
+	inviteID string
+}
+
+// ChatServiceMockRevokeInviteLinkParamPtrs contains pointers to parameters of the ChatService.RevokeInviteLink
+type ChatServiceMockRevokeInviteLinkParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	inviteID *string
+}
+
+// ChatServiceMockRevokeInviteLinkResults contains results of the ChatService.RevokeInviteLink
+type ChatServiceMockRevokeInviteLinkResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeInviteLink *mChatServiceMockRevokeInviteLink) Optional() *mChatServiceMockRevokeInviteLink {
+	mmRevokeInviteLink.optional = true
+	return mmRevokeInviteLink
+}
+
+// Expect sets up expected params for ChatService.RevokeInviteLink
+func (mmRevokeInviteLink *mChatServiceMockRevokeInviteLink) Expect(ctx context.Context, chatID int64, inviteID string) *mChatServiceMockRevokeInviteLink {
+	if mmRevokeInviteLink.mock.funcRevokeInviteLink != nil {
+		mmRevokeInviteLink.mock.t.Fatalf("ChatServiceMock.RevokeInviteLink mock is already set by Set")
+	}
+
+	if mmRevokeInviteLink.defaultExpectation == nil {
+		mmRevokeInviteLink.defaultExpectation = &ChatServiceMockRevokeInviteLinkExpectation{}
+	}
+
+	if mmRevokeInviteLink.defaultExpectation.paramPtrs != nil {
+		mmRevokeInviteLink.mock.t.Fatalf("ChatServiceMock.RevokeInviteLink mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeInviteLink.defaultExpectation.params = &ChatServiceMockRevokeInviteLinkParams{ctx, chatID, inviteID}
+	for _, e := range mmRevokeInviteLink.expectations {
+		if minimock.Equal(e.params, mmRevokeInviteLink.defaultExpectation.params) {
+			mmRevokeInviteLink.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeInviteLink.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeInviteLink
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.RevokeInviteLink
+func (mmRevokeInviteLink *mChatServiceMockRevokeInviteLink) ExpectCtxParam1(ctx context.Context) *mChatServiceMockRevokeInviteLink {
+	if mmRevokeInviteLink.mock.funcRevokeInviteLink != nil {
+		mmRevokeInviteLink.mock.t.Fatalf("ChatServiceMock.RevokeInviteLink mock is already set by Set")
+	}
+
+	if mmRevokeInviteLink.defaultExpectation == nil {
+		mmRevokeInviteLink.defaultExpectation = &ChatServiceMockRevokeInviteLinkExpectation{}
+	}
+
+	if mmRevokeInviteLink.defaultExpectation.params != nil {
+		mmRevokeInviteLink.mock.t.Fatalf("ChatServiceMock.RevokeInviteLink mock is already set by Expect")
+	}
+
+	if mmRevokeInviteLink.defaultExpectation.paramPtrs == nil {
+		mmRevokeInviteLink.defaultExpectation.paramPtrs = &ChatServiceMockRevokeInviteLinkParamPtrs{}
+	}
+	mmRevokeInviteLink.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRevokeInviteLink
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.RevokeInviteLink
+func (mmRevokeInviteLink *mChatServiceMockRevokeInviteLink) ExpectChatIDParam2(chatID int64) *mChatServiceMockRevokeInviteLink {
+	if mmRevokeInviteLink.mock.funcRevokeInviteLink != nil {
+		mmRevokeInviteLink.mock.t.Fatalf("ChatServiceMock.RevokeInviteLink mock is already set by Set")
+	}
+
+	if mmRevokeInviteLink.defaultExpectation == nil {
+		mmRevokeInviteLink.defaultExpectation = &ChatServiceMockRevokeInviteLinkExpectation{}
+	}
+
+	if mmRevokeInviteLink.defaultExpectation.params != nil {
+		mmRevokeInviteLink.mock.t.Fatalf("ChatServiceMock.RevokeInviteLink mock is already set by Expect")
+	}
+
+	if mmRevokeInviteLink.defaultExpectation.paramPtrs == nil {
+		mmRevokeInviteLink.defaultExpectation.paramPtrs = &ChatServiceMockRevokeInviteLinkParamPtrs{}
+	}
+	mmRevokeInviteLink.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmRevokeInviteLink
+}
+
+// ExpectInviteIDParam3 sets up expected param inviteID for ChatService.RevokeInviteLink
+func (mmRevokeInviteLink *mChatServiceMockRevokeInviteLink) ExpectInviteIDParam3(inviteID string) *mChatServiceMockRevokeInviteLink {
+	if mmRevokeInviteLink.mock.funcRevokeInviteLink != nil {
+		mmRevokeInviteLink.mock.t.Fatalf("ChatServiceMock.RevokeInviteLink mock is already set by Set")
+	}
+
+	if mmRevokeInviteLink.defaultExpectation == nil {
+		mmRevokeInviteLink.defaultExpectation = &ChatServiceMockRevokeInviteLinkExpectation{}
+	}
+
+	if mmRevokeInviteLink.defaultExpectation.params != nil {
+		mmRevokeInviteLink.mock.t.Fatalf("ChatServiceMock.RevokeInviteLink mock is already set by Expect")
+	}
+
+	if mmRevokeInviteLink.defaultExpectation.paramPtrs == nil {
+		mmRevokeInviteLink.defaultExpectation.paramPtrs = &ChatServiceMockRevokeInviteLinkParamPtrs{}
+	}
+	mmRevokeInviteLink.defaultExpectation.paramPtrs.inviteID = &inviteID
+
+	return mmRevokeInviteLink
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.RevokeInviteLink
+func (mmRevokeInviteLink *mChatServiceMockRevokeInviteLink) Inspect(f func(ctx context.Context, chatID int64, inviteID string)) *mChatServiceMockRevokeInviteLink {
+	if mmRevokeInviteLink.mock.inspectFuncRevokeInviteLink != nil {
+		mmRevokeInviteLink.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.RevokeInviteLink")
+	}
+
+	mmRevokeInviteLink.mock.inspectFuncRevokeInviteLink = f
+
+	return mmRevokeInviteLink
+}
+
+// Return sets up results that will be returned by ChatService.RevokeInviteLink
+func (mmRevokeInviteLink *mChatServiceMockRevokeInviteLink) Return(err error) *ChatServiceMock {
+	if mmRevokeInviteLink.mock.funcRevokeInviteLink != nil {
+		mmRevokeInviteLink.mock.t.Fatalf("ChatServiceMock.RevokeInviteLink mock is already set by Set")
+	}
+
+	if mmRevokeInviteLink.defaultExpectation == nil {
+		mmRevokeInviteLink.defaultExpectation = &ChatServiceMockRevokeInviteLinkExpectation{mock: mmRevokeInviteLink.mock}
+	}
+	mmRevokeInviteLink.defaultExpectation.results = &ChatServiceMockRevokeInviteLinkResults{err}
+	return mmRevokeInviteLink.mock
+}
+
+// Set uses given function f to mock the ChatService.RevokeInviteLink method
+func (mmRevokeInviteLink *mChatServiceMockRevokeInviteLink) Set(f func(ctx context.Context, chatID int64, inviteID string) (err error)) *ChatServiceMock {
+	if mmRevokeInviteLink.defaultExpectation != nil {
+		mmRevokeInviteLink.mock.t.Fatalf("Default expectation is already set for the ChatService.RevokeInviteLink method")
+	}
+
+	if len(mmRevokeInviteLink.expectations) > 0 {
+		mmRevokeInviteLink.mock.t.Fatalf("Some expectations are already set for the ChatService.RevokeInviteLink method")
+	}
+
+	mmRevokeInviteLink.mock.funcRevokeInviteLink = f
+	return mmRevokeInviteLink.mock
+}
+
+// When sets expectation for the ChatService.RevokeInviteLink which will trigger the result defined by the following
+// Then helper
+func (mmRevokeInviteLink *mChatServiceMockRevokeInviteLink) When(ctx context.Context, chatID int64, inviteID string) *ChatServiceMockRevokeInviteLinkExpectation {
+	if mmRevokeInviteLink.mock.funcRevokeInviteLink != nil {
+		mmRevokeInviteLink.mock.t.Fatalf("ChatServiceMock.RevokeInviteLink mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockRevokeInviteLinkExpectation{
+		mock:   mmRevokeInviteLink.mock,
+		params: &ChatServiceMockRevokeInviteLinkParams{ctx, chatID, inviteID},
+	}
+	mmRevokeInviteLink.expectations = append(mmRevokeInviteLink.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.RevokeInviteLink return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockRevokeInviteLinkExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockRevokeInviteLinkResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.RevokeInviteLink should be invoked
+func (mmRevokeInviteLink *mChatServiceMockRevokeInviteLink) Times(n uint64) *mChatServiceMockRevokeInviteLink {
+	if n == 0 {
+		mmRevokeInviteLink.mock.t.Fatalf("Times of ChatServiceMock.RevokeInviteLink mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeInviteLink.expectedInvocations, n)
+	return mmRevokeInviteLink
+}
+
+func (mmRevokeInviteLink *mChatServiceMockRevokeInviteLink) invocationsDone() bool {
+	if len(mmRevokeInviteLink.expectations) == 0 && mmRevokeInviteLink.defaultExpectation == nil && mmRevokeInviteLink.mock.funcRevokeInviteLink == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeInviteLink.mock.afterRevokeInviteLinkCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeInviteLink.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeInviteLink implements service.ChatService
+func (mmRevokeInviteLink *ChatServiceMock) RevokeInviteLink(ctx context.Context, chatID int64, inviteID string) (err error) {
+	mm_atomic.AddUint64(&mmRevokeInviteLink.beforeRevokeInviteLinkCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeInviteLink.afterRevokeInviteLinkCounter, 1)
+
+	if mmRevokeInviteLink.inspectFuncRevokeInviteLink != nil {
+		mmRevokeInviteLink.inspectFuncRevokeInviteLink(ctx, chatID, inviteID)
+	}
+
+	mm_params := ChatServiceMockRevokeInviteLinkParams{ctx, chatID, inviteID}
+
+	// Record call args
+	mmRevokeInviteLink.RevokeInviteLinkMock.mutex.Lock()
+	mmRevokeInviteLink.RevokeInviteLinkMock.callArgs = append(mmRevokeInviteLink.RevokeInviteLinkMock.callArgs, &mm_params)
+	mmRevokeInviteLink.RevokeInviteLinkMock.mutex.Unlock()
+
+	for _, e := range mmRevokeInviteLink.RevokeInviteLinkMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeInviteLink.RevokeInviteLinkMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeInviteLink.RevokeInviteLinkMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeInviteLink.RevokeInviteLinkMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeInviteLink.RevokeInviteLinkMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockRevokeInviteLinkParams{ctx, chatID, inviteID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeInviteLink.t.Errorf("ChatServiceMock.RevokeInviteLink got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmRevokeInviteLink.t.Errorf("ChatServiceMock.RevokeInviteLink got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.inviteID != nil && !minimock.Equal(*mm_want_ptrs.inviteID, mm_got.inviteID) {
+				mmRevokeInviteLink.t.Errorf("ChatServiceMock.RevokeInviteLink got unexpected parameter inviteID, want: %#v, got: %#v%s\n", *mm_want_ptrs.inviteID, mm_got.inviteID, minimock.Diff(*mm_want_ptrs.inviteID, mm_got.inviteID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeInviteLink.t.Errorf("ChatServiceMock.RevokeInviteLink got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeInviteLink.RevokeInviteLinkMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeInviteLink.t.Fatal("No results are set for the ChatServiceMock.RevokeInviteLink")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeInviteLink.funcRevokeInviteLink != nil {
+		return mmRevokeInviteLink.funcRevokeInviteLink(ctx, chatID, inviteID)
+	}
+	mmRevokeInviteLink.t.Fatalf("Unexpected call to ChatServiceMock.RevokeInviteLink. %v %v %v", ctx, chatID, inviteID)
+	return
+}
+
+// RevokeInviteLinkAfterCounter returns a count of finished ChatServiceMock.RevokeInviteLink invocations
+func (mmRevokeInviteLink *ChatServiceMock) RevokeInviteLinkAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeInviteLink.afterRevokeInviteLinkCounter)
+}
+
+// RevokeInviteLinkBeforeCounter returns a count of ChatServiceMock.RevokeInviteLink invocations
+func (mmRevokeInviteLink *ChatServiceMock) RevokeInviteLinkBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeInviteLink.beforeRevokeInviteLinkCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.RevokeInviteLink.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeInviteLink *mChatServiceMockRevokeInviteLink) Calls() []*ChatServiceMockRevokeInviteLinkParams {
+	mmRevokeInviteLink.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockRevokeInviteLinkParams, len(mmRevokeInviteLink.callArgs))
+	copy(argCopy, mmRevokeInviteLink.callArgs)
+
+	mmRevokeInviteLink.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeInviteLinkDone returns true if the count of the RevokeInviteLink invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockRevokeInviteLinkDone() bool {
+	if m.RevokeInviteLinkMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeInviteLinkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeInviteLinkMock.invocationsDone()
+}
+
+// MinimockRevokeInviteLinkInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockRevokeInviteLinkInspect() {
+	for _, e := range m.RevokeInviteLinkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.RevokeInviteLink with params: %#v", *e.params)
+		}
+	}
+
+	afterRevokeInviteLinkCounter := mm_atomic.LoadUint64(&m.afterRevokeInviteLinkCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeInviteLinkMock.defaultExpectation != nil && afterRevokeInviteLinkCounter < 1 {
+		if m.RevokeInviteLinkMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.RevokeInviteLink")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.RevokeInviteLink with params: %#v", *m.RevokeInviteLinkMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeInviteLink != nil && afterRevokeInviteLinkCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.RevokeInviteLink")
+	}
+
+	if !m.RevokeInviteLinkMock.invocationsDone() && afterRevokeInviteLinkCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.RevokeInviteLink but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeInviteLinkMock.expectedInvocations), afterRevokeInviteLinkCounter)
+	}
+}
+
+type mChatServiceMockScheduleMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockScheduleMessageExpectation
+	expectations       []*ChatServiceMockScheduleMessageExpectation
+
+	callArgs []*ChatServiceMockScheduleMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockScheduleMessageExpectation specifies expectation struct of the ChatService.ScheduleMessage
+type ChatServiceMockScheduleMessageExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockScheduleMessageParams
+	paramPtrs *ChatServiceMockScheduleMessageParamPtrs
+	results   *ChatServiceMockScheduleMessageResults
+	Counter   uint64
+}
+
+// ChatServiceMockScheduleMessageParams contains parameters of the ChatService.ScheduleMessage
+type ChatServiceMockScheduleMessageParams struct {
+	ctx    context.Context
+	chatID int64
+	text   string
+	sendAt time.Time
+}
+
+// ChatServiceMockScheduleMessageParamPtrs contains pointers to parameters of the ChatService.ScheduleMessage
+type ChatServiceMockScheduleMessageParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+	text   *string
+	sendAt *time.Time
+}
+
+// ChatServiceMockScheduleMessageResults contains results of the ChatService.ScheduleMessage
+type ChatServiceMockScheduleMessageResults struct {
+	sp1 *model.ScheduledMessage
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Optional() *mChatServiceMockScheduleMessage {
+	mmScheduleMessage.optional = true
+	return mmScheduleMessage
+}
+
+// Expect sets up expected params for ChatService.ScheduleMessage
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Expect(ctx context.Context, chatID int64, text string, sendAt time.Time) *mChatServiceMockScheduleMessage {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	if mmScheduleMessage.defaultExpectation == nil {
+		mmScheduleMessage.defaultExpectation = &ChatServiceMockScheduleMessageExpectation{}
+	}
+
+	if mmScheduleMessage.defaultExpectation.paramPtrs != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by ExpectParams functions")
+	}
+
+	mmScheduleMessage.defaultExpectation.params = &ChatServiceMockScheduleMessageParams{ctx, chatID, text, sendAt}
+	for _, e := range mmScheduleMessage.expectations {
+		if minimock.Equal(e.params, mmScheduleMessage.defaultExpectation.params) {
+			mmScheduleMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmScheduleMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmScheduleMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ScheduleMessage
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockScheduleMessage {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	if mmScheduleMessage.defaultExpectation == nil {
+		mmScheduleMessage.defaultExpectation = &ChatServiceMockScheduleMessageExpectation{}
+	}
+
+	if mmScheduleMessage.defaultExpectation.params != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Expect")
+	}
+
+	if mmScheduleMessage.defaultExpectation.paramPtrs == nil {
+		mmScheduleMessage.defaultExpectation.paramPtrs = &ChatServiceMockScheduleMessageParamPtrs{}
+	}
+	mmScheduleMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmScheduleMessage
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.ScheduleMessage
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) ExpectChatIDParam2(chatID int64) *mChatServiceMockScheduleMessage {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	if mmScheduleMessage.defaultExpectation == nil {
+		mmScheduleMessage.defaultExpectation = &ChatServiceMockScheduleMessageExpectation{}
+	}
+
+	if mmScheduleMessage.defaultExpectation.params != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Expect")
+	}
+
+	if mmScheduleMessage.defaultExpectation.paramPtrs == nil {
+		mmScheduleMessage.defaultExpectation.paramPtrs = &ChatServiceMockScheduleMessageParamPtrs{}
+	}
+	mmScheduleMessage.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmScheduleMessage
+}
+
+// ExpectTextParam3 sets up expected param text for ChatService.ScheduleMessage
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) ExpectTextParam3(text string) *mChatServiceMockScheduleMessage {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	if mmScheduleMessage.defaultExpectation == nil {
+		mmScheduleMessage.defaultExpectation = &ChatServiceMockScheduleMessageExpectation{}
+	}
+
+	if mmScheduleMessage.defaultExpectation.params != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Expect")
+	}
+
+	if mmScheduleMessage.defaultExpectation.paramPtrs == nil {
+		mmScheduleMessage.defaultExpectation.paramPtrs = &ChatServiceMockScheduleMessageParamPtrs{}
+	}
+	mmScheduleMessage.defaultExpectation.paramPtrs.text = &text
+
+	return mmScheduleMessage
+}
+
+// ExpectSendAtParam4 sets up expected param sendAt for ChatService.ScheduleMessage
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) ExpectSendAtParam4(sendAt time.Time) *mChatServiceMockScheduleMessage {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	if mmScheduleMessage.defaultExpectation == nil {
+		mmScheduleMessage.defaultExpectation = &ChatServiceMockScheduleMessageExpectation{}
+	}
+
+	if mmScheduleMessage.defaultExpectation.params != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Expect")
+	}
+
+	if mmScheduleMessage.defaultExpectation.paramPtrs == nil {
+		mmScheduleMessage.defaultExpectation.paramPtrs = &ChatServiceMockScheduleMessageParamPtrs{}
+	}
+	mmScheduleMessage.defaultExpectation.paramPtrs.sendAt = &sendAt
+
+	return mmScheduleMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ScheduleMessage
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Inspect(f func(ctx context.Context, chatID int64, text string, sendAt time.Time)) *mChatServiceMockScheduleMessage {
+	if mmScheduleMessage.mock.inspectFuncScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ScheduleMessage")
+	}
+
+	mmScheduleMessage.mock.inspectFuncScheduleMessage = f
+
+	return mmScheduleMessage
+}
+
+// Return sets up results that will be returned by ChatService.ScheduleMessage
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Return(sp1 *model.ScheduledMessage, err error) *ChatServiceMock {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	if mmScheduleMessage.defaultExpectation == nil {
+		mmScheduleMessage.defaultExpectation = &ChatServiceMockScheduleMessageExpectation{mock: mmScheduleMessage.mock}
+	}
+	mmScheduleMessage.defaultExpectation.results = &ChatServiceMockScheduleMessageResults{sp1, err}
+	return mmScheduleMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.ScheduleMessage method
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Set(f func(ctx context.Context, chatID int64, text string, sendAt time.Time) (sp1 *model.ScheduledMessage, err error)) *ChatServiceMock {
+	if mmScheduleMessage.defaultExpectation != nil {
+		mmScheduleMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.ScheduleMessage method")
+	}
+
+	if len(mmScheduleMessage.expectations) > 0 {
+		mmScheduleMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.ScheduleMessage method")
+	}
+
+	mmScheduleMessage.mock.funcScheduleMessage = f
+	return mmScheduleMessage.mock
+}
+
+// When sets expectation for the ChatService.ScheduleMessage which will trigger the result defined by the following
+// Then helper
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) When(ctx context.Context, chatID int64, text string, sendAt time.Time) *ChatServiceMockScheduleMessageExpectation {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockScheduleMessageExpectation{
+		mock:   mmScheduleMessage.mock,
+		params: &ChatServiceMockScheduleMessageParams{ctx, chatID, text, sendAt},
+	}
+	mmScheduleMessage.expectations = append(mmScheduleMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ScheduleMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockScheduleMessageExpectation) Then(sp1 *model.ScheduledMessage, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockScheduleMessageResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ScheduleMessage should be invoked
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Times(n uint64) *mChatServiceMockScheduleMessage {
+	if n == 0 {
+		mmScheduleMessage.mock.t.Fatalf("Times of ChatServiceMock.ScheduleMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmScheduleMessage.expectedInvocations, n)
+	return mmScheduleMessage
+}
+
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) invocationsDone() bool {
+	if len(mmScheduleMessage.expectations) == 0 && mmScheduleMessage.defaultExpectation == nil && mmScheduleMessage.mock.funcScheduleMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmScheduleMessage.mock.afterScheduleMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmScheduleMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ScheduleMessage implements service.ChatService
+func (mmScheduleMessage *ChatServiceMock) ScheduleMessage(ctx context.Context, chatID int64, text string, sendAt time.Time) (sp1 *model.ScheduledMessage, err error) {
+	mm_atomic.AddUint64(&mmScheduleMessage.beforeScheduleMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmScheduleMessage.afterScheduleMessageCounter, 1)
+
+	if mmScheduleMessage.inspectFuncScheduleMessage != nil {
+		mmScheduleMessage.inspectFuncScheduleMessage(ctx, chatID, text, sendAt)
+	}
+
+	mm_params := ChatServiceMockScheduleMessageParams{ctx, chatID, text, sendAt}
+
+	// Record call args
+	mmScheduleMessage.ScheduleMessageMock.mutex.Lock()
+	mmScheduleMessage.ScheduleMessageMock.callArgs = append(mmScheduleMessage.ScheduleMessageMock.callArgs, &mm_params)
+	mmScheduleMessage.ScheduleMessageMock.mutex.Unlock()
+
+	for _, e := range mmScheduleMessage.ScheduleMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmScheduleMessage.ScheduleMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmScheduleMessage.ScheduleMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmScheduleMessage.ScheduleMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmScheduleMessage.ScheduleMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockScheduleMessageParams{ctx, chatID, text, sendAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmScheduleMessage.t.Errorf("ChatServiceMock.ScheduleMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmScheduleMessage.t.Errorf("ChatServiceMock.ScheduleMessage got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.text != nil && !minimock.Equal(*mm_want_ptrs.text, mm_got.text) {
+				mmScheduleMessage.t.Errorf("ChatServiceMock.ScheduleMessage got unexpected parameter text, want: %#v, got: %#v%s\n", *mm_want_ptrs.text, mm_got.text, minimock.Diff(*mm_want_ptrs.text, mm_got.text))
+			}
+
+			if mm_want_ptrs.sendAt != nil && !minimock.Equal(*mm_want_ptrs.sendAt, mm_got.sendAt) {
+				mmScheduleMessage.t.Errorf("ChatServiceMock.ScheduleMessage got unexpected parameter sendAt, want: %#v, got: %#v%s\n", *mm_want_ptrs.sendAt, mm_got.sendAt, minimock.Diff(*mm_want_ptrs.sendAt, mm_got.sendAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmScheduleMessage.t.Errorf("ChatServiceMock.ScheduleMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmScheduleMessage.ScheduleMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmScheduleMessage.t.Fatal("No results are set for the ChatServiceMock.ScheduleMessage")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmScheduleMessage.funcScheduleMessage != nil {
+		return mmScheduleMessage.funcScheduleMessage(ctx, chatID, text, sendAt)
+	}
+	mmScheduleMessage.t.Fatalf("Unexpected call to ChatServiceMock.ScheduleMessage. %v %v %v %v", ctx, chatID, text, sendAt)
+	return
+}
+
+// ScheduleMessageAfterCounter returns a count of finished ChatServiceMock.ScheduleMessage invocations
+func (mmScheduleMessage *ChatServiceMock) ScheduleMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScheduleMessage.afterScheduleMessageCounter)
+}
+
+// ScheduleMessageBeforeCounter returns a count of ChatServiceMock.ScheduleMessage invocations
+func (mmScheduleMessage *ChatServiceMock) ScheduleMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScheduleMessage.beforeScheduleMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ScheduleMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Calls() []*ChatServiceMockScheduleMessageParams {
+	mmScheduleMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockScheduleMessageParams, len(mmScheduleMessage.callArgs))
+	copy(argCopy, mmScheduleMessage.callArgs)
+
+	mmScheduleMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockScheduleMessageDone returns true if the count of the ScheduleMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockScheduleMessageDone() bool {
+	if m.ScheduleMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ScheduleMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ScheduleMessageMock.invocationsDone()
+}
+
+// MinimockScheduleMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockScheduleMessageInspect() {
+	for _, e := range m.ScheduleMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ScheduleMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterScheduleMessageCounter := mm_atomic.LoadUint64(&m.afterScheduleMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScheduleMessageMock.defaultExpectation != nil && afterScheduleMessageCounter < 1 {
+		if m.ScheduleMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.ScheduleMessage")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ScheduleMessage with params: %#v", *m.ScheduleMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScheduleMessage != nil && afterScheduleMessageCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.ScheduleMessage")
+	}
+
+	if !m.ScheduleMessageMock.invocationsDone() && afterScheduleMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ScheduleMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.ScheduleMessageMock.expectedInvocations), afterScheduleMessageCounter)
+	}
+}
+
+type mChatServiceMockSearchMessages struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockSearchMessagesExpectation
+	expectations       []*ChatServiceMockSearchMessagesExpectation
+
+	callArgs []*ChatServiceMockSearchMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockSearchMessagesExpectation specifies expectation struct of the ChatService.SearchMessages
+type ChatServiceMockSearchMessagesExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockSearchMessagesParams
+	paramPtrs *ChatServiceMockSearchMessagesParamPtrs
+	results   *ChatServiceMockSearchMessagesResults
+	Counter   uint64
+}
+
+// ChatServiceMockSearchMessagesParams contains parameters of the ChatService.SearchMessages
+type ChatServiceMockSearchMessagesParams struct {
+	ctx   context.Context
+	query *model.SearchQuery
+}
+
+// ChatServiceMockSearchMessagesParamPtrs contains pointers to parameters of the ChatService.SearchMessages
+type ChatServiceMockSearchMessagesParamPtrs struct {
+	ctx   *context.Context
+	query **model.SearchQuery
+}
+
+// ChatServiceMockSearchMessagesResults contains results of the ChatService.SearchMessages
+type ChatServiceMockSearchMessagesResults struct {
+	sp1 *model.SearchPage
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSearchMessages *mChatServiceMockSearchMessages) Optional() *mChatServiceMockSearchMessages {
+	mmSearchMessages.optional = true
+	return mmSearchMessages
+}
+
+// Expect sets up expected params for ChatService.SearchMessages
+func (mmSearchMessages *mChatServiceMockSearchMessages) Expect(ctx context.Context, query *model.SearchQuery) *mChatServiceMockSearchMessages {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Set")
+	}
+
+	if mmSearchMessages.defaultExpectation == nil {
+		mmSearchMessages.defaultExpectation = &ChatServiceMockSearchMessagesExpectation{}
+	}
+
+	if mmSearchMessages.defaultExpectation.paramPtrs != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by ExpectParams functions")
+	}
+
+	mmSearchMessages.defaultExpectation.params = &ChatServiceMockSearchMessagesParams{ctx, query}
+	for _, e := range mmSearchMessages.expectations {
+		if minimock.Equal(e.params, mmSearchMessages.defaultExpectation.params) {
+			mmSearchMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearchMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmSearchMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.SearchMessages
+func (mmSearchMessages *mChatServiceMockSearchMessages) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSearchMessages {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Set")
+	}
+
+	if mmSearchMessages.defaultExpectation == nil {
+		mmSearchMessages.defaultExpectation = &ChatServiceMockSearchMessagesExpectation{}
+	}
+
+	if mmSearchMessages.defaultExpectation.params != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Expect")
+	}
+
+	if mmSearchMessages.defaultExpectation.paramPtrs == nil {
+		mmSearchMessages.defaultExpectation.paramPtrs = &ChatServiceMockSearchMessagesParamPtrs{}
+	}
+	mmSearchMessages.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSearchMessages
+}
+
+// ExpectQueryParam2 sets up expected param query for ChatService.SearchMessages
+func (mmSearchMessages *mChatServiceMockSearchMessages) ExpectQueryParam2(query *model.SearchQuery) *mChatServiceMockSearchMessages {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Set")
+	}
+
+	if mmSearchMessages.defaultExpectation == nil {
+		mmSearchMessages.defaultExpectation = &ChatServiceMockSearchMessagesExpectation{}
+	}
+
+	if mmSearchMessages.defaultExpectation.params != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Expect")
+	}
+
+	if mmSearchMessages.defaultExpectation.paramPtrs == nil {
+		mmSearchMessages.defaultExpectation.paramPtrs = &ChatServiceMockSearchMessagesParamPtrs{}
+	}
+	mmSearchMessages.defaultExpectation.paramPtrs.query = &query
+
+	return mmSearchMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.SearchMessages
+func (mmSearchMessages *mChatServiceMockSearchMessages) Inspect(f func(ctx context.Context, query *model.SearchQuery)) *mChatServiceMockSearchMessages {
+	if mmSearchMessages.mock.inspectFuncSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SearchMessages")
+	}
+
+	mmSearchMessages.mock.inspectFuncSearchMessages = f
+
+	return mmSearchMessages
+}
+
+// Return sets up results that will be returned by ChatService.SearchMessages
+func (mmSearchMessages *mChatServiceMockSearchMessages) Return(sp1 *model.SearchPage, err error) *ChatServiceMock {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Set")
+	}
+
+	if mmSearchMessages.defaultExpectation == nil {
+		mmSearchMessages.defaultExpectation = &ChatServiceMockSearchMessagesExpectation{mock: mmSearchMessages.mock}
+	}
+	mmSearchMessages.defaultExpectation.results = &ChatServiceMockSearchMessagesResults{sp1, err}
+	return mmSearchMessages.mock
+}
+
+// Set uses given function f to mock the ChatService.SearchMessages method
+func (mmSearchMessages *mChatServiceMockSearchMessages) Set(f func(ctx context.Context, query *model.SearchQuery) (sp1 *model.SearchPage, err error)) *ChatServiceMock {
+	if mmSearchMessages.defaultExpectation != nil {
+		mmSearchMessages.mock.t.Fatalf("Default expectation is already set for the ChatService.SearchMessages method")
+	}
+
+	if len(mmSearchMessages.expectations) > 0 {
+		mmSearchMessages.mock.t.Fatalf("Some expectations are already set for the ChatService.SearchMessages method")
+	}
+
+	mmSearchMessages.mock.funcSearchMessages = f
+	return mmSearchMessages.mock
+}
+
+// When sets expectation for the ChatService.SearchMessages which will trigger the result defined by the following
+// Then helper
+func (mmSearchMessages *mChatServiceMockSearchMessages) When(ctx context.Context, query *model.SearchQuery) *ChatServiceMockSearchMessagesExpectation {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockSearchMessagesExpectation{
+		mock:   mmSearchMessages.mock,
+		params: &ChatServiceMockSearchMessagesParams{ctx, query},
+	}
+	mmSearchMessages.expectations = append(mmSearchMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.SearchMessages return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSearchMessagesExpectation) Then(sp1 *model.SearchPage, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSearchMessagesResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.SearchMessages should be invoked
+func (mmSearchMessages *mChatServiceMockSearchMessages) Times(n uint64) *mChatServiceMockSearchMessages {
+	if n == 0 {
+		mmSearchMessages.mock.t.Fatalf("Times of ChatServiceMock.SearchMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSearchMessages.expectedInvocations, n)
+	return mmSearchMessages
+}
+
+func (mmSearchMessages *mChatServiceMockSearchMessages) invocationsDone() bool {
+	if len(mmSearchMessages.expectations) == 0 && mmSearchMessages.defaultExpectation == nil && mmSearchMessages.mock.funcSearchMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSearchMessages.mock.afterSearchMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearchMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SearchMessages implements service.ChatService
+func (mmSearchMessages *ChatServiceMock) SearchMessages(ctx context.Context, query *model.SearchQuery) (sp1 *model.SearchPage, err error) {
+	mm_atomic.AddUint64(&mmSearchMessages.beforeSearchMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearchMessages.afterSearchMessagesCounter, 1)
+
+	if mmSearchMessages.inspectFuncSearchMessages != nil {
+		mmSearchMessages.inspectFuncSearchMessages(ctx, query)
+	}
+
+	mm_params := ChatServiceMockSearchMessagesParams{ctx, query}
+
+	// Record call args
+	mmSearchMessages.SearchMessagesMock.mutex.Lock()
+	mmSearchMessages.SearchMessagesMock.callArgs = append(mmSearchMessages.SearchMessagesMock.callArgs, &mm_params)
+	mmSearchMessages.SearchMessagesMock.mutex.Unlock()
+
+	for _, e := range mmSearchMessages.SearchMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmSearchMessages.SearchMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearchMessages.SearchMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearchMessages.SearchMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmSearchMessages.SearchMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockSearchMessagesParams{ctx, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSearchMessages.t.Errorf("ChatServiceMock.SearchMessages got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmSearchMessages.t.Errorf("ChatServiceMock.SearchMessages got unexpected parameter query, want: %#v, got: %#v%s\n", *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSearchMessages.t.Errorf("ChatServiceMock.SearchMessages got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSearchMessages.SearchMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSearchMessages.t.Fatal("No results are set for the ChatServiceMock.SearchMessages")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmSearchMessages.funcSearchMessages != nil {
+		return mmSearchMessages.funcSearchMessages(ctx, query)
+	}
+	mmSearchMessages.t.Fatalf("Unexpected call to ChatServiceMock.SearchMessages. %v %v", ctx, query)
+	return
+}
+
+// SearchMessagesAfterCounter returns a count of finished ChatServiceMock.SearchMessages invocations
+func (mmSearchMessages *ChatServiceMock) SearchMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchMessages.afterSearchMessagesCounter)
+}
+
+// SearchMessagesBeforeCounter returns a count of ChatServiceMock.SearchMessages invocations
+func (mmSearchMessages *ChatServiceMock) SearchMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchMessages.beforeSearchMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.SearchMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSearchMessages *mChatServiceMockSearchMessages) Calls() []*ChatServiceMockSearchMessagesParams {
+	mmSearchMessages.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockSearchMessagesParams, len(mmSearchMessages.callArgs))
+	copy(argCopy, mmSearchMessages.callArgs)
+
+	mmSearchMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSearchMessagesDone returns true if the count of the SearchMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockSearchMessagesDone() bool {
+	if m.SearchMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SearchMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SearchMessagesMock.invocationsDone()
+}
+
+// MinimockSearchMessagesInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSearchMessagesInspect() {
+	for _, e := range m.SearchMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.SearchMessages with params: %#v", *e.params)
+		}
+	}
+
+	afterSearchMessagesCounter := mm_atomic.LoadUint64(&m.afterSearchMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SearchMessagesMock.defaultExpectation != nil && afterSearchMessagesCounter < 1 {
+		if m.SearchMessagesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.SearchMessages")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.SearchMessages with params: %#v", *m.SearchMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSearchMessages != nil && afterSearchMessagesCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.SearchMessages")
+	}
+
+	if !m.SearchMessagesMock.invocationsDone() && afterSearchMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.SearchMessages but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchMessagesMock.expectedInvocations), afterSearchMessagesCounter)
+	}
+}
+
+type mChatServiceMockSendMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockSendMessageExpectation
+	expectations       []*ChatServiceMockSendMessageExpectation
+
+	callArgs []*ChatServiceMockSendMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockSendMessageExpectation specifies expectation struct of the ChatService.SendMessage
+type ChatServiceMockSendMessageExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockSendMessageParams
+	paramPtrs *ChatServiceMockSendMessageParamPtrs
+	results   *ChatServiceMockSendMessageResults
+	Counter   uint64
+}
+
+// ChatServiceMockSendMessageParams contains parameters of the ChatService.SendMessage
+type ChatServiceMockSendMessageParams struct {
+	ctx           context.Context
+	createMessage *model.MessageCreate
+}
+
+// ChatServiceMockSendMessageParamPtrs contains pointers to parameters of the ChatService.SendMessage
+type ChatServiceMockSendMessageParamPtrs struct {
+	ctx           *context.Context
+	createMessage **model.MessageCreate
+}
+
+// ChatServiceMockSendMessageResults contains results of the ChatService.SendMessage
+type ChatServiceMockSendMessageResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSendMessage *mChatServiceMockSendMessage) Optional() *mChatServiceMockSendMessage {
+	mmSendMessage.optional = true
+	return mmSendMessage
+}
+
+// Expect sets up expected params for ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) Expect(ctx context.Context, createMessage *model.MessageCreate) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by ExpectParams functions")
+	}
+
+	mmSendMessage.defaultExpectation.params = &ChatServiceMockSendMessageParams{ctx, createMessage}
+	for _, e := range mmSendMessage.expectations {
+		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
+			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmSendMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSendMessage
+}
+
+// ExpectCreateMessageParam2 sets up expected param createMessage for ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) ExpectCreateMessageParam2(createMessage *model.MessageCreate) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.createMessage = &createMessage
+
+	return mmSendMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) Inspect(f func(ctx context.Context, createMessage *model.MessageCreate)) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.inspectFuncSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SendMessage")
+	}
+
+	mmSendMessage.mock.inspectFuncSendMessage = f
+
+	return mmSendMessage
+}
+
+// Return sets up results that will be returned by ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) Return(mp1 *model.Message, err error) *ChatServiceMock {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{mock: mmSendMessage.mock}
+	}
+	mmSendMessage.defaultExpectation.results = &ChatServiceMockSendMessageResults{mp1, err}
+	return mmSendMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.SendMessage method
+func (mmSendMessage *mChatServiceMockSendMessage) Set(f func(ctx context.Context, createMessage *model.MessageCreate) (mp1 *model.Message, err error)) *ChatServiceMock {
+	if mmSendMessage.defaultExpectation != nil {
+		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.SendMessage method")
+	}
+
+	if len(mmSendMessage.expectations) > 0 {
+		mmSendMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.SendMessage method")
+	}
+
+	mmSendMessage.mock.funcSendMessage = f
+	return mmSendMessage.mock
+}
+
+// When sets expectation for the ChatService.SendMessage which will trigger the result defined by the following
+// Then helper
+func (mmSendMessage *mChatServiceMockSendMessage) When(ctx context.Context, createMessage *model.MessageCreate) *ChatServiceMockSendMessageExpectation {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockSendMessageExpectation{
+		mock:   mmSendMessage.mock,
+		params: &ChatServiceMockSendMessageParams{ctx, createMessage},
+	}
+	mmSendMessage.expectations = append(mmSendMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.SendMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSendMessageExpectation) Then(mp1 *model.Message, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSendMessageResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.SendMessage should be invoked
+func (mmSendMessage *mChatServiceMockSendMessage) Times(n uint64) *mChatServiceMockSendMessage {
+	if n == 0 {
+		mmSendMessage.mock.t.Fatalf("Times of ChatServiceMock.SendMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSendMessage.expectedInvocations, n)
+	return mmSendMessage
+}
+
+func (mmSendMessage *mChatServiceMockSendMessage) invocationsDone() bool {
+	if len(mmSendMessage.expectations) == 0 && mmSendMessage.defaultExpectation == nil && mmSendMessage.mock.funcSendMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSendMessage.mock.afterSendMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSendMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SendMessage implements service.ChatService
+func (mmSendMessage *ChatServiceMock) SendMessage(ctx context.Context, createMessage *model.MessageCreate) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
+
+	if mmSendMessage.inspectFuncSendMessage != nil {
+		mmSendMessage.inspectFuncSendMessage(ctx, createMessage)
+	}
+
+	mm_params := ChatServiceMockSendMessageParams{ctx, createMessage}
+
+	// Record call args
+	mmSendMessage.SendMessageMock.mutex.Lock()
+	mmSendMessage.SendMessageMock.callArgs = append(mmSendMessage.SendMessageMock.callArgs, &mm_params)
+	mmSendMessage.SendMessageMock.mutex.Unlock()
+
+	for _, e := range mmSendMessage.SendMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmSendMessage.SendMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSendMessage.SendMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmSendMessage.SendMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmSendMessage.SendMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockSendMessageParams{ctx, createMessage}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.createMessage != nil && !minimock.Equal(*mm_want_ptrs.createMessage, mm_got.createMessage) {
+				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter createMessage, want: %#v, got: %#v%s\n", *mm_want_ptrs.createMessage, mm_got.createMessage, minimock.Diff(*mm_want_ptrs.createMessage, mm_got.createMessage))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSendMessage.SendMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSendMessage.t.Fatal("No results are set for the ChatServiceMock.SendMessage")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmSendMessage.funcSendMessage != nil {
+		return mmSendMessage.funcSendMessage(ctx, createMessage)
+	}
+	mmSendMessage.t.Fatalf("Unexpected call to ChatServiceMock.SendMessage. %v %v", ctx, createMessage)
+	return
+}
+
+// SendMessageAfterCounter returns a count of finished ChatServiceMock.SendMessage invocations
+func (mmSendMessage *ChatServiceMock) SendMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendMessage.afterSendMessageCounter)
+}
+
+// SendMessageBeforeCounter returns a count of ChatServiceMock.SendMessage invocations
+func (mmSendMessage *ChatServiceMock) SendMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendMessage.beforeSendMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.SendMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSendMessage *mChatServiceMockSendMessage) Calls() []*ChatServiceMockSendMessageParams {
+	mmSendMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockSendMessageParams, len(mmSendMessage.callArgs))
+	copy(argCopy, mmSendMessage.callArgs)
+
+	mmSendMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendMessageDone returns true if the count of the SendMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockSendMessageDone() bool {
+	if m.SendMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendMessageMock.invocationsDone()
+}
+
+// MinimockSendMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSendMessageInspect() {
+	for _, e := range m.SendMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.SendMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterSendMessageCounter := mm_atomic.LoadUint64(&m.afterSendMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendMessageMock.defaultExpectation != nil && afterSendMessageCounter < 1 {
+		if m.SendMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.SendMessage")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.SendMessage with params: %#v", *m.SendMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSendMessage != nil && afterSendMessageCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.SendMessage")
+	}
+
+	if !m.SendMessageMock.invocationsDone() && afterSendMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.SendMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.SendMessageMock.expectedInvocations), afterSendMessageCounter)
+	}
+}
+
+type mChatServiceMockSetMemberRole struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockSetMemberRoleExpectation
+	expectations       []*ChatServiceMockSetMemberRoleExpectation
+
+	callArgs []*ChatServiceMockSetMemberRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockSetMemberRoleExpectation specifies expectation struct of the ChatService.SetMemberRole
+type ChatServiceMockSetMemberRoleExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockSetMemberRoleParams
+	paramPtrs *ChatServiceMockSetMemberRoleParamPtrs
+	results   *ChatServiceMockSetMemberRoleResults
+	Counter   uint64
+}
+
+// ChatServiceMockSetMemberRoleParams contains parameters of the ChatService.SetMemberRole
+type ChatServiceMockSetMemberRoleParams struct {
+	ctx    context.Context
+	chatID int64
+	userID int64
+	role   string
+}
+
+// ChatServiceMockSetMemberRoleParamPtrs contains pointers to parameters of the ChatService.SetMemberRole
+type ChatServiceMockSetMemberRoleParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+	userID *int64
+	role   *string
+}
+
+// ChatServiceMockSetMemberRoleResults contains results of the ChatService.SetMemberRole
+type ChatServiceMockSetMemberRoleResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Optional() *mChatServiceMockSetMemberRole {
+	mmSetMemberRole.optional = true
+	return mmSetMemberRole
+}
+
+// Expect sets up expected params for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Expect(ctx context.Context, chatID int64, userID int64, role string) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by ExpectParams functions")
+	}
+
+	mmSetMemberRole.defaultExpectation.params = &ChatServiceMockSetMemberRoleParams{ctx, chatID, userID, role}
+	for _, e := range mmSetMemberRole.expectations {
+		if minimock.Equal(e.params, mmSetMemberRole.defaultExpectation.params) {
+			mmSetMemberRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetMemberRole.defaultExpectation.params)
+		}
+	}
+
+	return mmSetMemberRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatServiceMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSetMemberRole
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) ExpectChatIDParam2(chatID int64) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatServiceMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmSetMemberRole
+}
+
+// ExpectUserIDParam3 sets up expected param userID for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) ExpectUserIDParam3(userID int64) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatServiceMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmSetMemberRole
+}
+
+// ExpectRoleParam4 sets up expected param role for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) ExpectRoleParam4(role string) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatServiceMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.role = &role
+
+	return mmSetMemberRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Inspect(f func(ctx context.Context, chatID int64, userID int64, role string)) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.inspectFuncSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SetMemberRole")
+	}
+
+	mmSetMemberRole.mock.inspectFuncSetMemberRole = f
+
+	return mmSetMemberRole
+}
+
+// Return sets up results that will be returned by ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Return(err error) *ChatServiceMock {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{mock: mmSetMemberRole.mock}
+	}
+	mmSetMemberRole.defaultExpectation.results = &ChatServiceMockSetMemberRoleResults{err}
+	return mmSetMemberRole.mock
+}
+
+// Set uses given function f to mock the ChatService.SetMemberRole method
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Set(f func(ctx context.Context, chatID int64, userID int64, role string) (err error)) *ChatServiceMock {
+	if mmSetMemberRole.defaultExpectation != nil {
+		mmSetMemberRole.mock.t.Fatalf("Default expectation is already set for the ChatService.SetMemberRole method")
+	}
+
+	if len(mmSetMemberRole.expectations) > 0 {
+		mmSetMemberRole.mock.t.Fatalf("Some expectations are already set for the ChatService.SetMemberRole method")
+	}
+
+	mmSetMemberRole.mock.funcSetMemberRole = f
+	return mmSetMemberRole.mock
+}
+
+// When sets expectation for the ChatService.SetMemberRole which will trigger the result defined by the following
+// Then helper
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) When(ctx context.Context, chatID int64, userID int64, role string) *ChatServiceMockSetMemberRoleExpectation {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockSetMemberRoleExpectation{
+		mock:   mmSetMemberRole.mock,
+		params: &ChatServiceMockSetMemberRoleParams{ctx, chatID, userID, role},
+	}
+	mmSetMemberRole.expectations = append(mmSetMemberRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.SetMemberRole return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSetMemberRoleExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSetMemberRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.SetMemberRole should be invoked
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Times(n uint64) *mChatServiceMockSetMemberRole {
+	if n == 0 {
+		mmSetMemberRole.mock.t.Fatalf("Times of ChatServiceMock.SetMemberRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetMemberRole.expectedInvocations, n)
+	return mmSetMemberRole
+}
+
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) invocationsDone() bool {
+	if len(mmSetMemberRole.expectations) == 0 && mmSetMemberRole.defaultExpectation == nil && mmSetMemberRole.mock.funcSetMemberRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetMemberRole.mock.afterSetMemberRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetMemberRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetMemberRole implements service.ChatService
+func (mmSetMemberRole *ChatServiceMock) SetMemberRole(ctx context.Context, chatID int64, userID int64, role string) (err error) {
+	mm_atomic.AddUint64(&mmSetMemberRole.beforeSetMemberRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetMemberRole.afterSetMemberRoleCounter, 1)
+
+	if mmSetMemberRole.inspectFuncSetMemberRole != nil {
+		mmSetMemberRole.inspectFuncSetMemberRole(ctx, chatID, userID, role)
+	}
+
+	mm_params := ChatServiceMockSetMemberRoleParams{ctx, chatID, userID, role}
+
+	// Record call args
+	mmSetMemberRole.SetMemberRoleMock.mutex.Lock()
+	mmSetMemberRole.SetMemberRoleMock.callArgs = append(mmSetMemberRole.SetMemberRoleMock.callArgs, &mm_params)
+	mmSetMemberRole.SetMemberRoleMock.mutex.Unlock()
+
+	for _, e := range mmSetMemberRole.SetMemberRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetMemberRole.SetMemberRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetMemberRole.SetMemberRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockSetMemberRoleParams{ctx, chatID, userID, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameter role, want: %#v, got: %#v%s\n", *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetMemberRole.t.Fatal("No results are set for the ChatServiceMock.SetMemberRole")
+		}
+		return (*mm_results).err
+	}
+	if mmSetMemberRole.funcSetMemberRole != nil {
+		return mmSetMemberRole.funcSetMemberRole(ctx, chatID, userID, role)
+	}
+	mmSetMemberRole.t.Fatalf("Unexpected call to ChatServiceMock.SetMemberRole. %v %v %v %v", ctx, chatID, userID, role)
+	return
+}
+
+// SetMemberRoleAfterCounter returns a count of finished ChatServiceMock.SetMemberRole invocations
+func (mmSetMemberRole *ChatServiceMock) SetMemberRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMemberRole.afterSetMemberRoleCounter)
+}
+
+// SetMemberRoleBeforeCounter returns a count of ChatServiceMock.SetMemberRole invocations
+func (mmSetMemberRole *ChatServiceMock) SetMemberRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMemberRole.beforeSetMemberRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.SetMemberRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Calls() []*ChatServiceMockSetMemberRoleParams {
+	mmSetMemberRole.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockSetMemberRoleParams, len(mmSetMemberRole.callArgs))
+	copy(argCopy, mmSetMemberRole.callArgs)
+
+	mmSetMemberRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetMemberRoleDone returns true if the count of the SetMemberRole invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockSetMemberRoleDone() bool {
+	if m.SetMemberRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetMemberRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetMemberRoleMock.invocationsDone()
+}
+
+// MinimockSetMemberRoleInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSetMemberRoleInspect() {
+	for _, e := range m.SetMemberRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.SetMemberRole with params: %#v", *e.params)
+		}
+	}
+
+	afterSetMemberRoleCounter := mm_atomic.LoadUint64(&m.afterSetMemberRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetMemberRoleMock.defaultExpectation != nil && afterSetMemberRoleCounter < 1 {
+		if m.SetMemberRoleMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.SetMemberRole")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.SetMemberRole with params: %#v", *m.SetMemberRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetMemberRole != nil && afterSetMemberRoleCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.SetMemberRole")
+	}
+
+	if !m.SetMemberRoleMock.invocationsDone() && afterSetMemberRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.SetMemberRole but found %d calls",
+			mm_atomic.LoadUint64(&m.SetMemberRoleMock.expectedInvocations), afterSetMemberRoleCounter)
+	}
+}
+
+type mChatServiceMockSetMessageTTL struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockSetMessageTTLExpectation
+	expectations       []*ChatServiceMockSetMessageTTLExpectation
+
+	callArgs []*ChatServiceMockSetMessageTTLParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockSetMessageTTLExpectation specifies expectation struct of the ChatService.SetMessageTTL
+type ChatServiceMockSetMessageTTLExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockSetMessageTTLParams
+	paramPtrs *ChatServiceMockSetMessageTTLParamPtrs
+	results   *ChatServiceMockSetMessageTTLResults
+	Counter   uint64
+}
+
+// ChatServiceMockSetMessageTTLParams contains parameters of the ChatService.SetMessageTTL
+type ChatServiceMockSetMessageTTLParams struct {
+	ctx    context.Context
+	chatID int64
+	ttl    time.Duration
+}
+
+// ChatServiceMockSetMessageTTLParamPtrs contains pointers to parameters of the ChatService.SetMessageTTL
+type ChatServiceMockSetMessageTTLParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+	ttl    *time.Duration
+}
+
+// ChatServiceMockSetMessageTTLResults contains results of the ChatService.SetMessageTTL
+type ChatServiceMockSetMessageTTLResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetMessageTTL *mChatServiceMockSetMessageTTL) Optional() *mChatServiceMockSetMessageTTL {
+	mmSetMessageTTL.optional = true
+	return mmSetMessageTTL
+}
+
+// Expect sets up expected params for ChatService.SetMessageTTL
+func (mmSetMessageTTL *mChatServiceMockSetMessageTTL) Expect(ctx context.Context, chatID int64, ttl time.Duration) *mChatServiceMockSetMessageTTL {
+	if mmSetMessageTTL.mock.funcSetMessageTTL != nil {
+		mmSetMessageTTL.mock.t.Fatalf("ChatServiceMock.SetMessageTTL mock is already set by Set")
+	}
+
+	if mmSetMessageTTL.defaultExpectation == nil {
+		mmSetMessageTTL.defaultExpectation = &ChatServiceMockSetMessageTTLExpectation{}
+	}
+
+	if mmSetMessageTTL.defaultExpectation.paramPtrs != nil {
+		mmSetMessageTTL.mock.t.Fatalf("ChatServiceMock.SetMessageTTL mock is already set by ExpectParams functions")
+	}
+
+	mmSetMessageTTL.defaultExpectation.params = &ChatServiceMockSetMessageTTLParams{ctx, chatID, ttl}
+	for _, e := range mmSetMessageTTL.expectations {
+		if minimock.Equal(e.params, mmSetMessageTTL.defaultExpectation.params) {
+			mmSetMessageTTL.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetMessageTTL.defaultExpectation.params)
+		}
+	}
+
+	return mmSetMessageTTL
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.SetMessageTTL
+func (mmSetMessageTTL *mChatServiceMockSetMessageTTL) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSetMessageTTL {
+	if mmSetMessageTTL.mock.funcSetMessageTTL != nil {
+		mmSetMessageTTL.mock.t.Fatalf("ChatServiceMock.SetMessageTTL mock is already set by Set")
+	}
+
+	if mmSetMessageTTL.defaultExpectation == nil {
+		mmSetMessageTTL.defaultExpectation = &ChatServiceMockSetMessageTTLExpectation{}
+	}
+
+	if mmSetMessageTTL.defaultExpectation.params != nil {
+		mmSetMessageTTL.mock.t.Fatalf("ChatServiceMock.SetMessageTTL mock is already set by Expect")
+	}
+
+	if mmSetMessageTTL.defaultExpectation.paramPtrs == nil {
+		mmSetMessageTTL.defaultExpectation.paramPtrs = &ChatServiceMockSetMessageTTLParamPtrs{}
+	}
+	mmSetMessageTTL.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSetMessageTTL
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.SetMessageTTL
+func (mmSetMessageTTL *mChatServiceMockSetMessageTTL) ExpectChatIDParam2(chatID int64) *mChatServiceMockSetMessageTTL {
+	if mmSetMessageTTL.mock.funcSetMessageTTL != nil {
+		mmSetMessageTTL.mock.t.Fatalf("ChatServiceMock.SetMessageTTL mock is already set by Set")
+	}
+
+	if mmSetMessageTTL.defaultExpectation == nil {
+		mmSetMessageTTL.defaultExpectation = &ChatServiceMockSetMessageTTLExpectation{}
+	}
+
+	if mmSetMessageTTL.defaultExpectation.params != nil {
+		mmSetMessageTTL.mock.t.Fatalf("ChatServiceMock.SetMessageTTL mock is already set by Expect")
+	}
+
+	if mmSetMessageTTL.defaultExpectation.paramPtrs == nil {
+		mmSetMessageTTL.defaultExpectation.paramPtrs = &ChatServiceMockSetMessageTTLParamPtrs{}
+	}
+	mmSetMessageTTL.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmSetMessageTTL
+}
+
+// ExpectTtlParam3 sets up expected param ttl for ChatService.SetMessageTTL
+func (mmSetMessageTTL *mChatServiceMockSetMessageTTL) ExpectTtlParam3(ttl time.Duration) *mChatServiceMockSetMessageTTL {
+	if mmSetMessageTTL.mock.funcSetMessageTTL != nil {
+		mmSetMessageTTL.mock.t.Fatalf("ChatServiceMock.SetMessageTTL mock is already set by Set")
+	}
+
+	if mmSetMessageTTL.defaultExpectation == nil {
+		mmSetMessageTTL.defaultExpectation = &ChatServiceMockSetMessageTTLExpectation{}
+	}
+
+	if mmSetMessageTTL.defaultExpectation.params != nil {
+		mmSetMessageTTL.mock.t.Fatalf("ChatServiceMock.SetMessageTTL mock is already set by Expect")
+	}
+
+	if mmSetMessageTTL.defaultExpectation.paramPtrs == nil {
+		mmSetMessageTTL.defaultExpectation.paramPtrs = &ChatServiceMockSetMessageTTLParamPtrs{}
+	}
+	mmSetMessageTTL.defaultExpectation.paramPtrs.ttl = &ttl
+
+	return mmSetMessageTTL
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.SetMessageTTL
+func (mmSetMessageTTL *mChatServiceMockSetMessageTTL) Inspect(f func(ctx context.Context, chatID int64, ttl time.Duration)) *mChatServiceMockSetMessageTTL {
+	if mmSetMessageTTL.mock.inspectFuncSetMessageTTL != nil {
+		mmSetMessageTTL.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SetMessageTTL")
+	}
+
+	mmSetMessageTTL.mock.inspectFuncSetMessageTTL = f
+
+	return mmSetMessageTTL
+}
+
+// Return sets up results that will be returned by ChatService.SetMessageTTL
+func (mmSetMessageTTL *mChatServiceMockSetMessageTTL) Return(err error) *ChatServiceMock {
+	if mmSetMessageTTL.mock.funcSetMessageTTL != nil {
+		mmSetMessageTTL.mock.t.Fatalf("ChatServiceMock.SetMessageTTL mock is already set by Set")
+	}
+
+	if mmSetMessageTTL.defaultExpectation == nil {
+		mmSetMessageTTL.defaultExpectation = &ChatServiceMockSetMessageTTLExpectation{mock: mmSetMessageTTL.mock}
+	}
+	mmSetMessageTTL.defaultExpectation.results = &ChatServiceMockSetMessageTTLResults{err}
+	return mmSetMessageTTL.mock
+}
+
+// Set uses given function f to mock the ChatService.SetMessageTTL method
+func (mmSetMessageTTL *mChatServiceMockSetMessageTTL) Set(f func(ctx context.Context, chatID int64, ttl time.Duration) (err error)) *ChatServiceMock {
+	if mmSetMessageTTL.defaultExpectation != nil {
+		mmSetMessageTTL.mock.t.Fatalf("Default expectation is already set for the ChatService.SetMessageTTL method")
+	}
+
+	if len(mmSetMessageTTL.expectations) > 0 {
+		mmSetMessageTTL.mock.t.Fatalf("Some expectations are already set for the ChatService.SetMessageTTL method")
+	}
+
+	mmSetMessageTTL.mock.funcSetMessageTTL = f
+	return mmSetMessageTTL.mock
+}
+
+// When sets expectation for the ChatService.SetMessageTTL which will trigger the result defined by the following
+// Then helper
+func (mmSetMessageTTL *mChatServiceMockSetMessageTTL) When(ctx context.Context, chatID int64, ttl time.Duration) *ChatServiceMockSetMessageTTLExpectation {
+	if mmSetMessageTTL.mock.funcSetMessageTTL != nil {
+		mmSetMessageTTL.mock.t.Fatalf("ChatServiceMock.SetMessageTTL mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockSetMessageTTLExpectation{
+		mock:   mmSetMessageTTL.mock,
+		params: &ChatServiceMockSetMessageTTLParams{ctx, chatID, ttl},
+	}
+	mmSetMessageTTL.expectations = append(mmSetMessageTTL.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.SetMessageTTL return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSetMessageTTLExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSetMessageTTLResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.SetMessageTTL should be invoked
+func (mmSetMessageTTL *mChatServiceMockSetMessageTTL) Times(n uint64) *mChatServiceMockSetMessageTTL {
+	if n == 0 {
+		mmSetMessageTTL.mock.t.Fatalf("Times of ChatServiceMock.SetMessageTTL mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetMessageTTL.expectedInvocations, n)
+	return mmSetMessageTTL
+}
+
+func (mmSetMessageTTL *mChatServiceMockSetMessageTTL) invocationsDone() bool {
+	if len(mmSetMessageTTL.expectations) == 0 && mmSetMessageTTL.defaultExpectation == nil && mmSetMessageTTL.mock.funcSetMessageTTL == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetMessageTTL.mock.afterSetMessageTTLCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetMessageTTL.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetMessageTTL implements service.ChatService
+func (mmSetMessageTTL *ChatServiceMock) SetMessageTTL(ctx context.Context, chatID int64, ttl time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmSetMessageTTL.beforeSetMessageTTLCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetMessageTTL.afterSetMessageTTLCounter, 1)
+
+	if mmSetMessageTTL.inspectFuncSetMessageTTL != nil {
+		mmSetMessageTTL.inspectFuncSetMessageTTL(ctx, chatID, ttl)
+	}
+
+	mm_params := ChatServiceMockSetMessageTTLParams{ctx, chatID, ttl}
+
+	// Record call args
+	mmSetMessageTTL.SetMessageTTLMock.mutex.Lock()
+	mmSetMessageTTL.SetMessageTTLMock.callArgs = append(mmSetMessageTTL.SetMessageTTLMock.callArgs, &mm_params)
+	mmSetMessageTTL.SetMessageTTLMock.mutex.Unlock()
+
+	for _, e := range mmSetMessageTTL.SetMessageTTLMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetMessageTTL.SetMessageTTLMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetMessageTTL.SetMessageTTLMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetMessageTTL.SetMessageTTLMock.defaultExpectation.params
+		mm_want_ptrs := mmSetMessageTTL.SetMessageTTLMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockSetMessageTTLParams{ctx, chatID, ttl}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetMessageTTL.t.Errorf("ChatServiceMock.SetMessageTTL got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmSetMessageTTL.t.Errorf("ChatServiceMock.SetMessageTTL got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.ttl != nil && !minimock.Equal(*mm_want_ptrs.ttl, mm_got.ttl) {
+				mmSetMessageTTL.t.Errorf("ChatServiceMock.SetMessageTTL got unexpected parameter ttl, want: %#v, got: %#v%s\n", *mm_want_ptrs.ttl, mm_got.ttl, minimock.Diff(*mm_want_ptrs.ttl, mm_got.ttl))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetMessageTTL.t.Errorf("ChatServiceMock.SetMessageTTL got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetMessageTTL.SetMessageTTLMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetMessageTTL.t.Fatal("No results are set for the ChatServiceMock.SetMessageTTL")
+		}
+		return (*mm_results).err
+	}
+	if mmSetMessageTTL.funcSetMessageTTL != nil {
+		return mmSetMessageTTL.funcSetMessageTTL(ctx, chatID, ttl)
+	}
+	mmSetMessageTTL.t.Fatalf("Unexpected call to ChatServiceMock.SetMessageTTL. %v %v %v", ctx, chatID, ttl)
+	return
+}
+
+// SetMessageTTLAfterCounter returns a count of finished ChatServiceMock.SetMessageTTL invocations
+func (mmSetMessageTTL *ChatServiceMock) SetMessageTTLAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMessageTTL.afterSetMessageTTLCounter)
+}
+
+// SetMessageTTLBeforeCounter returns a count of ChatServiceMock.SetMessageTTL invocations
+func (mmSetMessageTTL *ChatServiceMock) SetMessageTTLBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMessageTTL.beforeSetMessageTTLCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.SetMessageTTL.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetMessageTTL *mChatServiceMockSetMessageTTL) Calls() []*ChatServiceMockSetMessageTTLParams {
+	mmSetMessageTTL.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockSetMessageTTLParams, len(mmSetMessageTTL.callArgs))
+	copy(argCopy, mmSetMessageTTL.callArgs)
+
+	mmSetMessageTTL.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetMessageTTLDone returns true if the count of the SetMessageTTL invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockSetMessageTTLDone() bool {
+	if m.SetMessageTTLMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetMessageTTLMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetMessageTTLMock.invocationsDone()
+}
+
+// MinimockSetMessageTTLInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSetMessageTTLInspect() {
+	for _, e := range m.SetMessageTTLMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.SetMessageTTL with params: %#v", *e.params)
+		}
+	}
+
+	afterSetMessageTTLCounter := mm_atomic.LoadUint64(&m.afterSetMessageTTLCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetMessageTTLMock.defaultExpectation != nil && afterSetMessageTTLCounter < 1 {
+		if m.SetMessageTTLMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.SetMessageTTL")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.SetMessageTTL with params: %#v", *m.SetMessageTTLMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetMessageTTL != nil && afterSetMessageTTLCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.SetMessageTTL")
+	}
+
+	if !m.SetMessageTTLMock.invocationsDone() && afterSetMessageTTLCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.SetMessageTTL but found %d calls",
+			mm_atomic.LoadUint64(&m.SetMessageTTLMock.expectedInvocations), afterSetMessageTTLCounter)
+	}
+}
+
+type mChatServiceMockSetTyping struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockSetTypingExpectation
+	expectations       []*ChatServiceMockSetTypingExpectation
+
+	callArgs []*ChatServiceMockSetTypingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockSetTypingExpectation specifies expectation struct of the ChatService.SetTyping
+type ChatServiceMockSetTypingExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockSetTypingParams
+	paramPtrs *ChatServiceMockSetTypingParamPtrs
+	results   *ChatServiceMockSetTypingResults
+	Counter   uint64
+}
+
+// ChatServiceMockSetTypingParams contains parameters of the ChatService.SetTyping
+type ChatServiceMockSetTypingParams struct {
+	ctx      context.Context
+	chatID   int64
+	userID   int64
+	isTyping bool
+}
+
+// ChatServiceMockSetTypingParamPtrs contains pointers to parameters of the ChatService.SetTyping
+type ChatServiceMockSetTypingParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	userID   *int64
+	isTyping *bool
+}
+
+// ChatServiceMockSetTypingResults contains results of the ChatService.SetTyping
+type ChatServiceMockSetTypingResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetTyping *mChatServiceMockSetTyping) Optional() *mChatServiceMockSetTyping {
+	mmSetTyping.optional = true
+	return mmSetTyping
+}
+
+// Expect sets up expected params for ChatService.SetTyping
+func (mmSetTyping *mChatServiceMockSetTyping) Expect(ctx context.Context, chatID int64, userID int64, isTyping bool) *mChatServiceMockSetTyping {
+	if mmSetTyping.mock.funcSetTyping != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Set")
+	}
+
+	if mmSetTyping.defaultExpectation == nil {
+		mmSetTyping.defaultExpectation = &ChatServiceMockSetTypingExpectation{}
+	}
+
+	if mmSetTyping.defaultExpectation.paramPtrs != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by ExpectParams functions")
+	}
+
+	mmSetTyping.defaultExpectation.params = &ChatServiceMockSetTypingParams{ctx, chatID, userID, isTyping}
+	for _, e := range mmSetTyping.expectations {
+		if minimock.Equal(e.params, mmSetTyping.defaultExpectation.params) {
+			mmSetTyping.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetTyping.defaultExpectation.params)
+		}
+	}
+
+	return mmSetTyping
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.SetTyping
+func (mmSetTyping *mChatServiceMockSetTyping) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSetTyping {
+	if mmSetTyping.mock.funcSetTyping != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Set")
+	}
+
+	if mmSetTyping.defaultExpectation == nil {
+		mmSetTyping.defaultExpectation = &ChatServiceMockSetTypingExpectation{}
+	}
+
+	if mmSetTyping.defaultExpectation.params != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Expect")
+	}
+
+	if mmSetTyping.defaultExpectation.paramPtrs == nil {
+		mmSetTyping.defaultExpectation.paramPtrs = &ChatServiceMockSetTypingParamPtrs{}
+	}
+	mmSetTyping.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSetTyping
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.SetTyping
+func (mmSetTyping *mChatServiceMockSetTyping) ExpectChatIDParam2(chatID int64) *mChatServiceMockSetTyping {
+	if mmSetTyping.mock.funcSetTyping != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Set")
+	}
+
+	if mmSetTyping.defaultExpectation == nil {
+		mmSetTyping.defaultExpectation = &ChatServiceMockSetTypingExpectation{}
+	}
+
+	if mmSetTyping.defaultExpectation.params != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Expect")
+	}
+
+	if mmSetTyping.defaultExpectation.paramPtrs == nil {
+		mmSetTyping.defaultExpectation.paramPtrs = &ChatServiceMockSetTypingParamPtrs{}
+	}
+	mmSetTyping.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmSetTyping
+}
+
+// ExpectUserIDParam3 sets up expected param userID for ChatService.SetTyping
+func (mmSetTyping *mChatServiceMockSetTyping) ExpectUserIDParam3(userID int64) *mChatServiceMockSetTyping {
+	if mmSetTyping.mock.funcSetTyping != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Set")
+	}
+
+	if mmSetTyping.defaultExpectation == nil {
+		mmSetTyping.defaultExpectation = &ChatServiceMockSetTypingExpectation{}
+	}
+
+	if mmSetTyping.defaultExpectation.params != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Expect")
+	}
+
+	if mmSetTyping.defaultExpectation.paramPtrs == nil {
+		mmSetTyping.defaultExpectation.paramPtrs = &ChatServiceMockSetTypingParamPtrs{}
+	}
+	mmSetTyping.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmSetTyping
+}
+
+// ExpectIsTypingParam4 sets up expected param isTyping for ChatService.SetTyping
+func (mmSetTyping *mChatServiceMockSetTyping) ExpectIsTypingParam4(isTyping bool) *mChatServiceMockSetTyping {
+	if mmSetTyping.mock.funcSetTyping != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Set")
+	}
+
+	if mmSetTyping.defaultExpectation == nil {
+		mmSetTyping.defaultExpectation = &ChatServiceMockSetTypingExpectation{}
+	}
+
+	if mmSetTyping.defaultExpectation.params != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Expect")
+	}
+
+	if mmSetTyping.defaultExpectation.paramPtrs == nil {
+		mmSetTyping.defaultExpectation.paramPtrs = &ChatServiceMockSetTypingParamPtrs{}
+	}
+	mmSetTyping.defaultExpectation.paramPtrs.isTyping = &isTyping
+
+	return mmSetTyping
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.SetTyping
+func (mmSetTyping *mChatServiceMockSetTyping) Inspect(f func(ctx context.Context, chatID int64, userID int64, isTyping bool)) *mChatServiceMockSetTyping {
+	if mmSetTyping.mock.inspectFuncSetTyping != nil {
+		mmSetTyping.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SetTyping")
+	}
+
+	mmSetTyping.mock.inspectFuncSetTyping = f
+
+	return mmSetTyping
+}
+
+// Return sets up results that will be returned by ChatService.SetTyping
+func (mmSetTyping *mChatServiceMockSetTyping) Return(err error) *ChatServiceMock {
+	if mmSetTyping.mock.funcSetTyping != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Set")
+	}
+
+	if mmSetTyping.defaultExpectation == nil {
+		mmSetTyping.defaultExpectation = &ChatServiceMockSetTypingExpectation{mock: mmSetTyping.mock}
+	}
+	mmSetTyping.defaultExpectation.results = &ChatServiceMockSetTypingResults{err}
+	return mmSetTyping.mock
+}
+
+// Set uses given function f to mock the ChatService.SetTyping method
+func (mmSetTyping *mChatServiceMockSetTyping) Set(f func(ctx context.Context, chatID int64, userID int64, isTyping bool) (err error)) *ChatServiceMock {
+	if mmSetTyping.defaultExpectation != nil {
+		mmSetTyping.mock.t.Fatalf("Default expectation is already set for the ChatService.SetTyping method")
+	}
+
+	if len(mmSetTyping.expectations) > 0 {
+		mmSetTyping.mock.t.Fatalf("Some expectations are already set for the ChatService.SetTyping method")
+	}
+
+	mmSetTyping.mock.funcSetTyping = f
+	return mmSetTyping.mock
+}
+
+// When sets expectation for the ChatService.SetTyping which will trigger the result defined by the following
+// Then helper
+func (mmSetTyping *mChatServiceMockSetTyping) When(ctx context.Context, chatID int64, userID int64, isTyping bool) *ChatServiceMockSetTypingExpectation {
+	if mmSetTyping.mock.funcSetTyping != nil {
+		mmSetTyping.mock.t.Fatalf("ChatServiceMock.SetTyping mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockSetTypingExpectation{
+		mock:   mmSetTyping.mock,
+		params: &ChatServiceMockSetTypingParams{ctx, chatID, userID, isTyping},
+	}
+	mmSetTyping.expectations = append(mmSetTyping.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.SetTyping return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSetTypingExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSetTypingResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.SetTyping should be invoked
+func (mmSetTyping *mChatServiceMockSetTyping) Times(n uint64) *mChatServiceMockSetTyping {
+	if n == 0 {
+		mmSetTyping.mock.t.Fatalf("Times of ChatServiceMock.SetTyping mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetTyping.expectedInvocations, n)
+	return mmSetTyping
+}
+
+func (mmSetTyping *mChatServiceMockSetTyping) invocationsDone() bool {
+	if len(mmSetTyping.expectations) == 0 && mmSetTyping.defaultExpectation == nil && mmSetTyping.mock.funcSetTyping == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetTyping.mock.afterSetTypingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetTyping.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetTyping implements service.ChatService
+func (mmSetTyping *ChatServiceMock) SetTyping(ctx context.Context, chatID int64, userID int64, isTyping bool) (err error) {
+	mm_atomic.AddUint64(&mmSetTyping.beforeSetTypingCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetTyping.afterSetTypingCounter, 1)
+
+	if mmSetTyping.inspectFuncSetTyping != nil {
+		mmSetTyping.inspectFuncSetTyping(ctx, chatID, userID, isTyping)
+	}
+
+	mm_params := ChatServiceMockSetTypingParams{ctx, chatID, userID, isTyping}
+
+	// Record call args
+	mmSetTyping.SetTypingMock.mutex.Lock()
+	mmSetTyping.SetTypingMock.callArgs = append(mmSetTyping.SetTypingMock.callArgs, &mm_params)
+	mmSetTyping.SetTypingMock.mutex.Unlock()
+
+	for _, e := range mmSetTyping.SetTypingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetTyping.SetTypingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetTyping.SetTypingMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetTyping.SetTypingMock.defaultExpectation.params
+		mm_want_ptrs := mmSetTyping.SetTypingMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockSetTypingParams{ctx, chatID, userID, isTyping}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetTyping.t.Errorf("ChatServiceMock.SetTyping got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmSetTyping.t.Errorf("ChatServiceMock.SetTyping got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSetTyping.t.Errorf("ChatServiceMock.SetTyping got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.isTyping != nil && !minimock.Equal(*mm_want_ptrs.isTyping, mm_got.isTyping) {
+				mmSetTyping.t.Errorf("ChatServiceMock.SetTyping got unexpected parameter isTyping, want: %#v, got: %#v%s\n", *mm_want_ptrs.isTyping, mm_got.isTyping, minimock.Diff(*mm_want_ptrs.isTyping, mm_got.isTyping))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetTyping.t.Errorf("ChatServiceMock.SetTyping got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetTyping.SetTypingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetTyping.t.Fatal("No results are set for the ChatServiceMock.SetTyping")
+		}
+		return (*mm_results).err
+	}
+	if mmSetTyping.funcSetTyping != nil {
+		return mmSetTyping.funcSetTyping(ctx, chatID, userID, isTyping)
+	}
+	mmSetTyping.t.Fatalf("Unexpected call to ChatServiceMock.SetTyping. %v %v %v %v", ctx, chatID, userID, isTyping)
+	return
+}
+
+// SetTypingAfterCounter returns a count of finished ChatServiceMock.SetTyping invocations
+func (mmSetTyping *ChatServiceMock) SetTypingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetTyping.afterSetTypingCounter)
+}
+
+// SetTypingBeforeCounter returns a count of ChatServiceMock.SetTyping invocations
+func (mmSetTyping *ChatServiceMock) SetTypingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetTyping.beforeSetTypingCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.SetTyping.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetTyping *mChatServiceMockSetTyping) Calls() []*ChatServiceMockSetTypingParams {
+	mmSetTyping.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockSetTypingParams, len(mmSetTyping.callArgs))
+	copy(argCopy, mmSetTyping.callArgs)
+
+	mmSetTyping.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetTypingDone returns true if the count of the SetTyping invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockSetTypingDone() bool {
+	if m.SetTypingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetTypingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetTypingMock.invocationsDone()
+}
+
+// MinimockSetTypingInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSetTypingInspect() {
+	for _, e := range m.SetTypingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.SetTyping with params: %#v", *e.params)
+		}
+	}
+
+	afterSetTypingCounter := mm_atomic.LoadUint64(&m.afterSetTypingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetTypingMock.defaultExpectation != nil && afterSetTypingCounter < 1 {
+		if m.SetTypingMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.SetTyping")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.SetTyping with params: %#v", *m.SetTypingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetTyping != nil && afterSetTypingCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.SetTyping")
+	}
+
+	if !m.SetTypingMock.invocationsDone() && afterSetTypingCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.SetTyping but found %d calls",
+			mm_atomic.LoadUint64(&m.SetTypingMock.expectedInvocations), afterSetTypingCounter)
+	}
+}
+
+type mChatServiceMockStoreMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockStoreMessageExpectation
+	expectations       []*ChatServiceMockStoreMessageExpectation
+
+	callArgs []*ChatServiceMockStoreMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockStoreMessageExpectation specifies expectation struct of the ChatService.StoreMessage
+type ChatServiceMockStoreMessageExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockStoreMessageParams
+	paramPtrs *ChatServiceMockStoreMessageParamPtrs
+	results   *ChatServiceMockStoreMessageResults
+	Counter   uint64
+}
+
+// ChatServiceMockStoreMessageParams contains parameters of the ChatService.StoreMessage
+type ChatServiceMockStoreMessageParams struct {
+	ctx           context.Context
+	createMessage *model.MessageCreate
+}
+
+// ChatServiceMockStoreMessageParamPtrs contains pointers to parameters of the ChatService.StoreMessage
+type ChatServiceMockStoreMessageParamPtrs struct {
+	ctx           *context.Context
+	createMessage **model.MessageCreate
+}
+
+// ChatServiceMockStoreMessageResults contains results of the ChatService.StoreMessage
+type ChatServiceMockStoreMessageResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmStoreMessage *mChatServiceMockStoreMessage) Optional() *mChatServiceMockStoreMessage {
+	mmStoreMessage.optional = true
+	return mmStoreMessage
+}
+
+// Expect sets up expected params for ChatService.StoreMessage
+func (mmStoreMessage *mChatServiceMockStoreMessage) Expect(ctx context.Context, createMessage *model.MessageCreate) *mChatServiceMockStoreMessage {
+	if mmStoreMessage.mock.funcStoreMessage != nil {
+		mmStoreMessage.mock.t.Fatalf("ChatServiceMock.StoreMessage mock is already set by Set")
+	}
+
+	if mmStoreMessage.defaultExpectation == nil {
+		mmStoreMessage.defaultExpectation = &ChatServiceMockStoreMessageExpectation{}
+	}
+
+	if mmStoreMessage.defaultExpectation.paramPtrs != nil {
+		mmStoreMessage.mock.t.Fatalf("ChatServiceMock.StoreMessage mock is already set by ExpectParams functions")
+	}
+
+	mmStoreMessage.defaultExpectation.params = &ChatServiceMockStoreMessageParams{ctx, createMessage}
+	for _, e := range mmStoreMessage.expectations {
+		if minimock.Equal(e.params, mmStoreMessage.defaultExpectation.params) {
+			mmStoreMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStoreMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmStoreMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.StoreMessage
+func (mmStoreMessage *mChatServiceMockStoreMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockStoreMessage {
+	if mmStoreMessage.mock.funcStoreMessage != nil {
+		mmStoreMessage.mock.t.Fatalf("ChatServiceMock.StoreMessage mock is already set by Set")
+	}
+
+	if mmStoreMessage.defaultExpectation == nil {
+		mmStoreMessage.defaultExpectation = &ChatServiceMockStoreMessageExpectation{}
+	}
+
+	if mmStoreMessage.defaultExpectation.params != nil {
+		mmStoreMessage.mock.t.Fatalf("ChatServiceMock.StoreMessage mock is already set by Expect")
+	}
+
+	if mmStoreMessage.defaultExpectation.paramPtrs == nil {
+		mmStoreMessage.defaultExpectation.paramPtrs = &ChatServiceMockStoreMessageParamPtrs{}
+	}
+	mmStoreMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmStoreMessage
+}
+
+// ExpectCreateMessageParam2 sets up expected param createMessage for ChatService.StoreMessage
+func (mmStoreMessage *mChatServiceMockStoreMessage) ExpectCreateMessageParam2(createMessage *model.MessageCreate) *mChatServiceMockStoreMessage {
+	if mmStoreMessage.mock.funcStoreMessage != nil {
+		mmStoreMessage.mock.t.Fatalf("ChatServiceMock.StoreMessage mock is already set by Set")
+	}
+
+	if mmStoreMessage.defaultExpectation == nil {
+		mmStoreMessage.defaultExpectation = &ChatServiceMockStoreMessageExpectation{}
+	}
+
+	if mmStoreMessage.defaultExpectation.params != nil {
+		mmStoreMessage.mock.t.Fatalf("ChatServiceMock.StoreMessage mock is already set by Expect")
+	}
+
+	if mmStoreMessage.defaultExpectation.paramPtrs == nil {
+		mmStoreMessage.defaultExpectation.paramPtrs = &ChatServiceMockStoreMessageParamPtrs{}
+	}
+	mmStoreMessage.defaultExpectation.paramPtrs.createMessage = &createMessage
+
+	return mmStoreMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.StoreMessage
+func (mmStoreMessage *mChatServiceMockStoreMessage) Inspect(f func(ctx context.Context, createMessage *model.MessageCreate)) *mChatServiceMockStoreMessage {
+	if mmStoreMessage.mock.inspectFuncStoreMessage != nil {
+		mmStoreMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.StoreMessage")
+	}
+
+	mmStoreMessage.mock.inspectFuncStoreMessage = f
+
+	return mmStoreMessage
+}
+
+// Return sets up results that will be returned by ChatService.StoreMessage
+func (mmStoreMessage *mChatServiceMockStoreMessage) Return(mp1 *model.Message, err error) *ChatServiceMock {
+	if mmStoreMessage.mock.funcStoreMessage != nil {
+		mmStoreMessage.mock.t.Fatalf("ChatServiceMock.StoreMessage mock is already set by Set")
+	}
+
+	if mmStoreMessage.defaultExpectation == nil {
+		mmStoreMessage.defaultExpectation = &ChatServiceMockStoreMessageExpectation{mock: mmStoreMessage.mock}
+	}
+	mmStoreMessage.defaultExpectation.results = &ChatServiceMockStoreMessageResults{mp1, err}
+	return mmStoreMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.StoreMessage method
+func (mmStoreMessage *mChatServiceMockStoreMessage) Set(f func(ctx context.Context, createMessage *model.MessageCreate) (mp1 *model.Message, err error)) *ChatServiceMock {
+	if mmStoreMessage.defaultExpectation != nil {
+		mmStoreMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.StoreMessage method")
+	}
+
+	if len(mmStoreMessage.expectations) > 0 {
+		mmStoreMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.StoreMessage method")
+	}
+
+	mmStoreMessage.mock.funcStoreMessage = f
+	return mmStoreMessage.mock
+}
+
+// When sets expectation for the ChatService.StoreMessage which will trigger the result defined by the following
+// Then helper
+func (mmStoreMessage *mChatServiceMockStoreMessage) When(ctx context.Context, createMessage *model.MessageCreate) *ChatServiceMockStoreMessageExpectation {
+	if mmStoreMessage.mock.funcStoreMessage != nil {
+		mmStoreMessage.mock.t.Fatalf("ChatServiceMock.StoreMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockStoreMessageExpectation{
+		mock:   mmStoreMessage.mock,
+		params: &ChatServiceMockStoreMessageParams{ctx, createMessage},
+	}
+	mmStoreMessage.expectations = append(mmStoreMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.StoreMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockStoreMessageExpectation) Then(mp1 *model.Message, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockStoreMessageResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.StoreMessage should be invoked
+func (mmStoreMessage *mChatServiceMockStoreMessage) Times(n uint64) *mChatServiceMockStoreMessage {
+	if n == 0 {
+		mmStoreMessage.mock.t.Fatalf("Times of ChatServiceMock.StoreMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmStoreMessage.expectedInvocations, n)
+	return mmStoreMessage
+}
+
+func (mmStoreMessage *mChatServiceMockStoreMessage) invocationsDone() bool {
+	if len(mmStoreMessage.expectations) == 0 && mmStoreMessage.defaultExpectation == nil && mmStoreMessage.mock.funcStoreMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmStoreMessage.mock.afterStoreMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmStoreMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// StoreMessage implements service.ChatService
+func (mmStoreMessage *ChatServiceMock) StoreMessage(ctx context.Context, createMessage *model.MessageCreate) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmStoreMessage.beforeStoreMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmStoreMessage.afterStoreMessageCounter, 1)
+
+	if mmStoreMessage.inspectFuncStoreMessage != nil {
+		mmStoreMessage.inspectFuncStoreMessage(ctx, createMessage)
+	}
+
+	mm_params := ChatServiceMockStoreMessageParams{ctx, createMessage}
+
+	// Record call args
+	mmStoreMessage.StoreMessageMock.mutex.Lock()
+	mmStoreMessage.StoreMessageMock.callArgs = append(mmStoreMessage.StoreMessageMock.callArgs, &mm_params)
+	mmStoreMessage.StoreMessageMock.mutex.Unlock()
+
+	for _, e := range mmStoreMessage.StoreMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmStoreMessage.StoreMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStoreMessage.StoreMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmStoreMessage.StoreMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmStoreMessage.StoreMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockStoreMessageParams{ctx, createMessage}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmStoreMessage.t.Errorf("ChatServiceMock.StoreMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.createMessage != nil && !minimock.Equal(*mm_want_ptrs.createMessage, mm_got.createMessage) {
+				mmStoreMessage.t.Errorf("ChatServiceMock.StoreMessage got unexpected parameter createMessage, want: %#v, got: %#v%s\n", *mm_want_ptrs.createMessage, mm_got.createMessage, minimock.Diff(*mm_want_ptrs.createMessage, mm_got.createMessage))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmStoreMessage.t.Errorf("ChatServiceMock.StoreMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmStoreMessage.StoreMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStoreMessage.t.Fatal("No results are set for the ChatServiceMock.StoreMessage")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmStoreMessage.funcStoreMessage != nil {
+		return mmStoreMessage.funcStoreMessage(ctx, createMessage)
+	}
+	mmStoreMessage.t.Fatalf("Unexpected call to ChatServiceMock.StoreMessage. %v %v", ctx, createMessage)
+	return
+}
+
+// StoreMessageAfterCounter returns a count of finished ChatServiceMock.StoreMessage invocations
+func (mmStoreMessage *ChatServiceMock) StoreMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStoreMessage.afterStoreMessageCounter)
+}
+
+// StoreMessageBeforeCounter returns a count of ChatServiceMock.StoreMessage invocations
+func (mmStoreMessage *ChatServiceMock) StoreMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStoreMessage.beforeStoreMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.StoreMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmStoreMessage *mChatServiceMockStoreMessage) Calls() []*ChatServiceMockStoreMessageParams {
+	mmStoreMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockStoreMessageParams, len(mmStoreMessage.callArgs))
+	copy(argCopy, mmStoreMessage.callArgs)
+
+	mmStoreMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockStoreMessageDone returns true if the count of the StoreMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockStoreMessageDone() bool {
+	if m.StoreMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.StoreMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.StoreMessageMock.invocationsDone()
+}
+
+// MinimockStoreMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockStoreMessageInspect() {
+	for _, e := range m.StoreMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.StoreMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterStoreMessageCounter := mm_atomic.LoadUint64(&m.afterStoreMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StoreMessageMock.defaultExpectation != nil && afterStoreMessageCounter < 1 {
+		if m.StoreMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.StoreMessage")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.StoreMessage with params: %#v", *m.StoreMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStoreMessage != nil && afterStoreMessageCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.StoreMessage")
+	}
+
+	if !m.StoreMessageMock.invocationsDone() && afterStoreMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.StoreMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.StoreMessageMock.expectedInvocations), afterStoreMessageCounter)
+	}
+}
+
+type mChatServiceMockTransferOwnership struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockTransferOwnershipExpectation
+	expectations       []*ChatServiceMockTransferOwnershipExpectation
+
+	callArgs []*ChatServiceMockTransferOwnershipParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockTransferOwnershipExpectation specifies expectation struct of the ChatService.TransferOwnership
+type ChatServiceMockTransferOwnershipExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockTransferOwnershipParams
+	paramPtrs *ChatServiceMockTransferOwnershipParamPtrs
+	results   *ChatServiceMockTransferOwnershipResults
+	Counter   uint64
+}
+
+// ChatServiceMockTransferOwnershipParams contains parameters of the ChatService.TransferOwnership
+type ChatServiceMockTransferOwnershipParams struct {
+	ctx    context.Context
+	chatID int64
+	userID int64
+}
+
+// ChatServiceMockTransferOwnershipParamPtrs contains pointers to parameters of the ChatService.TransferOwnership
+type ChatServiceMockTransferOwnershipParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+	userID *int64
+}
+
+// ChatServiceMockTransferOwnershipResults contains results of the ChatService.TransferOwnership
+type ChatServiceMockTransferOwnershipResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Optional() *mChatServiceMockTransferOwnership {
+	mmTransferOwnership.optional = true
+	return mmTransferOwnership
+}
+
+// Expect sets up expected params for ChatService.TransferOwnership
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Expect(ctx context.Context, chatID int64, userID int64) *mChatServiceMockTransferOwnership {
+	if mmTransferOwnership.mock.funcTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Set")
+	}
+
+	if mmTransferOwnership.defaultExpectation == nil {
+		mmTransferOwnership.defaultExpectation = &ChatServiceMockTransferOwnershipExpectation{}
+	}
+
+	if mmTransferOwnership.defaultExpectation.paramPtrs != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by ExpectParams functions")
+	}
+
+	mmTransferOwnership.defaultExpectation.params = &ChatServiceMockTransferOwnershipParams{ctx, chatID, userID}
+	for _, e := range mmTransferOwnership.expectations {
+		if minimock.Equal(e.params, mmTransferOwnership.defaultExpectation.params) {
+			mmTransferOwnership.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTransferOwnership.defaultExpectation.params)
+		}
+	}
+
+	return mmTransferOwnership
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.TransferOwnership
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) ExpectCtxParam1(ctx context.Context) *mChatServiceMockTransferOwnership {
+	if mmTransferOwnership.mock.funcTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Set")
+	}
+
+	if mmTransferOwnership.defaultExpectation == nil {
+		mmTransferOwnership.defaultExpectation = &ChatServiceMockTransferOwnershipExpectation{}
+	}
+
+	if mmTransferOwnership.defaultExpectation.params != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Expect")
+	}
+
+	if mmTransferOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferOwnership.defaultExpectation.paramPtrs = &ChatServiceMockTransferOwnershipParamPtrs{}
+	}
+	mmTransferOwnership.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmTransferOwnership
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.TransferOwnership
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) ExpectChatIDParam2(chatID int64) *mChatServiceMockTransferOwnership {
+	if mmTransferOwnership.mock.funcTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Set")
+	}
+
+	if mmTransferOwnership.defaultExpectation == nil {
+		mmTransferOwnership.defaultExpectation = &ChatServiceMockTransferOwnershipExpectation{}
+	}
+
+	if mmTransferOwnership.defaultExpectation.params != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Expect")
+	}
+
+	if mmTransferOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferOwnership.defaultExpectation.paramPtrs = &ChatServiceMockTransferOwnershipParamPtrs{}
+	}
+	mmTransferOwnership.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmTransferOwnership
+}
+
+// ExpectUserIDParam3 sets up expected param userID for ChatService.TransferOwnership
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) ExpectUserIDParam3(userID int64) *mChatServiceMockTransferOwnership {
+	if mmTransferOwnership.mock.funcTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Set")
+	}
+
+	if mmTransferOwnership.defaultExpectation == nil {
+		mmTransferOwnership.defaultExpectation = &ChatServiceMockTransferOwnershipExpectation{}
+	}
+
+	if mmTransferOwnership.defaultExpectation.params != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Expect")
+	}
+
+	if mmTransferOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferOwnership.defaultExpectation.paramPtrs = &ChatServiceMockTransferOwnershipParamPtrs{}
+	}
+	mmTransferOwnership.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmTransferOwnership
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.TransferOwnership
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Inspect(f func(ctx context.Context, chatID int64, userID int64)) *mChatServiceMockTransferOwnership {
+	if mmTransferOwnership.mock.inspectFuncTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.TransferOwnership")
+	}
+
+	mmTransferOwnership.mock.inspectFuncTransferOwnership = f
+
+	return mmTransferOwnership
+}
+
+// Return sets up results that will be returned by ChatService.TransferOwnership
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Return(err error) *ChatServiceMock {
+	if mmTransferOwnership.mock.funcTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Set")
+	}
+
+	if mmTransferOwnership.defaultExpectation == nil {
+		mmTransferOwnership.defaultExpectation = &ChatServiceMockTransferOwnershipExpectation{mock: mmTransferOwnership.mock}
+	}
+	mmTransferOwnership.defaultExpectation.results = &ChatServiceMockTransferOwnershipResults{err}
+	return mmTransferOwnership.mock
+}
+
+// Set uses given function f to mock the ChatService.TransferOwnership method
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Set(f func(ctx context.Context, chatID int64, userID int64) (err error)) *ChatServiceMock {
+	if mmTransferOwnership.defaultExpectation != nil {
+		mmTransferOwnership.mock.t.Fatalf("Default expectation is already set for the ChatService.TransferOwnership method")
+	}
+
+	if len(mmTransferOwnership.expectations) > 0 {
+		mmTransferOwnership.mock.t.Fatalf("Some expectations are already set for the ChatService.TransferOwnership method")
+	}
+
+	mmTransferOwnership.mock.funcTransferOwnership = f
+	return mmTransferOwnership.mock
+}
+
+// When sets expectation for the ChatService.TransferOwnership which will trigger the result defined by the following
+// Then helper
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) When(ctx context.Context, chatID int64, userID int64) *ChatServiceMockTransferOwnershipExpectation {
+	if mmTransferOwnership.mock.funcTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockTransferOwnershipExpectation{
+		mock:   mmTransferOwnership.mock,
+		params: &ChatServiceMockTransferOwnershipParams{ctx, chatID, userID},
+	}
+	mmTransferOwnership.expectations = append(mmTransferOwnership.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.TransferOwnership return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockTransferOwnershipExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockTransferOwnershipResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.TransferOwnership should be invoked
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Times(n uint64) *mChatServiceMockTransferOwnership {
+	if n == 0 {
+		mmTransferOwnership.mock.t.Fatalf("Times of ChatServiceMock.TransferOwnership mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTransferOwnership.expectedInvocations, n)
+	return mmTransferOwnership
+}
+
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) invocationsDone() bool {
+	if len(mmTransferOwnership.expectations) == 0 && mmTransferOwnership.defaultExpectation == nil && mmTransferOwnership.mock.funcTransferOwnership == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTransferOwnership.mock.afterTransferOwnershipCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTransferOwnership.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// TransferOwnership implements service.ChatService
+func (mmTransferOwnership *ChatServiceMock) TransferOwnership(ctx context.Context, chatID int64, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmTransferOwnership.beforeTransferOwnershipCounter, 1)
+	defer mm_atomic.AddUint64(&mmTransferOwnership.afterTransferOwnershipCounter, 1)
+
+	if mmTransferOwnership.inspectFuncTransferOwnership != nil {
+		mmTransferOwnership.inspectFuncTransferOwnership(ctx, chatID, userID)
+	}
+
+	mm_params := ChatServiceMockTransferOwnershipParams{ctx, chatID, userID}
+
+	// Record call args
+	mmTransferOwnership.TransferOwnershipMock.mutex.Lock()
+	mmTransferOwnership.TransferOwnershipMock.callArgs = append(mmTransferOwnership.TransferOwnershipMock.callArgs, &mm_params)
+	mmTransferOwnership.TransferOwnershipMock.mutex.Unlock()
+
+	for _, e := range mmTransferOwnership.TransferOwnershipMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmTransferOwnership.TransferOwnershipMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTransferOwnership.TransferOwnershipMock.defaultExpectation.Counter, 1)
+		mm_want := mmTransferOwnership.TransferOwnershipMock.defaultExpectation.params
+		mm_want_ptrs := mmTransferOwnership.TransferOwnershipMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockTransferOwnershipParams{ctx, chatID, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTransferOwnership.t.Errorf("ChatServiceMock.TransferOwnership got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmTransferOwnership.t.Errorf("ChatServiceMock.TransferOwnership got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmTransferOwnership.t.Errorf("ChatServiceMock.TransferOwnership got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTransferOwnership.t.Errorf("ChatServiceMock.TransferOwnership got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTransferOwnership.TransferOwnershipMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTransferOwnership.t.Fatal("No results are set for the ChatServiceMock.TransferOwnership")
+		}
+		return (*mm_results).err
+	}
+	if mmTransferOwnership.funcTransferOwnership != nil {
+		return mmTransferOwnership.funcTransferOwnership(ctx, chatID, userID)
+	}
+	mmTransferOwnership.t.Fatalf("Unexpected call to ChatServiceMock.TransferOwnership. %v %v %v", ctx, chatID, userID)
+	return
+}
+
+// TransferOwnershipAfterCounter returns a count of finished ChatServiceMock.TransferOwnership invocations
+func (mmTransferOwnership *ChatServiceMock) TransferOwnershipAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransferOwnership.afterTransferOwnershipCounter)
+}
+
+// TransferOwnershipBeforeCounter returns a count of ChatServiceMock.TransferOwnership invocations
+func (mmTransferOwnership *ChatServiceMock) TransferOwnershipBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransferOwnership.beforeTransferOwnershipCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.TransferOwnership.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Calls() []*ChatServiceMockTransferOwnershipParams {
+	mmTransferOwnership.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockTransferOwnershipParams, len(mmTransferOwnership.callArgs))
+	copy(argCopy, mmTransferOwnership.callArgs)
+
+	mmTransferOwnership.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTransferOwnershipDone returns true if the count of the TransferOwnership invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockTransferOwnershipDone() bool {
+	if m.TransferOwnershipMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TransferOwnershipMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TransferOwnershipMock.invocationsDone()
+}
+
+// MinimockTransferOwnershipInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockTransferOwnershipInspect() {
+	for _, e := range m.TransferOwnershipMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.TransferOwnership with params: %#v", *e.params)
+		}
+	}
+
+	afterTransferOwnershipCounter := mm_atomic.LoadUint64(&m.afterTransferOwnershipCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TransferOwnershipMock.defaultExpectation != nil && afterTransferOwnershipCounter < 1 {
+		if m.TransferOwnershipMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.TransferOwnership")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.TransferOwnership with params: %#v", *m.TransferOwnershipMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTransferOwnership != nil && afterTransferOwnershipCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.TransferOwnership")
+	}
+
+	if !m.TransferOwnershipMock.invocationsDone() && afterTransferOwnershipCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.TransferOwnership but found %d calls",
+			mm_atomic.LoadUint64(&m.TransferOwnershipMock.expectedInvocations), afterTransferOwnershipCounter)
+	}
+}
+
+type mChatServiceMockUnpinMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockUnpinMessageExpectation
+	expectations       []*ChatServiceMockUnpinMessageExpectation
+
+	callArgs []*ChatServiceMockUnpinMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockUnpinMessageExpectation specifies expectation struct of the ChatService.UnpinMessage
+type ChatServiceMockUnpinMessageExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockUnpinMessageParams
+	paramPtrs *ChatServiceMockUnpinMessageParamPtrs
+	results   *ChatServiceMockUnpinMessageResults
+	Counter   uint64
+}
+
+// ChatServiceMockUnpinMessageParams contains parameters of the ChatService.UnpinMessage
+type ChatServiceMockUnpinMessageParams struct {
+	ctx       context.Context
+	chatID    int64
+	messageID string
+}
+
+// ChatServiceMockUnpinMessageParamPtrs contains pointers to parameters of the ChatService.UnpinMessage
+type ChatServiceMockUnpinMessageParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	messageID *string
+}
+
+// ChatServiceMockUnpinMessageResults contains results of the ChatService.UnpinMessage
+type ChatServiceMockUnpinMessageResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Optional() *mChatServiceMockUnpinMessage {
+	mmUnpinMessage.optional = true
+	return mmUnpinMessage
+}
+
+// Expect sets up expected params for ChatService.UnpinMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Expect(ctx context.Context, chatID int64, messageID string) *mChatServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Set")
+	}
+
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatServiceMockUnpinMessageExpectation{}
+	}
+
+	if mmUnpinMessage.defaultExpectation.paramPtrs != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by ExpectParams functions")
+	}
+
+	mmUnpinMessage.defaultExpectation.params = &ChatServiceMockUnpinMessageParams{ctx, chatID, messageID}
+	for _, e := range mmUnpinMessage.expectations {
+		if minimock.Equal(e.params, mmUnpinMessage.defaultExpectation.params) {
+			mmUnpinMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUnpinMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmUnpinMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.UnpinMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Set")
+	}
+
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatServiceMockUnpinMessageExpectation{}
+	}
+
+	if mmUnpinMessage.defaultExpectation.params != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Expect")
+	}
+
+	if mmUnpinMessage.defaultExpectation.paramPtrs == nil {
+		mmUnpinMessage.defaultExpectation.paramPtrs = &ChatServiceMockUnpinMessageParamPtrs{}
+	}
+	mmUnpinMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUnpinMessage
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.UnpinMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) ExpectChatIDParam2(chatID int64) *mChatServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Set")
+	}
+
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatServiceMockUnpinMessageExpectation{}
+	}
+
+	if mmUnpinMessage.defaultExpectation.params != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Expect")
+	}
+
+	if mmUnpinMessage.defaultExpectation.paramPtrs == nil {
+		mmUnpinMessage.defaultExpectation.paramPtrs = &ChatServiceMockUnpinMessageParamPtrs{}
+	}
+	mmUnpinMessage.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmUnpinMessage
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatService.UnpinMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) ExpectMessageIDParam3(messageID string) *mChatServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Set")
+	}
+
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatServiceMockUnpinMessageExpectation{}
+	}
+
+	if mmUnpinMessage.defaultExpectation.params != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Expect")
+	}
+
+	if mmUnpinMessage.defaultExpectation.paramPtrs == nil {
+		mmUnpinMessage.defaultExpectation.paramPtrs = &ChatServiceMockUnpinMessageParamPtrs{}
+	}
+	mmUnpinMessage.defaultExpectation.paramPtrs.messageID = &messageID
+
+	return mmUnpinMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.UnpinMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Inspect(f func(ctx context.Context, chatID int64, messageID string)) *mChatServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.inspectFuncUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.UnpinMessage")
+	}
+
+	mmUnpinMessage.mock.inspectFuncUnpinMessage = f
+
+	return mmUnpinMessage
+}
+
+// Return sets up results that will be returned by ChatService.UnpinMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Return(err error) *ChatServiceMock {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Set")
+	}
+
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatServiceMockUnpinMessageExpectation{mock: mmUnpinMessage.mock}
+	}
+	mmUnpinMessage.defaultExpectation.results = &ChatServiceMockUnpinMessageResults{err}
+	return mmUnpinMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.UnpinMessage method
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Set(f func(ctx context.Context, chatID int64, messageID string) (err error)) *ChatServiceMock {
+	if mmUnpinMessage.defaultExpectation != nil {
+		mmUnpinMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.UnpinMessage method")
+	}
+
+	if len(mmUnpinMessage.expectations) > 0 {
+		mmUnpinMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.UnpinMessage method")
+	}
+
+	mmUnpinMessage.mock.funcUnpinMessage = f
+	return mmUnpinMessage.mock
+}
+
+// When sets expectation for the ChatService.UnpinMessage which will trigger the result defined by the following
+// Then helper
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) When(ctx context.Context, chatID int64, messageID string) *ChatServiceMockUnpinMessageExpectation {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockUnpinMessageExpectation{
+		mock:   mmUnpinMessage.mock,
+		params: &ChatServiceMockUnpinMessageParams{ctx, chatID, messageID},
+	}
+	mmUnpinMessage.expectations = append(mmUnpinMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.UnpinMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockUnpinMessageExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockUnpinMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.UnpinMessage should be invoked
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Times(n uint64) *mChatServiceMockUnpinMessage {
+	if n == 0 {
+		mmUnpinMessage.mock.t.Fatalf("Times of ChatServiceMock.UnpinMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUnpinMessage.expectedInvocations, n)
+	return mmUnpinMessage
+}
+
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) invocationsDone() bool {
+	if len(mmUnpinMessage.expectations) == 0 && mmUnpinMessage.defaultExpectation == nil && mmUnpinMessage.mock.funcUnpinMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUnpinMessage.mock.afterUnpinMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUnpinMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UnpinMessage implements service.ChatService
+func (mmUnpinMessage *ChatServiceMock) UnpinMessage(ctx context.Context, chatID int64, messageID string) (err error) {
+	mm_atomic.AddUint64(&mmUnpinMessage.beforeUnpinMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmUnpinMessage.afterUnpinMessageCounter, 1)
+
+	if mmUnpinMessage.inspectFuncUnpinMessage != nil {
+		mmUnpinMessage.inspectFuncUnpinMessage(ctx, chatID, messageID)
+	}
+
+	mm_params := ChatServiceMockUnpinMessageParams{ctx, chatID, messageID}
+
+	// Record call args
+	mmUnpinMessage.UnpinMessageMock.mutex.Lock()
+	mmUnpinMessage.UnpinMessageMock.callArgs = append(mmUnpinMessage.UnpinMessageMock.callArgs, &mm_params)
+	mmUnpinMessage.UnpinMessageMock.mutex.Unlock()
+
+	for _, e := range mmUnpinMessage.UnpinMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUnpinMessage.UnpinMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUnpinMessage.UnpinMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmUnpinMessage.UnpinMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmUnpinMessage.UnpinMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockUnpinMessageParams{ctx, chatID, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUnpinMessage.t.Errorf("ChatServiceMock.UnpinMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmUnpinMessage.t.Errorf("ChatServiceMock.UnpinMessage got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmUnpinMessage.t.Errorf("ChatServiceMock.UnpinMessage got unexpected parameter messageID, want: %#v, got: %#v%s\n", *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUnpinMessage.t.Errorf("ChatServiceMock.UnpinMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUnpinMessage.UnpinMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUnpinMessage.t.Fatal("No results are set for the ChatServiceMock.UnpinMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmUnpinMessage.funcUnpinMessage != nil {
+		return mmUnpinMessage.funcUnpinMessage(ctx, chatID, messageID)
+	}
+	mmUnpinMessage.t.Fatalf("Unexpected call to ChatServiceMock.UnpinMessage. %v %v %v", ctx, chatID, messageID)
+	return
+}
+
+// UnpinMessageAfterCounter returns a count of finished ChatServiceMock.UnpinMessage invocations
+func (mmUnpinMessage *ChatServiceMock) UnpinMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnpinMessage.afterUnpinMessageCounter)
+}
+
+// UnpinMessageBeforeCounter returns a count of ChatServiceMock.UnpinMessage invocations
+func (mmUnpinMessage *ChatServiceMock) UnpinMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnpinMessage.beforeUnpinMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.UnpinMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Calls() []*ChatServiceMockUnpinMessageParams {
+	mmUnpinMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockUnpinMessageParams, len(mmUnpinMessage.callArgs))
+	copy(argCopy, mmUnpinMessage.callArgs)
+
+	mmUnpinMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUnpinMessageDone returns true if the count of the UnpinMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockUnpinMessageDone() bool {
+	if m.UnpinMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UnpinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UnpinMessageMock.invocationsDone()
+}
+
+// MinimockUnpinMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockUnpinMessageInspect() {
+	for _, e := range m.UnpinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.UnpinMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterUnpinMessageCounter := mm_atomic.LoadUint64(&m.afterUnpinMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UnpinMessageMock.defaultExpectation != nil && afterUnpinMessageCounter < 1 {
+		if m.UnpinMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.UnpinMessage")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.UnpinMessage with params: %#v", *m.UnpinMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUnpinMessage != nil && afterUnpinMessageCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.UnpinMessage")
+	}
+
+	if !m.UnpinMessageMock.invocationsDone() && afterUnpinMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.UnpinMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.UnpinMessageMock.expectedInvocations), afterUnpinMessageCounter)
+	}
+}
+
+type mChatServiceMockUploadAttachment struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockUploadAttachmentExpectation
+	expectations       []*ChatServiceMockUploadAttachmentExpectation
+
+	callArgs []*ChatServiceMockUploadAttachmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockUploadAttachmentExpectation specifies expectation struct of the ChatService.UploadAttachment
+type ChatServiceMockUploadAttachmentExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockUploadAttachmentParams
+	paramPtrs *ChatServiceMockUploadAttachmentParamPtrs
+	results   *ChatServiceMockUploadAttachmentResults
+	Counter   uint64
+}
+
+// ChatServiceMockUploadAttachmentParams contains parameters of the ChatService.UploadAttachment
+type ChatServiceMockUploadAttachmentParams struct {
+	ctx     context.Context
+	upload  *model.AttachmentUpload
+	content io.Reader
+}
+
+// ChatServiceMockUploadAttachmentParamPtrs contains pointers to parameters of the ChatService.UploadAttachment
+type ChatServiceMockUploadAttachmentParamPtrs struct {
+	ctx     *context.Context
+	upload  **model.AttachmentUpload
+	content *io.Reader
+}
+
+// ChatServiceMockUploadAttachmentResults contains results of the ChatService.UploadAttachment
+type ChatServiceMockUploadAttachmentResults struct {
+	ap1 *model.Attachment
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) Optional() *mChatServiceMockUploadAttachment {
+	mmUploadAttachment.optional = true
+	return mmUploadAttachment
+}
+
+// Expect sets up expected params for ChatService.UploadAttachment
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) Expect(ctx context.Context, upload *model.AttachmentUpload, content io.Reader) *mChatServiceMockUploadAttachment {
+	if mmUploadAttachment.mock.funcUploadAttachment != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by Set")
+	}
+
+	if mmUploadAttachment.defaultExpectation == nil {
+		mmUploadAttachment.defaultExpectation = &ChatServiceMockUploadAttachmentExpectation{}
+	}
+
+	if mmUploadAttachment.defaultExpectation.paramPtrs != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by ExpectParams functions")
+	}
+
+	mmUploadAttachment.defaultExpectation.params = &ChatServiceMockUploadAttachmentParams{ctx, upload, content}
+	for _, e := range mmUploadAttachment.expectations {
+		if minimock.Equal(e.params, mmUploadAttachment.defaultExpectation.params) {
+			mmUploadAttachment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUploadAttachment.defaultExpectation.params)
+		}
+	}
+
+	return mmUploadAttachment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.UploadAttachment
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) ExpectCtxParam1(ctx context.Context) *mChatServiceMockUploadAttachment {
+	if mmUploadAttachment.mock.funcUploadAttachment != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by Set")
+	}
+
+	if mmUploadAttachment.defaultExpectation == nil {
+		mmUploadAttachment.defaultExpectation = &ChatServiceMockUploadAttachmentExpectation{}
+	}
+
+	if mmUploadAttachment.defaultExpectation.params != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by Expect")
+	}
+
+	if mmUploadAttachment.defaultExpectation.paramPtrs == nil {
+		mmUploadAttachment.defaultExpectation.paramPtrs = &ChatServiceMockUploadAttachmentParamPtrs{}
+	}
+	mmUploadAttachment.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUploadAttachment
+}
+
+// ExpectUploadParam2 sets up expected param upload for ChatService.UploadAttachment
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) ExpectUploadParam2(upload *model.AttachmentUpload) *mChatServiceMockUploadAttachment {
+	if mmUploadAttachment.mock.funcUploadAttachment != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by Set")
+	}
+
+	if mmUploadAttachment.defaultExpectation == nil {
+		mmUploadAttachment.defaultExpectation = &ChatServiceMockUploadAttachmentExpectation{}
+	}
+
+	if mmUploadAttachment.defaultExpectation.params != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by Expect")
+	}
+
+	if mmUploadAttachment.defaultExpectation.paramPtrs == nil {
+		mmUploadAttachment.defaultExpectation.paramPtrs = &ChatServiceMockUploadAttachmentParamPtrs{}
+	}
+	mmUploadAttachment.defaultExpectation.paramPtrs.upload = &upload
+
+	return mmUploadAttachment
+}
+
+// ExpectContentParam3 sets up expected param content for ChatService.UploadAttachment
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) ExpectContentParam3(content io.Reader) *mChatServiceMockUploadAttachment {
+	if mmUploadAttachment.mock.funcUploadAttachment != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by Set")
+	}
+
+	if mmUploadAttachment.defaultExpectation == nil {
+		mmUploadAttachment.defaultExpectation = &ChatServiceMockUploadAttachmentExpectation{}
+	}
+
+	if mmUploadAttachment.defaultExpectation.params != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by Expect")
+	}
+
+	if mmUploadAttachment.defaultExpectation.paramPtrs == nil {
+		mmUploadAttachment.defaultExpectation.paramPtrs = &ChatServiceMockUploadAttachmentParamPtrs{}
+	}
+	mmUploadAttachment.defaultExpectation.paramPtrs.content = &content
+
+	return mmUploadAttachment
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.UploadAttachment
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) Inspect(f func(ctx context.Context, upload *model.AttachmentUpload, content io.Reader)) *mChatServiceMockUploadAttachment {
+	if mmUploadAttachment.mock.inspectFuncUploadAttachment != nil {
+		mmUploadAttachment.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.UploadAttachment")
+	}
+
+	mmUploadAttachment.mock.inspectFuncUploadAttachment = f
+
+	return mmUploadAttachment
+}
+
+// Return sets up results that will be returned by ChatService.UploadAttachment
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) Return(ap1 *model.Attachment, err error) *ChatServiceMock {
+	if mmUploadAttachment.mock.funcUploadAttachment != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by Set")
+	}
+
+	if mmUploadAttachment.defaultExpectation == nil {
+		mmUploadAttachment.defaultExpectation = &ChatServiceMockUploadAttachmentExpectation{mock: mmUploadAttachment.mock}
+	}
+	mmUploadAttachment.defaultExpectation.results = &ChatServiceMockUploadAttachmentResults{ap1, err}
+	return mmUploadAttachment.mock
+}
+
+// Set uses given function f to mock the ChatService.UploadAttachment method
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) Set(f func(ctx context.Context, upload *model.AttachmentUpload, content io.Reader) (ap1 *model.Attachment, err error)) *ChatServiceMock {
+	if mmUploadAttachment.defaultExpectation != nil {
+		mmUploadAttachment.mock.t.Fatalf("Default expectation is already set for the ChatService.UploadAttachment method")
+	}
+
+	if len(mmUploadAttachment.expectations) > 0 {
+		mmUploadAttachment.mock.t.Fatalf("Some expectations are already set for the ChatService.UploadAttachment method")
+	}
+
+	mmUploadAttachment.mock.funcUploadAttachment = f
+	return mmUploadAttachment.mock
+}
+
+// When sets expectation for the ChatService.UploadAttachment which will trigger the result defined by the following
+// Then helper
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) When(ctx context.Context, upload *model.AttachmentUpload, content io.Reader) *ChatServiceMockUploadAttachmentExpectation {
+	if mmUploadAttachment.mock.funcUploadAttachment != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockUploadAttachmentExpectation{
+		mock:   mmUploadAttachment.mock,
+		params: &ChatServiceMockUploadAttachmentParams{ctx, upload, content},
+	}
+	mmUploadAttachment.expectations = append(mmUploadAttachment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.UploadAttachment return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockUploadAttachmentExpectation) Then(ap1 *model.Attachment, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockUploadAttachmentResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.UploadAttachment should be invoked
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) Times(n uint64) *mChatServiceMockUploadAttachment {
+	if n == 0 {
+		mmUploadAttachment.mock.t.Fatalf("Times of ChatServiceMock.UploadAttachment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUploadAttachment.expectedInvocations, n)
+	return mmUploadAttachment
+}
+
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) invocationsDone() bool {
+	if len(mmUploadAttachment.expectations) == 0 && mmUploadAttachment.defaultExpectation == nil && mmUploadAttachment.mock.funcUploadAttachment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUploadAttachment.mock.afterUploadAttachmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUploadAttachment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UploadAttachment implements service.ChatService
+func (mmUploadAttachment *ChatServiceMock) UploadAttachment(ctx context.Context, upload *model.AttachmentUpload, content io.Reader) (ap1 *model.Attachment, err error) {
+	mm_atomic.AddUint64(&mmUploadAttachment.beforeUploadAttachmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmUploadAttachment.afterUploadAttachmentCounter, 1)
+
+	if mmUploadAttachment.inspectFuncUploadAttachment != nil {
+		mmUploadAttachment.inspectFuncUploadAttachment(ctx, upload, content)
+	}
+
+	mm_params := ChatServiceMockUploadAttachmentParams{ctx, upload, content}
+
+	// Record call args
+	mmUploadAttachment.UploadAttachmentMock.mutex.Lock()
+	mmUploadAttachment.UploadAttachmentMock.callArgs = append(mmUploadAttachment.UploadAttachmentMock.callArgs, &mm_params)
+	mmUploadAttachment.UploadAttachmentMock.mutex.Unlock()
+
+	for _, e := range mmUploadAttachment.UploadAttachmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmUploadAttachment.UploadAttachmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUploadAttachment.UploadAttachmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmUploadAttachment.UploadAttachmentMock.defaultExpectation.params
+		mm_want_ptrs := mmUploadAttachment.UploadAttachmentMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockUploadAttachmentParams{ctx, upload, content}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUploadAttachment.t.Errorf("ChatServiceMock.UploadAttachment got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.upload != nil && !minimock.Equal(*mm_want_ptrs.upload, mm_got.upload) {
+				mmUploadAttachment.t.Errorf("ChatServiceMock.UploadAttachment got unexpected parameter upload, want: %#v, got: %#v%s\n", *mm_want_ptrs.upload, mm_got.upload, minimock.Diff(*mm_want_ptrs.upload, mm_got.upload))
+			}
+
+			if mm_want_ptrs.content != nil && !minimock.Equal(*mm_want_ptrs.content, mm_got.content) {
+				mmUploadAttachment.t.Errorf("ChatServiceMock.UploadAttachment got unexpected parameter content, want: %#v, got: %#v%s\n", *mm_want_ptrs.content, mm_got.content, minimock.Diff(*mm_want_ptrs.content, mm_got.content))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUploadAttachment.t.Errorf("ChatServiceMock.UploadAttachment got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUploadAttachment.UploadAttachmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUploadAttachment.t.Fatal("No results are set for the ChatServiceMock.UploadAttachment")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmUploadAttachment.funcUploadAttachment != nil {
+		return mmUploadAttachment.funcUploadAttachment(ctx, upload, content)
+	}
+	mmUploadAttachment.t.Fatalf("Unexpected call to ChatServiceMock.UploadAttachment. %v %v %v", ctx, upload, content)
+	return
+}
+
+// UploadAttachmentAfterCounter returns a count of finished ChatServiceMock.UploadAttachment invocations
+func (mmUploadAttachment *ChatServiceMock) UploadAttachmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUploadAttachment.afterUploadAttachmentCounter)
+}
+
+// UploadAttachmentBeforeCounter returns a count of ChatServiceMock.UploadAttachment invocations
+func (mmUploadAttachment *ChatServiceMock) UploadAttachmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUploadAttachment.beforeUploadAttachmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.UploadAttachment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) Calls() []*ChatServiceMockUploadAttachmentParams {
+	mmUploadAttachment.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockUploadAttachmentParams, len(mmUploadAttachment.callArgs))
+	copy(argCopy, mmUploadAttachment.callArgs)
+
+	mmUploadAttachment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUploadAttachmentDone returns true if the count of the UploadAttachment invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockUploadAttachmentDone() bool {
+	if m.UploadAttachmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UploadAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UploadAttachmentMock.invocationsDone()
+}
+
+// MinimockUploadAttachmentInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockUploadAttachmentInspect() {
+	for _, e := range m.UploadAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.UploadAttachment with params: %#v", *e.params)
+		}
+	}
+
+	afterUploadAttachmentCounter := mm_atomic.LoadUint64(&m.afterUploadAttachmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UploadAttachmentMock.defaultExpectation != nil && afterUploadAttachmentCounter < 1 {
+		if m.UploadAttachmentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.UploadAttachment")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.UploadAttachment with params: %#v", *m.UploadAttachmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUploadAttachment != nil && afterUploadAttachmentCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.UploadAttachment")
+	}
+
+	if !m.UploadAttachmentMock.invocationsDone() && afterUploadAttachmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.UploadAttachment but found %d calls",
+			mm_atomic.LoadUint64(&m.UploadAttachmentMock.expectedInvocations), afterUploadAttachmentCounter)
+	}
+}
+
+type mChatServiceMockVote struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockVoteExpectation
+	expectations       []*ChatServiceMockVoteExpectation
+
+	callArgs []*ChatServiceMockVoteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockVoteExpectation specifies expectation struct of the ChatService.Vote
+type ChatServiceMockVoteExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockVoteParams
+	paramPtrs *ChatServiceMockVoteParamPtrs
+	results   *ChatServiceMockVoteResults
+	Counter   uint64
+}
+
+// ChatServiceMockVoteParams contains parameters of the ChatService.Vote
+type ChatServiceMockVoteParams struct {
+	ctx  context.Context
+	vote *model.PollVote
 }
 
-// ChatServiceMockCreateChatParamPtrs contains pointers to parameters of the ChatService.CreateChat
-type ChatServiceMockCreateChatParamPtrs struct {
-	ctx        *context.Context
-	createChat **model.ChatCreate
+// ChatServiceMockVoteParamPtrs contains pointers to parameters of the ChatService.Vote
+type ChatServiceMockVoteParamPtrs struct {
+	ctx  *context.Context
+	vote **model.PollVote
 }
 
-// ChatServiceMockCreateChatResults contains results of the ChatService.CreateChat
-type ChatServiceMockCreateChatResults struct {
-	i1  int64
+// ChatServiceMockVoteResults contains results of the ChatService.Vote
+type ChatServiceMockVoteResults struct {
+	pp1 *model.Poll
 	err error
 }
 
@@ -104,318 +15667,317 @@ type ChatServiceMockCreateChatResults struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreateChat *mChatServiceMockCreateChat) Optional() *mChatServiceMockCreateChat {
-	mmCreateChat.optional = true
-	return mmCreateChat
+func (mmVote *mChatServiceMockVote) Optional() *mChatServiceMockVote {
+	mmVote.optional = true
+	return mmVote
 }
 
-// Expect sets up expected params for ChatService.CreateChat
-func (mmCreateChat *mChatServiceMockCreateChat) Expect(ctx context.Context, createChat *model.ChatCreate) *mChatServiceMockCreateChat {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+// Expect sets up expected params for ChatService.Vote
+func (mmVote *mChatServiceMockVote) Expect(ctx context.Context, vote *model.PollVote) *mChatServiceMockVote {
+	if mmVote.mock.funcVote != nil {
+		mmVote.mock.t.Fatalf("ChatServiceMock.Vote mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{}
+	if mmVote.defaultExpectation == nil {
+		mmVote.defaultExpectation = &ChatServiceMockVoteExpectation{}
 	}
 
-	if mmCreateChat.defaultExpectation.paramPtrs != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by ExpectParams functions")
+	if mmVote.defaultExpectation.paramPtrs != nil {
+		mmVote.mock.t.Fatalf("ChatServiceMock.Vote mock is already set by ExpectParams functions")
 	}
 
-	mmCreateChat.defaultExpectation.params = &ChatServiceMockCreateChatParams{ctx, createChat}
-	for _, e := range mmCreateChat.expectations {
-		if minimock.Equal(e.params, mmCreateChat.defaultExpectation.params) {
-			mmCreateChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateChat.defaultExpectation.params)
+	mmVote.defaultExpectation.params = &ChatServiceMockVoteParams{ctx, vote}
+	for _, e := range mmVote.expectations {
+		if minimock.Equal(e.params, mmVote.defaultExpectation.params) {
+			mmVote.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmVote.defaultExpectation.params)
 		}
 	}
 
-	return mmCreateChat
+	return mmVote
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatService.CreateChat
-func (mmCreateChat *mChatServiceMockCreateChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockCreateChat {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatService.Vote
+func (mmVote *mChatServiceMockVote) ExpectCtxParam1(ctx context.Context) *mChatServiceMockVote {
+	if mmVote.mock.funcVote != nil {
+		mmVote.mock.t.Fatalf("ChatServiceMock.Vote mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{}
+	if mmVote.defaultExpectation == nil {
+		mmVote.defaultExpectation = &ChatServiceMockVoteExpectation{}
 	}
 
-	if mmCreateChat.defaultExpectation.params != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Expect")
+	if mmVote.defaultExpectation.params != nil {
+		mmVote.mock.t.Fatalf("ChatServiceMock.Vote mock is already set by Expect")
 	}
 
-	if mmCreateChat.defaultExpectation.paramPtrs == nil {
-		mmCreateChat.defaultExpectation.paramPtrs = &ChatServiceMockCreateChatParamPtrs{}
+	if mmVote.defaultExpectation.paramPtrs == nil {
+		mmVote.defaultExpectation.paramPtrs = &ChatServiceMockVoteParamPtrs{}
 	}
-	mmCreateChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmVote.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmCreateChat
+	return mmVote
 }
 
-// ExpectCreateChatParam2 sets up expected param createChat for ChatService.CreateChat
-func (mmCreateChat *mChatServiceMockCreateChat) ExpectCreateChatParam2(createChat *model.ChatCreate) *mChatServiceMockCreateChat {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+// ExpectVoteParam2 sets up expected param vote for ChatService.Vote
+func (mmVote *mChatServiceMockVote) ExpectVoteParam2(vote *model.PollVote) *mChatServiceMockVote {
+	if mmVote.mock.funcVote != nil {
+		mmVote.mock.t.Fatalf("ChatServiceMock.Vote mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{}
+	if mmVote.defaultExpectation == nil {
+		mmVote.defaultExpectation = &ChatServiceMockVoteExpectation{}
 	}
 
-	if mmCreateChat.defaultExpectation.params != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Expect")
+	if mmVote.defaultExpectation.params != nil {
+		mmVote.mock.t.Fatalf("ChatServiceMock.Vote mock is already set by Expect")
 	}
 
-	if mmCreateChat.defaultExpectation.paramPtrs == nil {
-		mmCreateChat.defaultExpectation.paramPtrs = &ChatServiceMockCreateChatParamPtrs{}
+	if mmVote.defaultExpectation.paramPtrs == nil {
+		mmVote.defaultExpectation.paramPtrs = &ChatServiceMockVoteParamPtrs{}
 	}
-	mmCreateChat.defaultExpectation.paramPtrs.createChat = &createChat
+	mmVote.defaultExpectation.paramPtrs.vote = &vote
 
-	return mmCreateChat
+	return mmVote
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatService.CreateChat
-func (mmCreateChat *mChatServiceMockCreateChat) Inspect(f func(ctx context.Context, createChat *model.ChatCreate)) *mChatServiceMockCreateChat {
-	if mmCreateChat.mock.inspectFuncCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.CreateChat")
+// Inspect accepts an inspector function that has same arguments as the ChatService.Vote
+func (mmVote *mChatServiceMockVote) Inspect(f func(ctx context.Context, vote *model.PollVote)) *mChatServiceMockVote {
+	if mmVote.mock.inspectFuncVote != nil {
+		mmVote.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.Vote")
 	}
 
-	mmCreateChat.mock.inspectFuncCreateChat = f
+	mmVote.mock.inspectFuncVote = f
 
-	return mmCreateChat
+	return mmVote
 }
 
-// Return sets up results that will be returned by ChatService.CreateChat
-func (mmCreateChat *mChatServiceMockCreateChat) Return(i1 int64, err error) *ChatServiceMock {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+// Return sets up results that will be returned by ChatService.Vote
+func (mmVote *mChatServiceMockVote) Return(pp1 *model.Poll, err error) *ChatServiceMock {
+	if mmVote.mock.funcVote != nil {
+		mmVote.mock.t.Fatalf("ChatServiceMock.Vote mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{mock: mmCreateChat.mock}
+	if mmVote.defaultExpectation == nil {
+		mmVote.defaultExpectation = &ChatServiceMockVoteExpectation{mock: mmVote.mock}
 	}
-	mmCreateChat.defaultExpectation.results = &ChatServiceMockCreateChatResults{i1, err}
-	return mmCreateChat.mock
+	mmVote.defaultExpectation.results = &ChatServiceMockVoteResults{pp1, err}
+	return mmVote.mock
 }
 
-// Set uses given function f to mock the ChatService.CreateChat method
-func (mmCreateChat *mChatServiceMockCreateChat) Set(f func(ctx context.Context, createChat *model.ChatCreate) (i1 int64, err error)) *ChatServiceMock {
-	if mmCreateChat.defaultExpectation != nil {
-		mmCreateChat.mock.t.Fatalf("Default expectation is already set for the ChatService.CreateChat method")
+// Set uses given function f to mock the ChatService.Vote method
+func (mmVote *mChatServiceMockVote) Set(f func(ctx context.Context, vote *model.PollVote) (pp1 *model.Poll, err error)) *ChatServiceMock {
+	if mmVote.defaultExpectation != nil {
+		mmVote.mock.t.Fatalf("Default expectation is already set for the ChatService.Vote method")
 	}
 
-	if len(mmCreateChat.expectations) > 0 {
-		mmCreateChat.mock.t.Fatalf("Some expectations are already set for the ChatService.CreateChat method")
+	if len(mmVote.expectations) > 0 {
+		mmVote.mock.t.Fatalf("Some expectations are already set for the ChatService.Vote method")
 	}
 
-	mmCreateChat.mock.funcCreateChat = f
-	return mmCreateChat.mock
+	mmVote.mock.funcVote = f
+	return mmVote.mock
 }
 
-// When sets expectation for the ChatService.CreateChat which will trigger the result defined by the following
+// When sets expectation for the ChatService.Vote which will trigger the result defined by the following
 // Then helper
-func (mmCreateChat *mChatServiceMockCreateChat) When(ctx context.Context, createChat *model.ChatCreate) *ChatServiceMockCreateChatExpectation {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+func (mmVote *mChatServiceMockVote) When(ctx context.Context, vote *model.PollVote) *ChatServiceMockVoteExpectation {
+	if mmVote.mock.funcVote != nil {
+		mmVote.mock.t.Fatalf("ChatServiceMock.Vote mock is already set by Set")
 	}
 
-	expectation := &ChatServiceMockCreateChatExpectation{
-		mock:   mmCreateChat.mock,
-		params: &ChatServiceMockCreateChatParams{ctx, createChat},
+	expectation := &ChatServiceMockVoteExpectation{
+		mock:   mmVote.mock,
+		params: &ChatServiceMockVoteParams{ctx, vote},
 	}
-	mmCreateChat.expectations = append(mmCreateChat.expectations, expectation)
+	mmVote.expectations = append(mmVote.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatService.CreateChat return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockCreateChatExpectation) Then(i1 int64, err error) *ChatServiceMock {
-	e.results = &ChatServiceMockCreateChatResults{i1, err}
+// Then sets up ChatService.Vote return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockVoteExpectation) Then(pp1 *model.Poll, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockVoteResults{pp1, err}
 	return e.mock
 }
 
-// Times sets number of times ChatService.CreateChat should be invoked
-func (mmCreateChat *mChatServiceMockCreateChat) Times(n uint64) *mChatServiceMockCreateChat {
+// Times sets number of times ChatService.Vote should be invoked
+func (mmVote *mChatServiceMockVote) Times(n uint64) *mChatServiceMockVote {
 	if n == 0 {
-		mmCreateChat.mock.t.Fatalf("Times of ChatServiceMock.CreateChat mock can not be zero")
+		mmVote.mock.t.Fatalf("Times of ChatServiceMock.Vote mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreateChat.expectedInvocations, n)
-	return mmCreateChat
+	mm_atomic.StoreUint64(&mmVote.expectedInvocations, n)
+	return mmVote
 }
 
-func (mmCreateChat *mChatServiceMockCreateChat) invocationsDone() bool {
-	if len(mmCreateChat.expectations) == 0 && mmCreateChat.defaultExpectation == nil && mmCreateChat.mock.funcCreateChat == nil {
+func (mmVote *mChatServiceMockVote) invocationsDone() bool {
+	if len(mmVote.expectations) == 0 && mmVote.defaultExpectation == nil && mmVote.mock.funcVote == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreateChat.mock.afterCreateChatCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreateChat.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmVote.mock.afterVoteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmVote.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreateChat implements service.ChatService
-func (mmCreateChat *ChatServiceMock) CreateChat(ctx context.Context, createChat *model.ChatCreate) (i1 int64, err error) {
-	mm_atomic.AddUint64(&mmCreateChat.beforeCreateChatCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreateChat.afterCreateChatCounter, 1)
+// Vote implements service.ChatService
+func (mmVote *ChatServiceMock) Vote(ctx context.Context, vote *model.PollVote) (pp1 *model.Poll, err error) {
+	mm_atomic.AddUint64(&mmVote.beforeVoteCounter, 1)
+	defer mm_atomic.AddUint64(&mmVote.afterVoteCounter, 1)
 
-	if mmCreateChat.inspectFuncCreateChat != nil {
-		mmCreateChat.inspectFuncCreateChat(ctx, createChat)
+	if mmVote.inspectFuncVote != nil {
+		mmVote.inspectFuncVote(ctx, vote)
 	}
 
-	mm_params := ChatServiceMockCreateChatParams{ctx, createChat}
+	mm_params := ChatServiceMockVoteParams{ctx, vote}
 
 	// Record call args
-	mmCreateChat.CreateChatMock.mutex.Lock()
-	mmCreateChat.CreateChatMock.callArgs = append(mmCreateChat.CreateChatMock.callArgs, &mm_params)
-	mmCreateChat.CreateChatMock.mutex.Unlock()
+	mmVote.VoteMock.mutex.Lock()
+	mmVote.VoteMock.callArgs = append(mmVote.VoteMock.callArgs, &mm_params)
+	mmVote.VoteMock.mutex.Unlock()
 
-	for _, e := range mmCreateChat.CreateChatMock.expectations {
+	for _, e := range mmVote.VoteMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.i1, e.results.err
+			return e.results.pp1, e.results.err
 		}
 	}
 
-	if mmCreateChat.CreateChatMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreateChat.CreateChatMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreateChat.CreateChatMock.defaultExpectation.params
-		mm_want_ptrs := mmCreateChat.CreateChatMock.defaultExpectation.paramPtrs
+	if mmVote.VoteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmVote.VoteMock.defaultExpectation.Counter, 1)
+		mm_want := mmVote.VoteMock.defaultExpectation.params
+		mm_want_ptrs := mmVote.VoteMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatServiceMockCreateChatParams{ctx, createChat}
+		mm_got := ChatServiceMockVoteParams{ctx, vote}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreateChat.t.Errorf("ChatServiceMock.CreateChat got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmVote.t.Errorf("ChatServiceMock.Vote got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.createChat != nil && !minimock.Equal(*mm_want_ptrs.createChat, mm_got.createChat) {
-				mmCreateChat.t.Errorf("ChatServiceMock.CreateChat got unexpected parameter createChat, want: %#v, got: %#v%s\n", *mm_want_ptrs.createChat, mm_got.createChat, minimock.Diff(*mm_want_ptrs.createChat, mm_got.createChat))
+			if mm_want_ptrs.vote != nil && !minimock.Equal(*mm_want_ptrs.vote, mm_got.vote) {
+				mmVote.t.Errorf("ChatServiceMock.Vote got unexpected parameter vote, want: %#v, got: %#v%s\n", *mm_want_ptrs.vote, mm_got.vote, minimock.Diff(*mm_want_ptrs.vote, mm_got.vote))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreateChat.t.Errorf("ChatServiceMock.CreateChat got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmVote.t.Errorf("ChatServiceMock.Vote got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreateChat.CreateChatMock.defaultExpectation.results
+		mm_results := mmVote.VoteMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreateChat.t.Fatal("No results are set for the ChatServiceMock.CreateChat")
+			mmVote.t.Fatal("No results are set for the ChatServiceMock.Vote")
 		}
-		return (*mm_results).i1, (*mm_results).err
+		return (*mm_results).pp1, (*mm_results).err
 	}
-	if mmCreateChat.funcCreateChat != nil {
-		return mmCreateChat.funcCreateChat(ctx, createChat)
+	if mmVote.funcVote != nil {
+		return mmVote.funcVote(ctx, vote)
 	}
-	mmCreateChat.t.Fatalf("Unexpected call to ChatServiceMock.CreateChat. %v %v", ctx, createChat)
+	mmVote.t.Fatalf("Unexpected call to ChatServiceMock.Vote. %v %v", ctx, vote)
 	return
 }
 
-// CreateChatAfterCounter returns a count of finished ChatServiceMock.CreateChat invocations
-func (mmCreateChat *ChatServiceMock) CreateChatAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateChat.afterCreateChatCounter)
+// VoteAfterCounter returns a count of finished ChatServiceMock.Vote invocations
+func (mmVote *ChatServiceMock) VoteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVote.afterVoteCounter)
 }
 
-// CreateChatBeforeCounter returns a count of ChatServiceMock.CreateChat invocations
-func (mmCreateChat *ChatServiceMock) CreateChatBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateChat.beforeCreateChatCounter)
+// VoteBeforeCounter returns a count of ChatServiceMock.Vote invocations
+func (mmVote *ChatServiceMock) VoteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVote.beforeVoteCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatServiceMock.CreateChat.
+// Calls returns a list of arguments used in each call to ChatServiceMock.Vote.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreateChat *mChatServiceMockCreateChat) Calls() []*ChatServiceMockCreateChatParams {
-	mmCreateChat.mutex.RLock()
+func (mmVote *mChatServiceMockVote) Calls() []*ChatServiceMockVoteParams {
+	mmVote.mutex.RLock()
 
-	argCopy := make([]*ChatServiceMockCreateChatParams, len(mmCreateChat.callArgs))
-	copy(argCopy, mmCreateChat.callArgs)
+	argCopy := make([]*ChatServiceMockVoteParams, len(mmVote.callArgs))
+	copy(argCopy, mmVote.callArgs)
 
-	mmCreateChat.mutex.RUnlock()
+	mmVote.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateChatDone returns true if the count of the CreateChat invocations corresponds
+// MinimockVoteDone returns true if the count of the Vote invocations corresponds
 // the number of defined expectations
-func (m *ChatServiceMock) MinimockCreateChatDone() bool {
-	if m.CreateChatMock.optional {
+func (m *ChatServiceMock) MinimockVoteDone() bool {
+	if m.VoteMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateChatMock.expectations {
+	for _, e := range m.VoteMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateChatMock.invocationsDone()
+	return m.VoteMock.invocationsDone()
 }
 
-// MinimockCreateChatInspect logs each unmet expectation
-func (m *ChatServiceMock) MinimockCreateChatInspect() {
-	for _, e := range m.CreateChatMock.expectations {
+// MinimockVoteInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockVoteInspect() {
+	for _, e := range m.VoteMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatServiceMock.CreateChat with params: %#v", *e.params)
+			m.t.Errorf("Expected call to ChatServiceMock.Vote with params: %#v", *e.params)
 		}
 	}
 
-	afterCreateChatCounter := mm_atomic.LoadUint64(&m.afterCreateChatCounter)
+	afterVoteCounter := mm_atomic.LoadUint64(&m.afterVoteCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateChatMock.defaultExpectation != nil && afterCreateChatCounter < 1 {
-		if m.CreateChatMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to ChatServiceMock.CreateChat")
+	if m.VoteMock.defaultExpectation != nil && afterVoteCounter < 1 {
+		if m.VoteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.Vote")
 		} else {
-			m.t.Errorf("Expected call to ChatServiceMock.CreateChat with params: %#v", *m.CreateChatMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatServiceMock.Vote with params: %#v", *m.VoteMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateChat != nil && afterCreateChatCounter < 1 {
-		m.t.Error("Expected call to ChatServiceMock.CreateChat")
+	if m.funcVote != nil && afterVoteCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.Vote")
 	}
 
-	if !m.CreateChatMock.invocationsDone() && afterCreateChatCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatServiceMock.CreateChat but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateChatMock.expectedInvocations), afterCreateChatCounter)
+	if !m.VoteMock.invocationsDone() && afterVoteCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.Vote but found %d calls",
+			mm_atomic.LoadUint64(&m.VoteMock.expectedInvocations), afterVoteCounter)
 	}
 }
 
-type mChatServiceMockDeleteChat struct {
+type mChatServiceMockWatchPresence struct {
 	optional           bool
 	mock               *ChatServiceMock
-	defaultExpectation *ChatServiceMockDeleteChatExpectation
-	expectations       []*ChatServiceMockDeleteChatExpectation
+	defaultExpectation *ChatServiceMockWatchPresenceExpectation
+	expectations       []*ChatServiceMockWatchPresenceExpectation
 
-	callArgs []*ChatServiceMockDeleteChatParams
+	callArgs []*ChatServiceMockWatchPresenceParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// ChatServiceMockDeleteChatExpectation specifies expectation struct of the ChatService.DeleteChat
-type ChatServiceMockDeleteChatExpectation struct {
+// ChatServiceMockWatchPresenceExpectation specifies expectation struct of the ChatService.WatchPresence
+type ChatServiceMockWatchPresenceExpectation struct {
 	mock      *ChatServiceMock
-	params    *ChatServiceMockDeleteChatParams
-	paramPtrs *ChatServiceMockDeleteChatParamPtrs
-	results   *ChatServiceMockDeleteChatResults
+	params    *ChatServiceMockWatchPresenceParams
+	paramPtrs *ChatServiceMockWatchPresenceParamPtrs
+	results   *ChatServiceMockWatchPresenceResults
 	Counter   uint64
 }
 
-// ChatServiceMockDeleteChatParams contains parameters of the ChatService.DeleteChat
-type ChatServiceMockDeleteChatParams struct {
+// ChatServiceMockWatchPresenceParams contains parameters of the ChatService.WatchPresence
+type ChatServiceMockWatchPresenceParams struct {
 	ctx context.Context
-	id  int64
 }
 
-// ChatServiceMockDeleteChatParamPtrs contains pointers to parameters of the ChatService.DeleteChat
-type ChatServiceMockDeleteChatParamPtrs struct {
+// ChatServiceMockWatchPresenceParamPtrs contains pointers to parameters of the ChatService.WatchPresence
+type ChatServiceMockWatchPresenceParamPtrs struct {
 	ctx *context.Context
-	id  *int64
 }
 
-// ChatServiceMockDeleteChatResults contains results of the ChatService.DeleteChat
-type ChatServiceMockDeleteChatResults struct {
+// ChatServiceMockWatchPresenceResults contains results of the ChatService.WatchPresence
+type ChatServiceMockWatchPresenceResults struct {
+	ch1 <-chan *model.Presence
 	err error
 }
 
@@ -424,319 +15986,293 @@ type ChatServiceMockDeleteChatResults struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteChat *mChatServiceMockDeleteChat) Optional() *mChatServiceMockDeleteChat {
-	mmDeleteChat.optional = true
-	return mmDeleteChat
+func (mmWatchPresence *mChatServiceMockWatchPresence) Optional() *mChatServiceMockWatchPresence {
+	mmWatchPresence.optional = true
+	return mmWatchPresence
 }
 
-// Expect sets up expected params for ChatService.DeleteChat
-func (mmDeleteChat *mChatServiceMockDeleteChat) Expect(ctx context.Context, id int64) *mChatServiceMockDeleteChat {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+// Expect sets up expected params for ChatService.WatchPresence
+func (mmWatchPresence *mChatServiceMockWatchPresence) Expect(ctx context.Context) *mChatServiceMockWatchPresence {
+	if mmWatchPresence.mock.funcWatchPresence != nil {
+		mmWatchPresence.mock.t.Fatalf("ChatServiceMock.WatchPresence mock is already set by Set")
 	}
 
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{}
+	if mmWatchPresence.defaultExpectation == nil {
+		mmWatchPresence.defaultExpectation = &ChatServiceMockWatchPresenceExpectation{}
 	}
 
-	if mmDeleteChat.defaultExpectation.paramPtrs != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by ExpectParams functions")
+	if mmWatchPresence.defaultExpectation.paramPtrs != nil {
+		mmWatchPresence.mock.t.Fatalf("ChatServiceMock.WatchPresence mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteChat.defaultExpectation.params = &ChatServiceMockDeleteChatParams{ctx, id}
-	for _, e := range mmDeleteChat.expectations {
-		if minimock.Equal(e.params, mmDeleteChat.defaultExpectation.params) {
-			mmDeleteChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteChat.defaultExpectation.params)
+	mmWatchPresence.defaultExpectation.params = &ChatServiceMockWatchPresenceParams{ctx}
+	for _, e := range mmWatchPresence.expectations {
+		if minimock.Equal(e.params, mmWatchPresence.defaultExpectation.params) {
+			mmWatchPresence.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWatchPresence.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteChat
-}
-
-// ExpectCtxParam1 sets up expected param ctx for ChatService.DeleteChat
-func (mmDeleteChat *mChatServiceMockDeleteChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockDeleteChat {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
-	}
-
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{}
-	}
-
-	if mmDeleteChat.defaultExpectation.params != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Expect")
-	}
-
-	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
-		mmDeleteChat.defaultExpectation.paramPtrs = &ChatServiceMockDeleteChatParamPtrs{}
-	}
-	mmDeleteChat.defaultExpectation.paramPtrs.ctx = &ctx
-
-	return mmDeleteChat
+	return mmWatchPresence
 }
 
-// ExpectIdParam2 sets up expected param id for ChatService.DeleteChat
-func (mmDeleteChat *mChatServiceMockDeleteChat) ExpectIdParam2(id int64) *mChatServiceMockDeleteChat {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatService.WatchPresence
+func (mmWatchPresence *mChatServiceMockWatchPresence) ExpectCtxParam1(ctx context.Context) *mChatServiceMockWatchPresence {
+	if mmWatchPresence.mock.funcWatchPresence != nil {
+		mmWatchPresence.mock.t.Fatalf("ChatServiceMock.WatchPresence mock is already set by Set")
 	}
 
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{}
+	if mmWatchPresence.defaultExpectation == nil {
+		mmWatchPresence.defaultExpectation = &ChatServiceMockWatchPresenceExpectation{}
 	}
 
-	if mmDeleteChat.defaultExpectation.params != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Expect")
+	if mmWatchPresence.defaultExpectation.params != nil {
+		mmWatchPresence.mock.t.Fatalf("ChatServiceMock.WatchPresence mock is already set by Expect")
 	}
 
-	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
-		mmDeleteChat.defaultExpectation.paramPtrs = &ChatServiceMockDeleteChatParamPtrs{}
+	if mmWatchPresence.defaultExpectation.paramPtrs == nil {
+		mmWatchPresence.defaultExpectation.paramPtrs = &ChatServiceMockWatchPresenceParamPtrs{}
 	}
-	mmDeleteChat.defaultExpectation.paramPtrs.id = &id
+	mmWatchPresence.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmDeleteChat
+	return mmWatchPresence
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatService.DeleteChat
-func (mmDeleteChat *mChatServiceMockDeleteChat) Inspect(f func(ctx context.Context, id int64)) *mChatServiceMockDeleteChat {
-	if mmDeleteChat.mock.inspectFuncDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.DeleteChat")
+// Inspect accepts an inspector function that has same arguments as the ChatService.WatchPresence
+func (mmWatchPresence *mChatServiceMockWatchPresence) Inspect(f func(ctx context.Context)) *mChatServiceMockWatchPresence {
+	if mmWatchPresence.mock.inspectFuncWatchPresence != nil {
+		mmWatchPresence.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.WatchPresence")
 	}
 
-	mmDeleteChat.mock.inspectFuncDeleteChat = f
+	mmWatchPresence.mock.inspectFuncWatchPresence = f
 
-	return mmDeleteChat
+	return mmWatchPresence
 }
 
-// Return sets up results that will be returned by ChatService.DeleteChat
-func (mmDeleteChat *mChatServiceMockDeleteChat) Return(err error) *ChatServiceMock {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+// Return sets up results that will be returned by ChatService.WatchPresence
+func (mmWatchPresence *mChatServiceMockWatchPresence) Return(ch1 <-chan *model.Presence, err error) *ChatServiceMock {
+	if mmWatchPresence.mock.funcWatchPresence != nil {
+		mmWatchPresence.mock.t.Fatalf("ChatServiceMock.WatchPresence mock is already set by Set")
 	}
 
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{mock: mmDeleteChat.mock}
+	if mmWatchPresence.defaultExpectation == nil {
+		mmWatchPresence.defaultExpectation = &ChatServiceMockWatchPresenceExpectation{mock: mmWatchPresence.mock}
 	}
-	mmDeleteChat.defaultExpectation.results = &ChatServiceMockDeleteChatResults{err}
-	return mmDeleteChat.mock
+	mmWatchPresence.defaultExpectation.results = &ChatServiceMockWatchPresenceResults{ch1, err}
+	return mmWatchPresence.mock
 }
 
-// Set uses given function f to mock the ChatService.DeleteChat method
-func (mmDeleteChat *mChatServiceMockDeleteChat) Set(f func(ctx context.Context, id int64) (err error)) *ChatServiceMock {
-	if mmDeleteChat.defaultExpectation != nil {
-		mmDeleteChat.mock.t.Fatalf("Default expectation is already set for the ChatService.DeleteChat method")
+// Set uses given function f to mock the ChatService.WatchPresence method
+func (mmWatchPresence *mChatServiceMockWatchPresence) Set(f func(ctx context.Context) (ch1 <-chan *model.Presence, err error)) *ChatServiceMock {
+	if mmWatchPresence.defaultExpectation != nil {
+		mmWatchPresence.mock.t.Fatalf("Default expectation is already set for the ChatService.WatchPresence method")
 	}
 
-	if len(mmDeleteChat.expectations) > 0 {
-		mmDeleteChat.mock.t.Fatalf("Some expectations are already set for the ChatService.DeleteChat method")
+	if len(mmWatchPresence.expectations) > 0 {
+		mmWatchPresence.mock.t.Fatalf("Some expectations are already set for the ChatService.WatchPresence method")
 	}
 
-	mmDeleteChat.mock.funcDeleteChat = f
-	return mmDeleteChat.mock
+	mmWatchPresence.mock.funcWatchPresence = f
+	return mmWatchPresence.mock
 }
 
-// When sets expectation for the ChatService.DeleteChat which will trigger the result defined by the following
+// When sets expectation for the ChatService.WatchPresence which will trigger the result defined by the following
 // Then helper
-func (mmDeleteChat *mChatServiceMockDeleteChat) When(ctx context.Context, id int64) *ChatServiceMockDeleteChatExpectation {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+func (mmWatchPresence *mChatServiceMockWatchPresence) When(ctx context.Context) *ChatServiceMockWatchPresenceExpectation {
+	if mmWatchPresence.mock.funcWatchPresence != nil {
+		mmWatchPresence.mock.t.Fatalf("ChatServiceMock.WatchPresence mock is already set by Set")
 	}
 
-	expectation := &ChatServiceMockDeleteChatExpectation{
-		mock:   mmDeleteChat.mock,
-		params: &ChatServiceMockDeleteChatParams{ctx, id},
+	expectation := &ChatServiceMockWatchPresenceExpectation{
+		mock:   mmWatchPresence.mock,
+		params: &ChatServiceMockWatchPresenceParams{ctx},
 	}
-	mmDeleteChat.expectations = append(mmDeleteChat.expectations, expectation)
+	mmWatchPresence.expectations = append(mmWatchPresence.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatService.DeleteChat return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockDeleteChatExpectation) Then(err error) *ChatServiceMock {
-	e.results = &ChatServiceMockDeleteChatResults{err}
+// Then sets up ChatService.WatchPresence return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockWatchPresenceExpectation) Then(ch1 <-chan *model.Presence, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockWatchPresenceResults{ch1, err}
 	return e.mock
 }
 
-// Times sets number of times ChatService.DeleteChat should be invoked
-func (mmDeleteChat *mChatServiceMockDeleteChat) Times(n uint64) *mChatServiceMockDeleteChat {
+// Times sets number of times ChatService.WatchPresence should be invoked
+func (mmWatchPresence *mChatServiceMockWatchPresence) Times(n uint64) *mChatServiceMockWatchPresence {
 	if n == 0 {
-		mmDeleteChat.mock.t.Fatalf("Times of ChatServiceMock.DeleteChat mock can not be zero")
+		mmWatchPresence.mock.t.Fatalf("Times of ChatServiceMock.WatchPresence mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteChat.expectedInvocations, n)
-	return mmDeleteChat
+	mm_atomic.StoreUint64(&mmWatchPresence.expectedInvocations, n)
+	return mmWatchPresence
 }
 
-func (mmDeleteChat *mChatServiceMockDeleteChat) invocationsDone() bool {
-	if len(mmDeleteChat.expectations) == 0 && mmDeleteChat.defaultExpectation == nil && mmDeleteChat.mock.funcDeleteChat == nil {
+func (mmWatchPresence *mChatServiceMockWatchPresence) invocationsDone() bool {
+	if len(mmWatchPresence.expectations) == 0 && mmWatchPresence.defaultExpectation == nil && mmWatchPresence.mock.funcWatchPresence == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteChat.mock.afterDeleteChatCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteChat.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmWatchPresence.mock.afterWatchPresenceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWatchPresence.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteChat implements service.ChatService
-func (mmDeleteChat *ChatServiceMock) DeleteChat(ctx context.Context, id int64) (err error) {
-	mm_atomic.AddUint64(&mmDeleteChat.beforeDeleteChatCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteChat.afterDeleteChatCounter, 1)
+// WatchPresence implements service.ChatService
+func (mmWatchPresence *ChatServiceMock) WatchPresence(ctx context.Context) (ch1 <-chan *model.Presence, err error) {
+	mm_atomic.AddUint64(&mmWatchPresence.beforeWatchPresenceCounter, 1)
+	defer mm_atomic.AddUint64(&mmWatchPresence.afterWatchPresenceCounter, 1)
 
-	if mmDeleteChat.inspectFuncDeleteChat != nil {
-		mmDeleteChat.inspectFuncDeleteChat(ctx, id)
+	if mmWatchPresence.inspectFuncWatchPresence != nil {
+		mmWatchPresence.inspectFuncWatchPresence(ctx)
 	}
 
-	mm_params := ChatServiceMockDeleteChatParams{ctx, id}
+	mm_params := ChatServiceMockWatchPresenceParams{ctx}
 
 	// Record call args
-	mmDeleteChat.DeleteChatMock.mutex.Lock()
-	mmDeleteChat.DeleteChatMock.callArgs = append(mmDeleteChat.DeleteChatMock.callArgs, &mm_params)
-	mmDeleteChat.DeleteChatMock.mutex.Unlock()
+	mmWatchPresence.WatchPresenceMock.mutex.Lock()
+	mmWatchPresence.WatchPresenceMock.callArgs = append(mmWatchPresence.WatchPresenceMock.callArgs, &mm_params)
+	mmWatchPresence.WatchPresenceMock.mutex.Unlock()
 
-	for _, e := range mmDeleteChat.DeleteChatMock.expectations {
+	for _, e := range mmWatchPresence.WatchPresenceMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.ch1, e.results.err
 		}
 	}
 
-	if mmDeleteChat.DeleteChatMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteChat.DeleteChatMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteChat.DeleteChatMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteChat.DeleteChatMock.defaultExpectation.paramPtrs
+	if mmWatchPresence.WatchPresenceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWatchPresence.WatchPresenceMock.defaultExpectation.Counter, 1)
+		mm_want := mmWatchPresence.WatchPresenceMock.defaultExpectation.params
+		mm_want_ptrs := mmWatchPresence.WatchPresenceMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatServiceMockDeleteChatParams{ctx, id}
+		mm_got := ChatServiceMockWatchPresenceParams{ctx}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteChat.t.Errorf("ChatServiceMock.DeleteChat got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmDeleteChat.t.Errorf("ChatServiceMock.DeleteChat got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+				mmWatchPresence.t.Errorf("ChatServiceMock.WatchPresence got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteChat.t.Errorf("ChatServiceMock.DeleteChat got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmWatchPresence.t.Errorf("ChatServiceMock.WatchPresence got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteChat.DeleteChatMock.defaultExpectation.results
+		mm_results := mmWatchPresence.WatchPresenceMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteChat.t.Fatal("No results are set for the ChatServiceMock.DeleteChat")
+			mmWatchPresence.t.Fatal("No results are set for the ChatServiceMock.WatchPresence")
 		}
-		return (*mm_results).err
+		return (*mm_results).ch1, (*mm_results).err
 	}
-	if mmDeleteChat.funcDeleteChat != nil {
-		return mmDeleteChat.funcDeleteChat(ctx, id)
+	if mmWatchPresence.funcWatchPresence != nil {
+		return mmWatchPresence.funcWatchPresence(ctx)
 	}
-	mmDeleteChat.t.Fatalf("Unexpected call to ChatServiceMock.DeleteChat. %v %v", ctx, id)
+	mmWatchPresence.t.Fatalf("Unexpected call to ChatServiceMock.WatchPresence. %v", ctx)
 	return
 }
 
-// DeleteChatAfterCounter returns a count of finished ChatServiceMock.DeleteChat invocations
-func (mmDeleteChat *ChatServiceMock) DeleteChatAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteChat.afterDeleteChatCounter)
+// WatchPresenceAfterCounter returns a count of finished ChatServiceMock.WatchPresence invocations
+func (mmWatchPresence *ChatServiceMock) WatchPresenceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWatchPresence.afterWatchPresenceCounter)
 }
 
-// DeleteChatBeforeCounter returns a count of ChatServiceMock.DeleteChat invocations
-func (mmDeleteChat *ChatServiceMock) DeleteChatBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteChat.beforeDeleteChatCounter)
+// WatchPresenceBeforeCounter returns a count of ChatServiceMock.WatchPresence invocations
+func (mmWatchPresence *ChatServiceMock) WatchPresenceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWatchPresence.beforeWatchPresenceCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatServiceMock.DeleteChat.
+// Calls returns a list of arguments used in each call to ChatServiceMock.WatchPresence.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteChat *mChatServiceMockDeleteChat) Calls() []*ChatServiceMockDeleteChatParams {
-	mmDeleteChat.mutex.RLock()
+func (mmWatchPresence *mChatServiceMockWatchPresence) Calls() []*ChatServiceMockWatchPresenceParams {
+	mmWatchPresence.mutex.RLock()
 
-	argCopy := make([]*ChatServiceMockDeleteChatParams, len(mmDeleteChat.callArgs))
-	copy(argCopy, mmDeleteChat.callArgs)
+	argCopy := make([]*ChatServiceMockWatchPresenceParams, len(mmWatchPresence.callArgs))
+	copy(argCopy, mmWatchPresence.callArgs)
 
-	mmDeleteChat.mutex.RUnlock()
+	mmWatchPresence.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteChatDone returns true if the count of the DeleteChat invocations corresponds
+// MinimockWatchPresenceDone returns true if the count of the WatchPresence invocations corresponds
 // the number of defined expectations
-func (m *ChatServiceMock) MinimockDeleteChatDone() bool {
-	if m.DeleteChatMock.optional {
+func (m *ChatServiceMock) MinimockWatchPresenceDone() bool {
+	if m.WatchPresenceMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteChatMock.expectations {
+	for _, e := range m.WatchPresenceMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteChatMock.invocationsDone()
+	return m.WatchPresenceMock.invocationsDone()
 }
 
-// MinimockDeleteChatInspect logs each unmet expectation
-func (m *ChatServiceMock) MinimockDeleteChatInspect() {
-	for _, e := range m.DeleteChatMock.expectations {
+// MinimockWatchPresenceInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockWatchPresenceInspect() {
+	for _, e := range m.WatchPresenceMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatServiceMock.DeleteChat with params: %#v", *e.params)
+			m.t.Errorf("Expected call to ChatServiceMock.WatchPresence with params: %#v", *e.params)
 		}
 	}
 
-	afterDeleteChatCounter := mm_atomic.LoadUint64(&m.afterDeleteChatCounter)
+	afterWatchPresenceCounter := mm_atomic.LoadUint64(&m.afterWatchPresenceCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteChatMock.defaultExpectation != nil && afterDeleteChatCounter < 1 {
-		if m.DeleteChatMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to ChatServiceMock.DeleteChat")
+	if m.WatchPresenceMock.defaultExpectation != nil && afterWatchPresenceCounter < 1 {
+		if m.WatchPresenceMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.WatchPresence")
 		} else {
-			m.t.Errorf("Expected call to ChatServiceMock.DeleteChat with params: %#v", *m.DeleteChatMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatServiceMock.WatchPresence with params: %#v", *m.WatchPresenceMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteChat != nil && afterDeleteChatCounter < 1 {
-		m.t.Error("Expected call to ChatServiceMock.DeleteChat")
+	if m.funcWatchPresence != nil && afterWatchPresenceCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.WatchPresence")
 	}
 
-	if !m.DeleteChatMock.invocationsDone() && afterDeleteChatCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatServiceMock.DeleteChat but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteChatMock.expectedInvocations), afterDeleteChatCounter)
+	if !m.WatchPresenceMock.invocationsDone() && afterWatchPresenceCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.WatchPresence but found %d calls",
+			mm_atomic.LoadUint64(&m.WatchPresenceMock.expectedInvocations), afterWatchPresenceCounter)
 	}
 }
 
-type mChatServiceMockSendMessage struct {
+type mChatServiceMockWatchTyping struct {
 	optional           bool
 	mock               *ChatServiceMock
-	defaultExpectation *ChatServiceMockSendMessageExpectation
-	expectations       []*ChatServiceMockSendMessageExpectation
+	defaultExpectation *ChatServiceMockWatchTypingExpectation
+	expectations       []*ChatServiceMockWatchTypingExpectation
 
-	callArgs []*ChatServiceMockSendMessageParams
+	callArgs []*ChatServiceMockWatchTypingParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// ChatServiceMockSendMessageExpectation specifies expectation struct of the ChatService.SendMessage
-type ChatServiceMockSendMessageExpectation struct {
+// ChatServiceMockWatchTypingExpectation specifies expectation struct of the ChatService.WatchTyping
+type ChatServiceMockWatchTypingExpectation struct {
 	mock      *ChatServiceMock
-	params    *ChatServiceMockSendMessageParams
-	paramPtrs *ChatServiceMockSendMessageParamPtrs
-	results   *ChatServiceMockSendMessageResults
+	params    *ChatServiceMockWatchTypingParams
+	paramPtrs *ChatServiceMockWatchTypingParamPtrs
+	results   *ChatServiceMockWatchTypingResults
 	Counter   uint64
 }
 
-// ChatServiceMockSendMessageParams contains parameters of the ChatService.SendMessage
-type ChatServiceMockSendMessageParams struct {
-	ctx           context.Context
-	createMessage *model.MessageCreate
+// ChatServiceMockWatchTypingParams contains parameters of the ChatService.WatchTyping
+type ChatServiceMockWatchTypingParams struct {
+	ctx    context.Context
+	chatID int64
 }
 
-// ChatServiceMockSendMessageParamPtrs contains pointers to parameters of the ChatService.SendMessage
-type ChatServiceMockSendMessageParamPtrs struct {
-	ctx           *context.Context
-	createMessage **model.MessageCreate
+// ChatServiceMockWatchTypingParamPtrs contains pointers to parameters of the ChatService.WatchTyping
+type ChatServiceMockWatchTypingParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
 }
 
-// ChatServiceMockSendMessageResults contains results of the ChatService.SendMessage
-type ChatServiceMockSendMessageResults struct {
-	s1  string
+// ChatServiceMockWatchTypingResults contains results of the ChatService.WatchTyping
+type ChatServiceMockWatchTypingResults struct {
+	ch1 <-chan *model.TypingEvent
 	err error
 }
 
@@ -745,280 +16281,280 @@ type ChatServiceMockSendMessageResults struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmSendMessage *mChatServiceMockSendMessage) Optional() *mChatServiceMockSendMessage {
-	mmSendMessage.optional = true
-	return mmSendMessage
+func (mmWatchTyping *mChatServiceMockWatchTyping) Optional() *mChatServiceMockWatchTyping {
+	mmWatchTyping.optional = true
+	return mmWatchTyping
 }
 
-// Expect sets up expected params for ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) Expect(ctx context.Context, createMessage *model.MessageCreate) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// Expect sets up expected params for ChatService.WatchTyping
+func (mmWatchTyping *mChatServiceMockWatchTyping) Expect(ctx context.Context, chatID int64) *mChatServiceMockWatchTyping {
+	if mmWatchTyping.mock.funcWatchTyping != nil {
+		mmWatchTyping.mock.t.Fatalf("ChatServiceMock.WatchTyping mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	if mmWatchTyping.defaultExpectation == nil {
+		mmWatchTyping.defaultExpectation = &ChatServiceMockWatchTypingExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by ExpectParams functions")
+	if mmWatchTyping.defaultExpectation.paramPtrs != nil {
+		mmWatchTyping.mock.t.Fatalf("ChatServiceMock.WatchTyping mock is already set by ExpectParams functions")
 	}
 
-	mmSendMessage.defaultExpectation.params = &ChatServiceMockSendMessageParams{ctx, createMessage}
-	for _, e := range mmSendMessage.expectations {
-		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
-			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+	mmWatchTyping.defaultExpectation.params = &ChatServiceMockWatchTypingParams{ctx, chatID}
+	for _, e := range mmWatchTyping.expectations {
+		if minimock.Equal(e.params, mmWatchTyping.defaultExpectation.params) {
+			mmWatchTyping.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWatchTyping.defaultExpectation.params)
 		}
 	}
 
-	return mmSendMessage
+	return mmWatchTyping
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatService.WatchTyping
+func (mmWatchTyping *mChatServiceMockWatchTyping) ExpectCtxParam1(ctx context.Context) *mChatServiceMockWatchTyping {
+	if mmWatchTyping.mock.funcWatchTyping != nil {
+		mmWatchTyping.mock.t.Fatalf("ChatServiceMock.WatchTyping mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	if mmWatchTyping.defaultExpectation == nil {
+		mmWatchTyping.defaultExpectation = &ChatServiceMockWatchTypingExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	if mmWatchTyping.defaultExpectation.params != nil {
+		mmWatchTyping.mock.t.Fatalf("ChatServiceMock.WatchTyping mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	if mmWatchTyping.defaultExpectation.paramPtrs == nil {
+		mmWatchTyping.defaultExpectation.paramPtrs = &ChatServiceMockWatchTypingParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmWatchTyping.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmSendMessage
+	return mmWatchTyping
 }
 
-// ExpectCreateMessageParam2 sets up expected param createMessage for ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) ExpectCreateMessageParam2(createMessage *model.MessageCreate) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.WatchTyping
+func (mmWatchTyping *mChatServiceMockWatchTyping) ExpectChatIDParam2(chatID int64) *mChatServiceMockWatchTyping {
+	if mmWatchTyping.mock.funcWatchTyping != nil {
+		mmWatchTyping.mock.t.Fatalf("ChatServiceMock.WatchTyping mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	if mmWatchTyping.defaultExpectation == nil {
+		mmWatchTyping.defaultExpectation = &ChatServiceMockWatchTypingExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	if mmWatchTyping.defaultExpectation.params != nil {
+		mmWatchTyping.mock.t.Fatalf("ChatServiceMock.WatchTyping mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	if mmWatchTyping.defaultExpectation.paramPtrs == nil {
+		mmWatchTyping.defaultExpectation.paramPtrs = &ChatServiceMockWatchTypingParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.createMessage = &createMessage
+	mmWatchTyping.defaultExpectation.paramPtrs.chatID = &chatID
 
-	return mmSendMessage
+	return mmWatchTyping
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) Inspect(f func(ctx context.Context, createMessage *model.MessageCreate)) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.inspectFuncSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SendMessage")
+// Inspect accepts an inspector function that has same arguments as the ChatService.WatchTyping
+func (mmWatchTyping *mChatServiceMockWatchTyping) Inspect(f func(ctx context.Context, chatID int64)) *mChatServiceMockWatchTyping {
+	if mmWatchTyping.mock.inspectFuncWatchTyping != nil {
+		mmWatchTyping.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.WatchTyping")
 	}
 
-	mmSendMessage.mock.inspectFuncSendMessage = f
+	mmWatchTyping.mock.inspectFuncWatchTyping = f
 
-	return mmSendMessage
+	return mmWatchTyping
 }
 
-// Return sets up results that will be returned by ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) Return(s1 string, err error) *ChatServiceMock {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// Return sets up results that will be returned by ChatService.WatchTyping
+func (mmWatchTyping *mChatServiceMockWatchTyping) Return(ch1 <-chan *model.TypingEvent, err error) *ChatServiceMock {
+	if mmWatchTyping.mock.funcWatchTyping != nil {
+		mmWatchTyping.mock.t.Fatalf("ChatServiceMock.WatchTyping mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{mock: mmSendMessage.mock}
+	if mmWatchTyping.defaultExpectation == nil {
+		mmWatchTyping.defaultExpectation = &ChatServiceMockWatchTypingExpectation{mock: mmWatchTyping.mock}
 	}
-	mmSendMessage.defaultExpectation.results = &ChatServiceMockSendMessageResults{s1, err}
-	return mmSendMessage.mock
+	mmWatchTyping.defaultExpectation.results = &ChatServiceMockWatchTypingResults{ch1, err}
+	return mmWatchTyping.mock
 }
 
-// Set uses given function f to mock the ChatService.SendMessage method
-func (mmSendMessage *mChatServiceMockSendMessage) Set(f func(ctx context.Context, createMessage *model.MessageCreate) (s1 string, err error)) *ChatServiceMock {
-	if mmSendMessage.defaultExpectation != nil {
-		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.SendMessage method")
+// Set uses given function f to mock the ChatService.WatchTyping method
+func (mmWatchTyping *mChatServiceMockWatchTyping) Set(f func(ctx context.Context, chatID int64) (ch1 <-chan *model.TypingEvent, err error)) *ChatServiceMock {
+	if mmWatchTyping.defaultExpectation != nil {
+		mmWatchTyping.mock.t.Fatalf("Default expectation is already set for the ChatService.WatchTyping method")
 	}
 
-	if len(mmSendMessage.expectations) > 0 {
-		mmSendMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.SendMessage method")
+	if len(mmWatchTyping.expectations) > 0 {
+		mmWatchTyping.mock.t.Fatalf("Some expectations are already set for the ChatService.WatchTyping method")
 	}
 
-	mmSendMessage.mock.funcSendMessage = f
-	return mmSendMessage.mock
+	mmWatchTyping.mock.funcWatchTyping = f
+	return mmWatchTyping.mock
 }
 
-// When sets expectation for the ChatService.SendMessage which will trigger the result defined by the following
+// When sets expectation for the ChatService.WatchTyping which will trigger the result defined by the following
 // Then helper
-func (mmSendMessage *mChatServiceMockSendMessage) When(ctx context.Context, createMessage *model.MessageCreate) *ChatServiceMockSendMessageExpectation {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+func (mmWatchTyping *mChatServiceMockWatchTyping) When(ctx context.Context, chatID int64) *ChatServiceMockWatchTypingExpectation {
+	if mmWatchTyping.mock.funcWatchTyping != nil {
+		mmWatchTyping.mock.t.Fatalf("ChatServiceMock.WatchTyping mock is already set by Set")
 	}
 
-	expectation := &ChatServiceMockSendMessageExpectation{
-		mock:   mmSendMessage.mock,
-		params: &ChatServiceMockSendMessageParams{ctx, createMessage},
+	expectation := &ChatServiceMockWatchTypingExpectation{
+		mock:   mmWatchTyping.mock,
+		params: &ChatServiceMockWatchTypingParams{ctx, chatID},
 	}
-	mmSendMessage.expectations = append(mmSendMessage.expectations, expectation)
+	mmWatchTyping.expectations = append(mmWatchTyping.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatService.SendMessage return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockSendMessageExpectation) Then(s1 string, err error) *ChatServiceMock {
-	e.results = &ChatServiceMockSendMessageResults{s1, err}
+// Then sets up ChatService.WatchTyping return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockWatchTypingExpectation) Then(ch1 <-chan *model.TypingEvent, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockWatchTypingResults{ch1, err}
 	return e.mock
 }
 
-// Times sets number of times ChatService.SendMessage should be invoked
-func (mmSendMessage *mChatServiceMockSendMessage) Times(n uint64) *mChatServiceMockSendMessage {
+// Times sets number of times ChatService.WatchTyping should be invoked
+func (mmWatchTyping *mChatServiceMockWatchTyping) Times(n uint64) *mChatServiceMockWatchTyping {
 	if n == 0 {
-		mmSendMessage.mock.t.Fatalf("Times of ChatServiceMock.SendMessage mock can not be zero")
+		mmWatchTyping.mock.t.Fatalf("Times of ChatServiceMock.WatchTyping mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmSendMessage.expectedInvocations, n)
-	return mmSendMessage
+	mm_atomic.StoreUint64(&mmWatchTyping.expectedInvocations, n)
+	return mmWatchTyping
 }
 
-func (mmSendMessage *mChatServiceMockSendMessage) invocationsDone() bool {
-	if len(mmSendMessage.expectations) == 0 && mmSendMessage.defaultExpectation == nil && mmSendMessage.mock.funcSendMessage == nil {
+func (mmWatchTyping *mChatServiceMockWatchTyping) invocationsDone() bool {
+	if len(mmWatchTyping.expectations) == 0 && mmWatchTyping.defaultExpectation == nil && mmWatchTyping.mock.funcWatchTyping == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmSendMessage.mock.afterSendMessageCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmSendMessage.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmWatchTyping.mock.afterWatchTypingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWatchTyping.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// SendMessage implements service.ChatService
-func (mmSendMessage *ChatServiceMock) SendMessage(ctx context.Context, createMessage *model.MessageCreate) (s1 string, err error) {
-	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
-	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
+// WatchTyping implements service.ChatService
+func (mmWatchTyping *ChatServiceMock) WatchTyping(ctx context.Context, chatID int64) (ch1 <-chan *model.TypingEvent, err error) {
+	mm_atomic.AddUint64(&mmWatchTyping.beforeWatchTypingCounter, 1)
+	defer mm_atomic.AddUint64(&mmWatchTyping.afterWatchTypingCounter, 1)
 
-	if mmSendMessage.inspectFuncSendMessage != nil {
-		mmSendMessage.inspectFuncSendMessage(ctx, createMessage)
+	if mmWatchTyping.inspectFuncWatchTyping != nil {
+		mmWatchTyping.inspectFuncWatchTyping(ctx, chatID)
 	}
 
-	mm_params := ChatServiceMockSendMessageParams{ctx, createMessage}
+	mm_params := ChatServiceMockWatchTypingParams{ctx, chatID}
 
 	// Record call args
-	mmSendMessage.SendMessageMock.mutex.Lock()
-	mmSendMessage.SendMessageMock.callArgs = append(mmSendMessage.SendMessageMock.callArgs, &mm_params)
-	mmSendMessage.SendMessageMock.mutex.Unlock()
+	mmWatchTyping.WatchTypingMock.mutex.Lock()
+	mmWatchTyping.WatchTypingMock.callArgs = append(mmWatchTyping.WatchTypingMock.callArgs, &mm_params)
+	mmWatchTyping.WatchTypingMock.mutex.Unlock()
 
-	for _, e := range mmSendMessage.SendMessageMock.expectations {
+	for _, e := range mmWatchTyping.WatchTypingMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.s1, e.results.err
+			return e.results.ch1, e.results.err
 		}
 	}
 
-	if mmSendMessage.SendMessageMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSendMessage.SendMessageMock.defaultExpectation.Counter, 1)
-		mm_want := mmSendMessage.SendMessageMock.defaultExpectation.params
-		mm_want_ptrs := mmSendMessage.SendMessageMock.defaultExpectation.paramPtrs
+	if mmWatchTyping.WatchTypingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWatchTyping.WatchTypingMock.defaultExpectation.Counter, 1)
+		mm_want := mmWatchTyping.WatchTypingMock.defaultExpectation.params
+		mm_want_ptrs := mmWatchTyping.WatchTypingMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatServiceMockSendMessageParams{ctx, createMessage}
+		mm_got := ChatServiceMockWatchTypingParams{ctx, chatID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmWatchTyping.t.Errorf("ChatServiceMock.WatchTyping got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.createMessage != nil && !minimock.Equal(*mm_want_ptrs.createMessage, mm_got.createMessage) {
-				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter createMessage, want: %#v, got: %#v%s\n", *mm_want_ptrs.createMessage, mm_got.createMessage, minimock.Diff(*mm_want_ptrs.createMessage, mm_got.createMessage))
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmWatchTyping.t.Errorf("ChatServiceMock.WatchTyping got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmWatchTyping.t.Errorf("ChatServiceMock.WatchTyping got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSendMessage.SendMessageMock.defaultExpectation.results
+		mm_results := mmWatchTyping.WatchTypingMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSendMessage.t.Fatal("No results are set for the ChatServiceMock.SendMessage")
+			mmWatchTyping.t.Fatal("No results are set for the ChatServiceMock.WatchTyping")
 		}
-		return (*mm_results).s1, (*mm_results).err
+		return (*mm_results).ch1, (*mm_results).err
 	}
-	if mmSendMessage.funcSendMessage != nil {
-		return mmSendMessage.funcSendMessage(ctx, createMessage)
+	if mmWatchTyping.funcWatchTyping != nil {
+		return mmWatchTyping.funcWatchTyping(ctx, chatID)
 	}
-	mmSendMessage.t.Fatalf("Unexpected call to ChatServiceMock.SendMessage. %v %v", ctx, createMessage)
+	mmWatchTyping.t.Fatalf("Unexpected call to ChatServiceMock.WatchTyping. %v %v", ctx, chatID)
 	return
 }
 
-// SendMessageAfterCounter returns a count of finished ChatServiceMock.SendMessage invocations
-func (mmSendMessage *ChatServiceMock) SendMessageAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSendMessage.afterSendMessageCounter)
+// WatchTypingAfterCounter returns a count of finished ChatServiceMock.WatchTyping invocations
+func (mmWatchTyping *ChatServiceMock) WatchTypingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWatchTyping.afterWatchTypingCounter)
 }
 
-// SendMessageBeforeCounter returns a count of ChatServiceMock.SendMessage invocations
-func (mmSendMessage *ChatServiceMock) SendMessageBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSendMessage.beforeSendMessageCounter)
+// WatchTypingBeforeCounter returns a count of ChatServiceMock.WatchTyping invocations
+func (mmWatchTyping *ChatServiceMock) WatchTypingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWatchTyping.beforeWatchTypingCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatServiceMock.SendMessage.
+// Calls returns a list of arguments used in each call to ChatServiceMock.WatchTyping.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSendMessage *mChatServiceMockSendMessage) Calls() []*ChatServiceMockSendMessageParams {
-	mmSendMessage.mutex.RLock()
+func (mmWatchTyping *mChatServiceMockWatchTyping) Calls() []*ChatServiceMockWatchTypingParams {
+	mmWatchTyping.mutex.RLock()
 
-	argCopy := make([]*ChatServiceMockSendMessageParams, len(mmSendMessage.callArgs))
-	copy(argCopy, mmSendMessage.callArgs)
+	argCopy := make([]*ChatServiceMockWatchTypingParams, len(mmWatchTyping.callArgs))
+	copy(argCopy, mmWatchTyping.callArgs)
 
-	mmSendMessage.mutex.RUnlock()
+	mmWatchTyping.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSendMessageDone returns true if the count of the SendMessage invocations corresponds
+// MinimockWatchTypingDone returns true if the count of the WatchTyping invocations corresponds
 // the number of defined expectations
-func (m *ChatServiceMock) MinimockSendMessageDone() bool {
-	if m.SendMessageMock.optional {
+func (m *ChatServiceMock) MinimockWatchTypingDone() bool {
+	if m.WatchTypingMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.SendMessageMock.expectations {
+	for _, e := range m.WatchTypingMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.SendMessageMock.invocationsDone()
+	return m.WatchTypingMock.invocationsDone()
 }
 
-// MinimockSendMessageInspect logs each unmet expectation
-func (m *ChatServiceMock) MinimockSendMessageInspect() {
-	for _, e := range m.SendMessageMock.expectations {
+// MinimockWatchTypingInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockWatchTypingInspect() {
+	for _, e := range m.WatchTypingMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatServiceMock.SendMessage with params: %#v", *e.params)
+			m.t.Errorf("Expected call to ChatServiceMock.WatchTyping with params: %#v", *e.params)
 		}
 	}
 
-	afterSendMessageCounter := mm_atomic.LoadUint64(&m.afterSendMessageCounter)
+	afterWatchTypingCounter := mm_atomic.LoadUint64(&m.afterWatchTypingCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SendMessageMock.defaultExpectation != nil && afterSendMessageCounter < 1 {
-		if m.SendMessageMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to ChatServiceMock.SendMessage")
+	if m.WatchTypingMock.defaultExpectation != nil && afterWatchTypingCounter < 1 {
+		if m.WatchTypingMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.WatchTyping")
 		} else {
-			m.t.Errorf("Expected call to ChatServiceMock.SendMessage with params: %#v", *m.SendMessageMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatServiceMock.WatchTyping with params: %#v", *m.WatchTypingMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSendMessage != nil && afterSendMessageCounter < 1 {
-		m.t.Error("Expected call to ChatServiceMock.SendMessage")
+	if m.funcWatchTyping != nil && afterWatchTypingCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.WatchTyping")
 	}
 
-	if !m.SendMessageMock.invocationsDone() && afterSendMessageCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatServiceMock.SendMessage but found %d calls",
-			mm_atomic.LoadUint64(&m.SendMessageMock.expectedInvocations), afterSendMessageCounter)
+	if !m.WatchTypingMock.invocationsDone() && afterWatchTypingCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.WatchTyping but found %d calls",
+			mm_atomic.LoadUint64(&m.WatchTypingMock.expectedInvocations), afterWatchTypingCounter)
 	}
 }
 
@@ -1026,11 +16562,101 @@ func (m *ChatServiceMock) MinimockSendMessageInspect() {
 func (m *ChatServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAddMembersInspect()
+
+			m.MinimockAddReactionInspect()
+
+			m.MinimockCancelScheduledMessageInspect()
+
+			m.MinimockClosePollInspect()
+
+			m.MinimockConnectChatInspect()
+
 			m.MinimockCreateChatInspect()
 
+			m.MinimockCreateInviteLinkInspect()
+
+			m.MinimockCreatePollInspect()
+
 			m.MinimockDeleteChatInspect()
 
+			m.MinimockDeleteMessageInspect()
+
+			m.MinimockEditMessageInspect()
+
+			m.MinimockForwardMessagesInspect()
+
+			m.MinimockGetChatInspect()
+
+			m.MinimockGetMessageHistoryInspect()
+
+			m.MinimockGetPresenceInspect()
+
+			m.MinimockGetReadStateInspect()
+
+			m.MinimockHeartbeatInspect()
+
+			m.MinimockJoinByInviteInspect()
+
+			m.MinimockLeaveChatInspect()
+
+			m.MinimockListChatsInspect()
+
+			m.MinimockListMentionsInspect()
+
+			m.MinimockListMessagesInspect()
+
+			m.MinimockListPinnedInspect()
+
+			m.MinimockListScheduledMessagesInspect()
+
+			m.MinimockListThreadInspect()
+
+			m.MinimockMarkReadInspect()
+
+			m.MinimockOpenAttachmentInspect()
+
+			m.MinimockPinMessageInspect()
+
+			m.MinimockPublishMessageInspect()
+
+			m.MinimockPurgeExpiredMessagesInspect()
+
+			m.MinimockPurgeStaleUploadsInspect()
+
+			m.MinimockRemoveMembersInspect()
+
+			m.MinimockRemoveReactionInspect()
+
+			m.MinimockRenameChatInspect()
+
+			m.MinimockRevokeInviteLinkInspect()
+
+			m.MinimockScheduleMessageInspect()
+
+			m.MinimockSearchMessagesInspect()
+
 			m.MinimockSendMessageInspect()
+
+			m.MinimockSetMemberRoleInspect()
+
+			m.MinimockSetMessageTTLInspect()
+
+			m.MinimockSetTypingInspect()
+
+			m.MinimockStoreMessageInspect()
+
+			m.MinimockTransferOwnershipInspect()
+
+			m.MinimockUnpinMessageInspect()
+
+			m.MinimockUploadAttachmentInspect()
+
+			m.MinimockVoteInspect()
+
+			m.MinimockWatchPresenceInspect()
+
+			m.MinimockWatchTypingInspect()
 		}
 	})
 }
@@ -1054,7 +16680,52 @@ func (m *ChatServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *ChatServiceMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddMembersDone() &&
+		m.MinimockAddReactionDone() &&
+		m.MinimockCancelScheduledMessageDone() &&
+		m.MinimockClosePollDone() &&
+		m.MinimockConnectChatDone() &&
 		m.MinimockCreateChatDone() &&
+		m.MinimockCreateInviteLinkDone() &&
+		m.MinimockCreatePollDone() &&
 		m.MinimockDeleteChatDone() &&
-		m.MinimockSendMessageDone()
+		m.MinimockDeleteMessageDone() &&
+		m.MinimockEditMessageDone() &&
+		m.MinimockForwardMessagesDone() &&
+		m.MinimockGetChatDone() &&
+		m.MinimockGetMessageHistoryDone() &&
+		m.MinimockGetPresenceDone() &&
+		m.MinimockGetReadStateDone() &&
+		m.MinimockHeartbeatDone() &&
+		m.MinimockJoinByInviteDone() &&
+		m.MinimockLeaveChatDone() &&
+		m.MinimockListChatsDone() &&
+		m.MinimockListMentionsDone() &&
+		m.MinimockListMessagesDone() &&
+		m.MinimockListPinnedDone() &&
+		m.MinimockListScheduledMessagesDone() &&
+		m.MinimockListThreadDone() &&
+		m.MinimockMarkReadDone() &&
+		m.MinimockOpenAttachmentDone() &&
+		m.MinimockPinMessageDone() &&
+		m.MinimockPublishMessageDone() &&
+		m.MinimockPurgeExpiredMessagesDone() &&
+		m.MinimockPurgeStaleUploadsDone() &&
+		m.MinimockRemoveMembersDone() &&
+		m.MinimockRemoveReactionDone() &&
+		m.MinimockRenameChatDone() &&
+		m.MinimockRevokeInviteLinkDone() &&
+		m.MinimockScheduleMessageDone() &&
+		m.MinimockSearchMessagesDone() &&
+		m.MinimockSendMessageDone() &&
+		m.MinimockSetMemberRoleDone() &&
+		m.MinimockSetMessageTTLDone() &&
+		m.MinimockSetTypingDone() &&
+		m.MinimockStoreMessageDone() &&
+		m.MinimockTransferOwnershipDone() &&
+		m.MinimockUnpinMessageDone() &&
+		m.MinimockUploadAttachmentDone() &&
+		m.MinimockVoteDone() &&
+		m.MinimockWatchPresenceDone() &&
+		m.MinimockWatchTypingDone()
 }
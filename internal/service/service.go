@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/BelyaevEI/microservices_chat/internal/model"
 )
@@ -9,6 +11,51 @@ import (
 // ChatService represents a chat service
 type ChatService interface {
 	CreateChat(ctx context.Context, createChat *model.ChatCreate) (int64, error)
-	SendMessage(ctx context.Context, createMessage *model.MessageCreate) (string, error)
+	SendMessage(ctx context.Context, createMessage *model.MessageCreate) (*model.Message, error)
+	StoreMessage(ctx context.Context, createMessage *model.MessageCreate) (*model.Message, error)
+	PublishMessage(message *model.Message)
 	DeleteChat(ctx context.Context, id int64) error
+	RenameChat(ctx context.Context, chatID int64, name string) error
+	GetChat(ctx context.Context, id int64) (*model.Chat, error)
+	ListChats(ctx context.Context, userID int64, pageSize int32, pageToken string) (*model.ChatPage, error)
+	ListMessages(ctx context.Context, query *model.MessageListQuery) (*model.MessagePage, error)
+	ListThread(ctx context.Context, query *model.ThreadQuery) (*model.ThreadPage, error)
+	SearchMessages(ctx context.Context, query *model.SearchQuery) (*model.SearchPage, error)
+	ConnectChat(ctx context.Context, chatID int64) (<-chan *model.Message, error)
+	ScheduleMessage(ctx context.Context, chatID int64, text string, sendAt time.Time) (*model.ScheduledMessage, error)
+	ListScheduledMessages(ctx context.Context, chatID int64) ([]*model.ScheduledMessage, error)
+	CancelScheduledMessage(ctx context.Context, chatID int64, scheduledID string) error
+	ForwardMessages(ctx context.Context, sourceChatID int64, messageIDs []string, targetChatID int64) ([]*model.Message, error)
+	EditMessage(ctx context.Context, edit *model.MessageEdit) (*model.Message, error)
+	GetMessageHistory(ctx context.Context, chatID int64, messageID string) ([]*model.MessageRevision, error)
+	DeleteMessage(ctx context.Context, del *model.MessageDelete) error
+	SetMessageTTL(ctx context.Context, chatID int64, ttl time.Duration) error
+	PurgeExpiredMessages(ctx context.Context, limit uint64) (int, error)
+	UploadAttachment(ctx context.Context, upload *model.AttachmentUpload, content io.Reader) (*model.Attachment, error)
+	OpenAttachment(ctx context.Context, attachmentID string) (*model.Attachment, io.ReadCloser, error)
+	PurgeStaleUploads(ctx context.Context, uploadedBefore time.Time, limit uint64) (int, error)
+	AddReaction(ctx context.Context, reaction *model.Reaction) error
+	RemoveReaction(ctx context.Context, reaction *model.Reaction) error
+	CreatePoll(ctx context.Context, create *model.PollCreate) (*model.Message, error)
+	Vote(ctx context.Context, vote *model.PollVote) (*model.Poll, error)
+	ClosePoll(ctx context.Context, chatID int64, messageID string) (*model.Poll, error)
+	PinMessage(ctx context.Context, chatID int64, messageID string) error
+	UnpinMessage(ctx context.Context, chatID int64, messageID string) error
+	ListPinned(ctx context.Context, chatID int64) ([]*model.PinnedMessage, error)
+	ListMentions(ctx context.Context, userID int64, pageSize int32, pageToken string) (*model.MentionPage, error)
+	AddMembers(ctx context.Context, chatID int64, userIDs []int64) error
+	RemoveMembers(ctx context.Context, chatID int64, userIDs []int64) error
+	LeaveChat(ctx context.Context, chatID, userID int64) error
+	SetMemberRole(ctx context.Context, chatID, userID int64, role string) error
+	TransferOwnership(ctx context.Context, chatID, userID int64) error
+	CreateInviteLink(ctx context.Context, chatID int64, expiresAt *time.Time, maxUses int32) (*model.InviteLink, error)
+	RevokeInviteLink(ctx context.Context, chatID int64, inviteID string) error
+	JoinByInvite(ctx context.Context, token string) (int64, error)
+	MarkRead(ctx context.Context, chatID, upToSeq int64) (*model.ReadMark, error)
+	GetReadState(ctx context.Context, chatID int64) ([]*model.ReadState, error)
+	SetTyping(ctx context.Context, chatID, userID int64, isTyping bool) error
+	WatchTyping(ctx context.Context, chatID int64) (<-chan *model.TypingEvent, error)
+	Heartbeat(ctx context.Context) error
+	GetPresence(ctx context.Context, userIDs []int64) ([]*model.Presence, error)
+	WatchPresence(ctx context.Context) (<-chan *model.Presence, error)
 }
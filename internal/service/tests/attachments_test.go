@@ -0,0 +1,288 @@
+package tests
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/BelyaevEI/microservices_chat/internal/storage"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUploadAttachment(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID   = gofakeit.Int64()
+		chatID   = gofakeit.Int64()
+		fileName = gofakeit.Word() + ".txt"
+		content  = "hello world"
+		sum      = sha256.Sum256([]byte(content))
+
+		ctx = auth.WithUserID(context.Background(), userID)
+
+		upload = &model.AttachmentUpload{
+			ChatID:   chatID,
+			FileName: fileName,
+		}
+
+		attachment = &model.Attachment{
+			ID:     gofakeit.UUID(),
+			ChatID: chatID,
+		}
+	)
+
+	tests := []struct {
+		name               string
+		maxSize            int64
+		want               *model.Attachment
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name:    "success case",
+			maxSize: int64(len(content)),
+			want:    attachment,
+			err:     nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.CreateAttachmentMock.
+					ExpectCtxParam1(ctx).
+					Inspect(func(_ context.Context, created *model.Attachment) {
+						if created.ChatID != chatID || created.UploaderID != userID || created.FileName != fileName ||
+							created.MimeType != "text/plain; charset=utf-8" || created.Size != int64(len(content)) ||
+							created.SHA256 != hex.EncodeToString(sum[:]) || created.StorageKey == "" {
+							mc.Errorf("unexpected attachment %+v", created)
+						}
+					}).
+					Return(attachment, nil)
+				return mock
+			},
+		},
+		{
+			name:    "too large case",
+			maxSize: int64(len(content)) - 1,
+			want:    nil,
+			err:     status.Errorf(codes.InvalidArgument, "attachment exceeds %d bytes", len(content)-1),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				return mock
+			},
+		},
+		{
+			name:    "not a member case",
+			maxSize: int64(len(content)),
+			want:    nil,
+			err:     status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(false, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			store, err := storage.NewLocalStore(dir)
+			require.NoError(t, err)
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{blobStore: store, maxAttachmentSize: test.maxSize})
+
+			res, err := service.UploadAttachment(ctx, upload, strings.NewReader(content))
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+
+			// rejected uploads leave nothing behind
+			entries, err := os.ReadDir(dir)
+			require.NoError(t, err)
+			if test.err != nil {
+				require.Empty(t, entries)
+			} else {
+				require.Len(t, entries, 1)
+			}
+		})
+	}
+
+}
+
+func TestOpenAttachment(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID       = gofakeit.Int64()
+		chatID       = gofakeit.Int64()
+		attachmentID = gofakeit.UUID()
+		messageID    = gofakeit.UUID()
+		storageKey   = "blob"
+		content      = "hello world"
+
+		ctx = auth.WithUserID(context.Background(), userID)
+
+		sent = &model.Attachment{
+			ID:         attachmentID,
+			ChatID:     chatID,
+			UploaderID: gofakeit.Int64(),
+			MessageID:  messageID,
+			StorageKey: storageKey,
+		}
+
+		unsent = &model.Attachment{
+			ID:         attachmentID,
+			ChatID:     chatID,
+			UploaderID: gofakeit.Int64(),
+			StorageKey: storageKey,
+		}
+	)
+
+	dir := t.TempDir()
+	store, err := storage.NewLocalStore(dir)
+	require.NoError(t, err)
+
+	_, err = store.Put(context.Background(), storageKey, strings.NewReader(content))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		want               *model.Attachment
+		wantContent        string
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name:        "success case",
+			want:        sent,
+			wantContent: content,
+			err:         nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetAttachmentMock.Expect(ctx, attachmentID).Return(sent, nil)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, messageID).Return(&model.Message{ID: messageID}, nil)
+				return mock
+			},
+		},
+		{
+			name: "expired message case",
+			want: nil,
+			err:  status.Errorf(codes.NotFound, "attachment %s not found", attachmentID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetAttachmentMock.Expect(ctx, attachmentID).Return(sent, nil)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, messageID).Return(nil, repository.ErrMessageNotFound)
+				return mock
+			},
+		},
+		{
+			name: "not sent yet case",
+			want: nil,
+			err:  status.Errorf(codes.NotFound, "attachment %s not found", attachmentID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetAttachmentMock.Expect(ctx, attachmentID).Return(unsent, nil)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				return mock
+			},
+		},
+		{
+			name: "not a member case",
+			want: nil,
+			err:  status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetAttachmentMock.Expect(ctx, attachmentID).Return(sent, nil)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "not found case",
+			want: nil,
+			err:  status.Errorf(codes.NotFound, "attachment %s not found", attachmentID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetAttachmentMock.Expect(ctx, attachmentID).Return(nil, repository.ErrAttachmentNotFound)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{blobStore: store})
+
+			res, reader, err := service.OpenAttachment(ctx, attachmentID)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+
+			if reader != nil {
+				defer reader.Close()
+
+				data, errRead := io.ReadAll(reader)
+				require.NoError(t, errRead)
+				require.Equal(t, test.wantContent, string(data))
+			}
+		})
+	}
+
+}
+
+func TestPurgeStaleUploads(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		ctx            = context.Background()
+		uploadedBefore = gofakeit.Date()
+		storageKey     = "stale"
+		limit          = uint64(10)
+	)
+
+	dir := t.TempDir()
+	store, err := storage.NewLocalStore(dir)
+	require.NoError(t, err)
+
+	_, err = store.Put(ctx, storageKey, strings.NewReader(gofakeit.Phrase()))
+	require.NoError(t, err)
+
+	chatRepositoryMock := mocks.NewChatRepositoryMock(mc)
+	chatRepositoryMock.DeleteStaleUploadsMock.Expect(ctx, uploadedBefore, limit).Return([]string{storageKey}, nil)
+
+	service := newTestService(chatRepositoryMock, serviceDeps{blobStore: store})
+
+	purged, err := service.PurgeStaleUploads(ctx, uploadedBefore, limit)
+	require.NoError(t, err)
+	require.Equal(t, 1, purged)
+
+	_, err = store.Open(ctx, storageKey)
+	require.ErrorIs(t, err, storage.ErrBlobNotFound)
+}
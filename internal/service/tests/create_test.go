@@ -0,0 +1,161 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/client/directory"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateChat(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	type args struct {
+		ctx context.Context
+		req *model.ChatCreate
+	}
+
+	var (
+		mc = minimock.NewController(t)
+
+		creatorID = int64(10)
+		ctx       = auth.WithUserID(context.Background(), creatorID)
+
+		id       = gofakeit.Int64()
+		chatName = gofakeit.Animal()
+
+		userDirectory = directory.NewStaticDirectory(map[string]int64{
+			"alice": 10,
+			"bob":   20,
+		})
+
+		repoErr = fmt.Errorf("repo error")
+
+		byNames = &model.ChatCreate{
+			Name:      chatName,
+			UserID:    []int64{20, 30},
+			Usernames: []string{"alice", "bob"},
+		}
+
+		unknownName = &model.ChatCreate{
+			Name:      chatName,
+			Usernames: []string{"alice", "carol"},
+		}
+
+		unlistedCreator = &model.ChatCreate{
+			Name:   chatName,
+			UserID: []int64{30},
+		}
+
+		members = []*model.ChatMember{
+			{UserID: 20, Username: "bob", Role: model.MemberRoleMember},
+			{UserID: 30, Role: model.MemberRoleMember},
+			{UserID: 10, Username: "alice", Role: model.MemberRoleOwner},
+		}
+	)
+
+	tests := []struct {
+		name               string
+		args               args
+		want               int64
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: byNames,
+			},
+			want: id,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.CreateChatMock.Expect(ctx, byNames).Return(id, nil)
+				mock.AddMembersMock.Expect(ctx, id, members).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "creator not listed case",
+			args: args{
+				ctx: ctx,
+				req: unlistedCreator,
+			},
+			want: id,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.CreateChatMock.Expect(ctx, unlistedCreator).Return(id, nil)
+				mock.AddMembersMock.Expect(ctx, id, []*model.ChatMember{
+					{UserID: creatorID, Role: model.MemberRoleOwner},
+					{UserID: 30, Role: model.MemberRoleMember},
+				}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "unauthenticated case",
+			args: args{
+				ctx: context.Background(),
+				req: byNames,
+			},
+			want: 0,
+			err:  status.Error(codes.Unauthenticated, "caller identity is unknown"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "unknown username case",
+			args: args{
+				ctx: ctx,
+				req: unknownName,
+			},
+			want: 0,
+			err:  status.Error(codes.InvalidArgument, "unknown usernames: carol"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "repository error case",
+			args: args{
+				ctx: ctx,
+				req: byNames,
+			},
+			want: 0,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.CreateChatMock.Expect(ctx, byNames).Return(0, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{userDirectory: userDirectory})
+
+			id, err := service.CreateChat(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, id)
+		})
+	}
+
+}
@@ -0,0 +1,155 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/BelyaevEI/microservices_chat/internal/storage"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDeleteMessage(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID    = gofakeit.Int64()
+		authorID  = gofakeit.Int64()
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.UUID()
+		deletedAt = time.Now()
+
+		ctx = auth.WithUserID(context.Background(), userID)
+
+		forMe = &model.MessageDelete{
+			MessageID: messageID,
+			ChatID:    chatID,
+		}
+
+		forEveryone = &model.MessageDelete{
+			MessageID:   messageID,
+			ChatID:      chatID,
+			ForEveryone: true,
+		}
+
+		// the shared blob is still used by a forwarded copy
+		removedKey = "removed"
+		sharedKey  = "shared"
+
+		othersMessage = &model.Message{
+			ID:   messageID,
+			Info: model.MessageInfo{ChatID: chatID, UserID: authorID},
+		}
+	)
+
+	dir := t.TempDir()
+	store, err := storage.NewLocalStore(dir)
+	require.NoError(t, err)
+
+	for _, key := range []string{removedKey, sharedKey} {
+		_, err = store.Put(context.Background(), key, strings.NewReader(gofakeit.Phrase()))
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name               string
+		del                *model.MessageDelete
+		err                error
+		removedBlobs       []string
+		keptBlobs          []string
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "for me case",
+			del:  forMe,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, messageID).Return(othersMessage, nil)
+				mock.HideMessageMock.Expect(ctx, chatID, messageID, userID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:         "admin for everyone case",
+			del:          forEveryone,
+			err:          nil,
+			removedBlobs: []string{removedKey},
+			keptBlobs:    []string{sharedKey},
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageForUpdateMock.Expect(ctx, chatID, messageID).Return(othersMessage, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleAdmin, nil)
+				mock.DeleteMessageRevisionsMock.Expect(ctx, chatID, messageID).Return(nil)
+				mock.DeleteMessageForEveryoneMock.Expect(ctx, chatID, messageID).Return(&model.Message{
+					ID:        messageID,
+					Info:      model.MessageInfo{ChatID: chatID, UserID: authorID},
+					DeletedAt: &deletedAt,
+				}, nil)
+				mock.DeleteMessageAttachmentsMock.Expect(ctx, chatID, messageID).Return([]string{removedKey, sharedKey}, nil)
+				mock.GetUsedStorageKeysMock.Expect(ctx, []string{removedKey, sharedKey}).Return([]string{sharedKey}, nil)
+				mock.ListHiddenForMock.Expect(ctx, chatID, messageID).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "member for everyone case",
+			del:  forEveryone,
+			err:  status.Error(codes.PermissionDenied, "only the author or an admin can delete the message for everyone"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageForUpdateMock.Expect(ctx, chatID, messageID).Return(othersMessage, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleMember, nil)
+				return mock
+			},
+		},
+		{
+			name: "not a member case",
+			del:  forMe,
+			err:  status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(false, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{blobStore: store})
+
+			err := service.DeleteMessage(ctx, test.del)
+			require.Equal(t, test.err, err)
+
+			for _, key := range test.removedBlobs {
+				_, errOpen := store.Open(context.Background(), key)
+				require.ErrorIs(t, errOpen, storage.ErrBlobNotFound)
+			}
+
+			for _, key := range test.keptBlobs {
+				content, errOpen := store.Open(context.Background(), key)
+				require.NoError(t, errOpen)
+				require.NoError(t, content.Close())
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEditMessage(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID    = gofakeit.Int64()
+		authorID  = gofakeit.Int64()
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.UUID()
+
+		ctx = auth.WithUserID(context.Background(), userID)
+
+		edit = &model.MessageEdit{
+			MessageID: messageID,
+			ChatID:    chatID,
+			Text:      gofakeit.Phrase(),
+		}
+	)
+
+	tests := []struct {
+		name               string
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "not the author case",
+			err:  status.Error(codes.PermissionDenied, "only the author can edit the message"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageForUpdateMock.Expect(ctx, chatID, messageID).Return(&model.Message{
+					ID:   messageID,
+					Info: model.MessageInfo{ChatID: chatID, UserID: authorID},
+				}, nil)
+				return mock
+			},
+		},
+		{
+			name: "voted poll case",
+			err:  status.Error(codes.FailedPrecondition, "poll that has votes can't be edited"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageForUpdateMock.Expect(ctx, chatID, messageID).Return(&model.Message{
+					ID:   messageID,
+					Info: model.MessageInfo{ChatID: chatID, UserID: userID},
+				}, nil)
+				mock.GetPollForUpdateMock.Expect(ctx, chatID, messageID).Return(&model.Poll{MessageID: messageID, ChatID: chatID}, nil)
+				mock.GetPollsMock.Expect(ctx, chatID, []string{messageID}).Return(map[string]*model.Poll{
+					messageID: {MessageID: messageID, ChatID: chatID, TotalVoters: 2},
+				}, nil)
+				return mock
+			},
+		},
+		{
+			name: "not a member case",
+			err:  status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(false, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			message, err := service.EditMessage(ctx, edit)
+			require.Equal(t, test.err, err)
+			require.Nil(t, message)
+		})
+	}
+}
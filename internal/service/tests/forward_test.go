@@ -0,0 +1,164 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestForwardMessages(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID       = gofakeit.Int64()
+		authorID     = gofakeit.Int64()
+		sourceChatID = gofakeit.Int64()
+		targetChatID = gofakeit.Int64()
+		firstID      = gofakeit.UUID()
+		secondID     = gofakeit.UUID()
+		copyID       = gofakeit.UUID()
+		seq          = gofakeit.Int64()
+		text         = gofakeit.Phrase()
+		deletedAt    = time.Now()
+
+		ctx = auth.WithUserID(context.Background(), userID)
+
+		// forwarding a forwarded message keeps its origin
+		origin = &model.ForwardedFrom{
+			UserID:    gofakeit.Int64(),
+			ChatID:    gofakeit.Int64(),
+			MessageID: gofakeit.UUID(),
+		}
+
+		first = &model.Message{
+			ID:   firstID,
+			Info: model.MessageInfo{ChatID: sourceChatID, UserID: authorID, Text: text},
+		}
+
+		second = &model.Message{
+			ID:            secondID,
+			Info:          model.MessageInfo{ChatID: sourceChatID, UserID: authorID, Text: text},
+			ForwardedFrom: origin,
+		}
+
+		attachments = []*model.Attachment{{ID: gofakeit.UUID(), ChatID: sourceChatID, MessageID: firstID}}
+		copies      = []*model.Attachment{{ID: gofakeit.UUID(), ChatID: targetChatID, MessageID: copyID}}
+
+		firstCopy = &model.MessageCreate{
+			Info: model.MessageInfo{ChatID: targetChatID, UserID: userID, Text: text},
+			ForwardedFrom: &model.ForwardedFrom{
+				UserID:    authorID,
+				ChatID:    sourceChatID,
+				MessageID: firstID,
+			},
+		}
+
+		secondCopy = &model.MessageCreate{
+			Info:          model.MessageInfo{ChatID: targetChatID, UserID: userID, Text: text},
+			ForwardedFrom: origin,
+		}
+	)
+
+	tests := []struct {
+		name               string
+		messageIDs         []string
+		want               []*model.Message
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name:       "success case",
+			messageIDs: []string{firstID, secondID},
+			want: []*model.Message{
+				{ID: copyID, Seq: seq, Info: firstCopy.Info, ForwardedFrom: firstCopy.ForwardedFrom, Attachments: copies},
+				{ID: copyID, Seq: seq, Info: secondCopy.Info, ForwardedFrom: origin},
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.When(ctx, sourceChatID, userID).Then(true, nil)
+				mock.IsMemberMock.When(ctx, targetChatID, userID).Then(true, nil)
+				mock.GetMessageMock.When(ctx, sourceChatID, firstID).Then(&model.Message{ID: firstID, Info: first.Info}, nil)
+				mock.GetMessageMock.When(ctx, sourceChatID, secondID).Then(second, nil)
+				mock.GetAttachmentsMock.Expect(ctx, sourceChatID, []string{firstID, secondID}).Return(map[string][]*model.Attachment{firstID: attachments}, nil)
+				mock.NextMessageSeqMock.Expect(ctx, targetChatID).Return(seq, nil)
+				mock.SendMessageMock.When(ctx, firstCopy, seq).Then(&model.Message{ID: copyID, Seq: seq, Info: firstCopy.Info, ForwardedFrom: firstCopy.ForwardedFrom}, nil)
+				mock.SendMessageMock.When(ctx, secondCopy, seq).Then(&model.Message{ID: copyID, Seq: seq, Info: secondCopy.Info, ForwardedFrom: origin}, nil)
+				mock.MarkReadMock.Expect(ctx, targetChatID, userID, seq).Return(&model.ReadMark{LastReadSeq: seq}, nil)
+				mock.UpdateLastActivityMock.Expect(ctx, targetChatID).Return(nil)
+				mock.CopyAttachmentsMock.Inspect(func(_ context.Context, source, target *model.Message) {
+					if source.ID != firstID || target.ID != copyID {
+						mc.Errorf("unexpected copy of %s to %s", source.ID, target.ID)
+					}
+				}).Return(copies, nil)
+				return mock
+			},
+		},
+		{
+			name:       "not a member of the target chat case",
+			messageIDs: []string{firstID},
+			want:       nil,
+			err:        status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, targetChatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.When(ctx, sourceChatID, userID).Then(true, nil)
+				mock.IsMemberMock.When(ctx, targetChatID, userID).Then(false, nil)
+				return mock
+			},
+		},
+		{
+			name:       "message not found case",
+			messageIDs: []string{firstID, secondID},
+			want:       nil,
+			err:        status.Errorf(codes.NotFound, "message %s not found in chat %d", secondID, sourceChatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.When(ctx, sourceChatID, userID).Then(true, nil)
+				mock.IsMemberMock.When(ctx, targetChatID, userID).Then(true, nil)
+				mock.GetMessageMock.When(ctx, sourceChatID, firstID).Then(first, nil)
+				mock.GetMessageMock.When(ctx, sourceChatID, secondID).Then(nil, repository.ErrMessageNotFound)
+				return mock
+			},
+		},
+		{
+			name:       "deleted message case",
+			messageIDs: []string{firstID},
+			want:       nil,
+			err:        status.Error(codes.FailedPrecondition, "deleted message can't be forwarded"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.When(ctx, sourceChatID, userID).Then(true, nil)
+				mock.IsMemberMock.When(ctx, targetChatID, userID).Then(true, nil)
+				mock.GetMessageMock.When(ctx, sourceChatID, firstID).Then(&model.Message{ID: firstID, DeletedAt: &deletedAt}, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			messages, err := service.ForwardMessages(ctx, sourceChatID, test.messageIDs, targetChatID)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, messages)
+		})
+	}
+
+}
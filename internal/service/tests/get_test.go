@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetChat(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID = gofakeit.Int64()
+		chatID = gofakeit.Int64()
+
+		ctx = auth.WithUserID(context.Background(), userID)
+
+		chat = &model.Chat{
+			ID:     chatID,
+			Name:   gofakeit.Name(),
+			UserID: []int64{userID},
+		}
+	)
+
+	tests := []struct {
+		name               string
+		want               *model.Chat
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			want: chat,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetChatMock.Expect(ctx, chatID).Return(chat, nil)
+				return mock
+			},
+		},
+		{
+			name: "not a member case",
+			want: nil,
+			err:  status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "chat not found case",
+			want: nil,
+			err:  status.Errorf(codes.NotFound, "chat with id %d not found", chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(false, repository.ErrChatNotFound)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			chat, err := service.GetChat(ctx, chatID)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, chat)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package tests
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateInviteLink(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID    = gofakeit.Int64()
+		chatID    = gofakeit.Int64()
+		inviteID  = gofakeit.UUID()
+		maxUses   = int32(5)
+		expiresAt = time.Now().Add(time.Hour)
+		past      = time.Now().Add(-time.Minute)
+
+		ctx = auth.WithUserID(context.Background(), userID)
+	)
+
+	tests := []struct {
+		name               string
+		expiresAt          *time.Time
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name:      "success case",
+			expiresAt: &expiresAt,
+			err:       nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleAdmin, nil)
+				mock.CreateInviteLinkMock.Set(func(_ context.Context, create *model.InviteLinkCreate) (*model.InviteLink, error) {
+					require.Equal(t, chatID, create.ChatID)
+					require.Equal(t, userID, create.CreatedBy)
+					require.Equal(t, &expiresAt, create.ExpiresAt)
+					require.Equal(t, maxUses, create.MaxUses)
+					require.Len(t, create.TokenHash, sha256.Size*2)
+
+					return &model.InviteLink{
+						ID:        inviteID,
+						ChatID:    chatID,
+						CreatedBy: userID,
+						ExpiresAt: create.ExpiresAt,
+						MaxUses:   create.MaxUses,
+					}, nil
+				})
+				return mock
+			},
+		},
+		{
+			name:      "expiry in the past case",
+			expiresAt: &past,
+			err:       status.Error(codes.InvalidArgument, "invite link expiry must be in the future"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name:      "plain member case",
+			expiresAt: nil,
+			err:       status.Errorf(codes.PermissionDenied, "user %d may not create invite links to chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleMember, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			res, err := service.CreateInviteLink(ctx, chatID, test.expiresAt, maxUses)
+			require.Equal(t, test.err, err)
+			if err == nil {
+				require.Equal(t, inviteID, res.ID)
+				require.NotEmpty(t, res.Token)
+			}
+		})
+	}
+
+}
+
+func TestJoinByInvite(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID    = gofakeit.Int64()
+		chatID    = gofakeit.Int64()
+		inviteID  = gofakeit.UUID()
+		token     = gofakeit.LetterN(43)
+		seq       = gofakeit.Int64()
+		revokedAt = time.Now().Add(-time.Minute)
+
+		ctx = auth.WithUserID(context.Background(), userID)
+
+		hash      = sha256.Sum256([]byte(token))
+		tokenHash = hex.EncodeToString(hash[:])
+
+		event = &model.MessageCreate{
+			Info: model.MessageInfo{
+				ChatID: chatID,
+				UserID: userID,
+			},
+			SystemEvent: model.SystemEventUserJoined,
+		}
+	)
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		want               int64
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			ctx:  ctx,
+			want: chatID,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetInviteLinkForUpdateMock.Expect(ctx, tokenHash).Return(&model.InviteLink{ID: inviteID, ChatID: chatID, MaxUses: 2, Uses: 1}, nil)
+				mock.LockChatMock.Expect(ctx, chatID).Return(nil)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(false, nil)
+				mock.AddMembersMock.Expect(ctx, chatID, []*model.ChatMember{{UserID: userID, Role: model.MemberRoleMember}}).Return(nil)
+				mock.CountInviteUseMock.Expect(ctx, inviteID).Return(nil)
+				mock.NextMessageSeqMock.Expect(ctx, chatID).Return(seq, nil)
+				mock.SendMessageMock.Expect(ctx, event, seq).Return(&model.Message{ID: gofakeit.UUID(), Info: event.Info, SystemEvent: event.SystemEvent}, nil)
+				mock.MarkReadMock.Expect(ctx, chatID, userID, seq).Return(&model.ReadMark{LastReadSeq: seq}, nil)
+				mock.UpdateLastActivityMock.Expect(ctx, chatID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "already a member case",
+			ctx:  ctx,
+			want: chatID,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetInviteLinkForUpdateMock.Expect(ctx, tokenHash).Return(&model.InviteLink{ID: inviteID, ChatID: chatID}, nil)
+				mock.LockChatMock.Expect(ctx, chatID).Return(nil)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				return mock
+			},
+		},
+		{
+			name: "used up case",
+			ctx:  ctx,
+			want: 0,
+			err:  status.Error(codes.FailedPrecondition, "invite link is revoked, expired or used up"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetInviteLinkForUpdateMock.Expect(ctx, tokenHash).Return(&model.InviteLink{ID: inviteID, ChatID: chatID, MaxUses: 2, Uses: 2}, nil)
+				return mock
+			},
+		},
+		{
+			name: "revoked case",
+			ctx:  ctx,
+			want: 0,
+			err:  status.Error(codes.FailedPrecondition, "invite link is revoked, expired or used up"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetInviteLinkForUpdateMock.Expect(ctx, tokenHash).Return(&model.InviteLink{ID: inviteID, ChatID: chatID, RevokedAt: &revokedAt}, nil)
+				return mock
+			},
+		},
+		{
+			name: "unknown token case",
+			ctx:  ctx,
+			want: 0,
+			err:  status.Error(codes.NotFound, "invite link not found"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetInviteLinkForUpdateMock.Expect(ctx, tokenHash).Return(nil, repository.ErrInviteLinkNotFound)
+				return mock
+			},
+		},
+		{
+			name: "unauthenticated case",
+			ctx:  context.Background(),
+			want: 0,
+			err:  status.Error(codes.Unauthenticated, "caller identity is unknown"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			res, err := service.JoinByInvite(test.ctx, token)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
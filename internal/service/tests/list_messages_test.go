@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListMessages(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID = gofakeit.Int64()
+		chatID = gofakeit.Int64()
+
+		memberCtx = auth.WithUserID(context.Background(), userID)
+	)
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "not a member case",
+			ctx:  memberCtx,
+			err:  status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(memberCtx, chatID, userID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "chat not found case",
+			ctx:  memberCtx,
+			err:  status.Errorf(codes.NotFound, "chat with id %d not found", chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(memberCtx, chatID, userID).Return(false, repository.ErrChatNotFound)
+				return mock
+			},
+		},
+		{
+			name: "unauthenticated case",
+			ctx:  context.Background(),
+			err:  status.Error(codes.Unauthenticated, "caller identity is unknown"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			page, err := service.ListMessages(test.ctx, &model.MessageListQuery{ChatID: chatID})
+			require.Equal(t, test.err, err)
+			require.Nil(t, page)
+		})
+	}
+}
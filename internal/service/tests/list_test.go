@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListChats(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID  = gofakeit.Int64()
+		otherID = gofakeit.Int64()
+		chatID  = gofakeit.Int64()
+
+		ctx = auth.WithUserID(context.Background(), userID)
+
+		chats = []*model.Chat{
+			{ID: chatID, Name: gofakeit.Name(), UnreadCount: 3},
+		}
+	)
+
+	tests := []struct {
+		name               string
+		userID             int64
+		want               *model.ChatPage
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name:   "success case",
+			userID: userID,
+			want:   &model.ChatPage{Chats: chats},
+			err:    nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.ListChatsMock.Expect(ctx, userID, nil, uint64(21)).Return(chats, nil)
+				return mock
+			},
+		},
+		{
+			name:   "other user case",
+			userID: otherID,
+			want:   nil,
+			err:    status.Errorf(codes.PermissionDenied, "user %d cannot list chats of user %d", userID, otherID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			page, err := service.ListChats(ctx, test.userID, 0, "")
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, page)
+		})
+	}
+}
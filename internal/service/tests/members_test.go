@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemovedMemberStreams(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		ownerID     = gofakeit.Int64()
+		memberID    = gofakeit.Int64()
+		bystanderID = gofakeit.Int64()
+		chatID      = gofakeit.Int64()
+
+		ownerCtx     = auth.WithUserID(context.Background(), ownerID)
+		memberCtx    = auth.WithUserID(context.Background(), memberID)
+		bystanderCtx = auth.WithUserID(context.Background(), bystanderID)
+	)
+
+	tests := []struct {
+		name   string
+		remove func(mock *mocks.ChatRepositoryMock, service service.ChatService) error
+	}{
+		{
+			name: "removed case",
+			remove: func(mock *mocks.ChatRepositoryMock, service service.ChatService) error {
+				mock.LockChatMock.Expect(ownerCtx, chatID).Return(nil)
+				mock.GetMemberRoleMock.Expect(ownerCtx, chatID, ownerID).Return(model.MemberRoleOwner, nil)
+				mock.GetMemberRolesMock.Expect(ownerCtx, chatID, []int64{memberID}).Return(map[int64]string{
+					memberID: model.MemberRoleMember,
+				}, nil)
+				mock.RemoveMembersMock.Expect(ownerCtx, chatID, []int64{memberID}).Return(1, nil)
+
+				return service.RemoveMembers(ownerCtx, chatID, []int64{memberID})
+			},
+		},
+		{
+			name: "left case",
+			remove: func(mock *mocks.ChatRepositoryMock, service service.ChatService) error {
+				mock.LockChatMock.Expect(memberCtx, chatID).Return(nil)
+				mock.GetMemberRoleMock.Expect(memberCtx, chatID, memberID).Return(model.MemberRoleMember, nil)
+				mock.RemoveMembersMock.Expect(memberCtx, chatID, []int64{memberID}).Return(1, nil)
+
+				return service.LeaveChat(memberCtx, chatID, memberID)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := mocks.NewChatRepositoryMock(mc)
+			chatRepositoryMock.IsMemberMock.When(memberCtx, chatID, memberID).Then(true, nil)
+			chatRepositoryMock.IsMemberMock.When(bystanderCtx, chatID, bystanderID).Then(true, nil)
+
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			messages, err := service.ConnectChat(memberCtx, chatID)
+			require.NoError(t, err)
+
+			typingEvents, err := service.WatchTyping(memberCtx, chatID)
+			require.NoError(t, err)
+
+			bystanderMessages, err := service.ConnectChat(bystanderCtx, chatID)
+			require.NoError(t, err)
+
+			require.NoError(t, test.remove(chatRepositoryMock, service))
+
+			// the removed member's streams end, the others stay open
+			select {
+			case _, ok := <-messages:
+				require.False(t, ok)
+			case <-time.After(time.Second):
+				t.Fatal("message stream of the removed member is still open")
+			}
+
+			select {
+			case _, ok := <-typingEvents:
+				require.False(t, ok)
+			case <-time.After(time.Second):
+				t.Fatal("typing stream of the removed member is still open")
+			}
+
+			select {
+			case <-bystanderMessages:
+				t.Fatal("message stream of another member was closed")
+			default:
+			}
+		})
+	}
+}
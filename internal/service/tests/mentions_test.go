@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListMentions(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID    = gofakeit.Int64()
+		otherID   = gofakeit.Int64()
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.UUID()
+
+		ctx = auth.WithUserID(context.Background(), userID)
+
+		mentions = []*model.MessageMention{
+			{UserID: userID, Offset: 0, Length: 4},
+		}
+	)
+
+	tests := []struct {
+		name               string
+		userID             int64
+		pageToken          string
+		want               *model.MentionPage
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name:   "success case",
+			userID: userID,
+			want: &model.MentionPage{
+				Messages: []*model.Message{
+					{ID: messageID, Info: model.MessageInfo{ChatID: chatID}, Mentions: mentions},
+				},
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.ListMentionsMock.Expect(ctx, userID, nil, uint64(21)).Return([]*model.Message{
+					{ID: messageID, Info: model.MessageInfo{ChatID: chatID}},
+				}, nil)
+				mock.GetMentionsMock.Expect(ctx, chatID, []string{messageID}).Return(map[string][]*model.MessageMention{
+					messageID: mentions,
+				}, nil)
+				return mock
+			},
+		},
+		{
+			name:   "other user case",
+			userID: otherID,
+			want:   nil,
+			err:    status.Errorf(codes.PermissionDenied, "user %d cannot list mentions of user %d", userID, otherID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name:      "invalid page token case",
+			userID:    userID,
+			pageToken: "!",
+			want:      nil,
+			err:       status.Error(codes.InvalidArgument, "invalid page token"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			page, err := service.ListMentions(ctx, test.userID, 0, test.pageToken)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, page)
+		})
+	}
+
+}
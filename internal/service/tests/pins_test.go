@@ -0,0 +1,166 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPinMessage(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID    = gofakeit.Int64()
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.UUID()
+		seq       = gofakeit.Int64()
+		maxPins   = 2
+		deletedAt = time.Now()
+
+		ctx = auth.WithUserID(context.Background(), userID)
+
+		message = &model.Message{ID: messageID, Info: model.MessageInfo{ChatID: chatID}}
+
+		event = &model.MessageCreate{
+			Info: model.MessageInfo{
+				ChatID: chatID,
+				UserID: userID,
+			},
+			SystemEvent: model.SystemEventMessagePinned,
+			SubjectID:   messageID,
+		}
+	)
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			ctx:  ctx,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.LockChatMock.Expect(ctx, chatID).Return(nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleAdmin, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, messageID).Return(message, nil)
+				mock.PinMessageMock.Expect(ctx, chatID, messageID, userID).Return(true, nil)
+				mock.CountPinsMock.Expect(ctx, chatID).Return(maxPins, nil)
+				mock.NextMessageSeqMock.Expect(ctx, chatID).Return(seq, nil)
+				mock.SendMessageMock.Expect(ctx, event, seq).Return(&model.Message{ID: gofakeit.UUID()}, nil)
+				mock.MarkReadMock.Expect(ctx, chatID, userID, seq).Return(&model.ReadMark{LastReadSeq: seq}, nil)
+				mock.UpdateLastActivityMock.Expect(ctx, chatID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "already pinned case",
+			ctx:  ctx,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.LockChatMock.Expect(ctx, chatID).Return(nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleAdmin, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, messageID).Return(message, nil)
+				mock.PinMessageMock.Expect(ctx, chatID, messageID, userID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "too many pins case",
+			ctx:  ctx,
+			err:  status.Errorf(codes.FailedPrecondition, "chat %d can't have more than %d pinned messages", chatID, maxPins),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.LockChatMock.Expect(ctx, chatID).Return(nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleAdmin, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, messageID).Return(message, nil)
+				mock.PinMessageMock.Expect(ctx, chatID, messageID, userID).Return(true, nil)
+				mock.CountPinsMock.Expect(ctx, chatID).Return(maxPins+1, nil)
+				return mock
+			},
+		},
+		{
+			name: "deleted message case",
+			ctx:  ctx,
+			err:  status.Error(codes.FailedPrecondition, "deleted message can't be pinned"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.LockChatMock.Expect(ctx, chatID).Return(nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleAdmin, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, messageID).Return(&model.Message{ID: messageID, DeletedAt: &deletedAt}, nil)
+				return mock
+			},
+		},
+		{
+			name: "system message case",
+			ctx:  ctx,
+			err:  status.Error(codes.FailedPrecondition, "system message can't be pinned"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.LockChatMock.Expect(ctx, chatID).Return(nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleAdmin, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, messageID).Return(&model.Message{ID: messageID, SystemEvent: model.SystemEventUserJoined}, nil)
+				return mock
+			},
+		},
+		{
+			name: "not a member case",
+			ctx:  ctx,
+			err:  status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.LockChatMock.Expect(ctx, chatID).Return(nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return("", repository.ErrMemberNotFound)
+				return mock
+			},
+		},
+		{
+			name: "plain member case",
+			ctx:  ctx,
+			err:  status.Errorf(codes.PermissionDenied, "user %d may not pin messages in chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.LockChatMock.Expect(ctx, chatID).Return(nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleMember, nil)
+				return mock
+			},
+		},
+		{
+			name: "unauthenticated case",
+			ctx:  context.Background(),
+			err:  status.Error(codes.Unauthenticated, "caller identity is unknown"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{maxPins: maxPins})
+
+			err := service.PinMessage(test.ctx, chatID, messageID)
+			require.Equal(t, test.err, err)
+		})
+	}
+
+}
@@ -0,0 +1,417 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreatePoll(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID    = gofakeit.Int64()
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.UUID()
+		seq       = gofakeit.Int64()
+		question  = gofakeit.Question()
+		options   = []string{"yes", "no"}
+		past      = time.Now().Add(-time.Minute)
+
+		ctx = auth.WithUserID(context.Background(), userID)
+
+		info = model.MessageInfo{
+			ChatID: chatID,
+			UserID: userID,
+			Text:   question,
+		}
+
+		newReq = func() *model.PollCreate {
+			return &model.PollCreate{
+				ChatID:   chatID,
+				Question: question,
+				Options:  options,
+			}
+		}
+	)
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		req                *model.PollCreate
+		want               *model.Message
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			ctx:  ctx,
+			req:  newReq(),
+			want: &model.Message{
+				ID:   messageID,
+				Seq:  seq,
+				Info: info,
+				Poll: &model.Poll{
+					MessageID: messageID,
+					ChatID:    chatID,
+					Question:  question,
+					Options: []*model.PollOption{
+						{Index: 0, Text: "yes"},
+						{Index: 1, Text: "no"},
+					},
+				},
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.NextMessageSeqMock.Expect(ctx, chatID).Return(seq, nil)
+				mock.SendMessageMock.Expect(ctx, &model.MessageCreate{Info: info}, seq).Return(&model.Message{ID: messageID, Seq: seq, Info: info}, nil)
+				mock.MarkReadMock.Expect(ctx, chatID, userID, seq).Return(&model.ReadMark{LastReadSeq: seq}, nil)
+				mock.UpdateLastActivityMock.Expect(ctx, chatID).Return(nil)
+				mock.CreatePollMock.Expect(ctx, messageID, newReq()).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "unauthenticated case",
+			ctx:  context.Background(),
+			req:  newReq(),
+			want: nil,
+			err:  status.Error(codes.Unauthenticated, "caller identity is unknown"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "repeated option case",
+			ctx:  ctx,
+			req: &model.PollCreate{
+				ChatID:   chatID,
+				Question: question,
+				Options:  []string{"yes", "yes"},
+			},
+			want: nil,
+			err:  status.Errorf(codes.InvalidArgument, "poll option %q is repeated", "yes"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "closing time in the past case",
+			ctx:  ctx,
+			req: &model.PollCreate{
+				ChatID:   chatID,
+				Question: question,
+				Options:  options,
+				ClosesAt: &past,
+			},
+			want: nil,
+			err:  status.Error(codes.InvalidArgument, "poll closing time must be in the future"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "not a member case",
+			ctx:  ctx,
+			req:  newReq(),
+			want: nil,
+			err:  status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(false, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			res, err := service.CreatePoll(test.ctx, test.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
+
+func TestVote(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID    = gofakeit.Int64()
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.UUID()
+		question  = gofakeit.Question()
+		closedAt  = time.Now().Add(-time.Minute)
+
+		ctx = auth.WithUserID(context.Background(), userID)
+
+		message = &model.Message{
+			ID:   messageID,
+			Info: model.MessageInfo{ChatID: chatID, Text: question},
+		}
+
+		newPoll = func() *model.Poll {
+			return &model.Poll{
+				MessageID: messageID,
+				ChatID:    chatID,
+				Options: []*model.PollOption{
+					{Index: 0, Text: "yes"},
+					{Index: 1, Text: "no"},
+				},
+			}
+		}
+
+		tallied = &model.Poll{
+			MessageID: messageID,
+			ChatID:    chatID,
+			Question:  question,
+			Options: []*model.PollOption{
+				{Index: 0, Text: "yes"},
+				{Index: 1, Text: "no", Votes: 1, VoterIDs: []int64{userID}},
+			},
+			TotalVoters: 1,
+		}
+
+		newReq = func(options ...int32) *model.PollVote {
+			return &model.PollVote{
+				ChatID:    chatID,
+				MessageID: messageID,
+				Options:   options,
+			}
+		}
+	)
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		req                *model.PollVote
+		want               *model.Poll
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			ctx:  ctx,
+			req:  newReq(1),
+			want: tallied,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				vote := newReq(1)
+				vote.UserID = userID
+
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, messageID).Return(&model.Message{ID: messageID, Info: message.Info}, nil)
+				mock.GetPollForUpdateMock.Expect(ctx, chatID, messageID).Return(newPoll(), nil)
+				mock.ReplacePollVotesMock.Expect(ctx, vote).Return(nil)
+				mock.CountReactionsMock.Expect(ctx, chatID, []string{messageID}).Return(nil, nil)
+				mock.GetMentionsMock.Expect(ctx, chatID, []string{messageID}).Return(nil, nil)
+				mock.GetAttachmentsMock.Expect(ctx, chatID, []string{messageID}).Return(nil, nil)
+				mock.GetPollsMock.Expect(ctx, chatID, []string{messageID}).Return(map[string]*model.Poll{messageID: {
+					MessageID: messageID,
+					ChatID:    chatID,
+					Options: []*model.PollOption{
+						{Index: 0, Text: "yes"},
+						{Index: 1, Text: "no", Votes: 1, VoterIDs: []int64{userID}},
+					},
+					TotalVoters: 1,
+				}}, nil)
+				mock.ListHiddenForMock.Expect(ctx, chatID, messageID).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "unauthenticated case",
+			ctx:  context.Background(),
+			req:  newReq(1),
+			want: nil,
+			err:  status.Error(codes.Unauthenticated, "caller identity is unknown"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "not a member case",
+			ctx:  ctx,
+			req:  newReq(1),
+			want: nil,
+			err:  status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "not a poll case",
+			ctx:  ctx,
+			req:  newReq(1),
+			want: nil,
+			err:  status.Errorf(codes.NotFound, "poll %s not found in chat %d", messageID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, messageID).Return(&model.Message{ID: messageID, Info: message.Info}, nil)
+				mock.GetPollForUpdateMock.Expect(ctx, chatID, messageID).Return(nil, repository.ErrPollNotFound)
+				return mock
+			},
+		},
+		{
+			name: "closed poll case",
+			ctx:  ctx,
+			req:  newReq(1),
+			want: nil,
+			err:  status.Errorf(codes.FailedPrecondition, "poll %s is closed", messageID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				poll := newPoll()
+				poll.ClosedAt = &closedAt
+
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, messageID).Return(&model.Message{ID: messageID, Info: message.Info}, nil)
+				mock.GetPollForUpdateMock.Expect(ctx, chatID, messageID).Return(poll, nil)
+				return mock
+			},
+		},
+		{
+			name: "several options in single choice poll case",
+			ctx:  ctx,
+			req:  newReq(0, 1),
+			want: nil,
+			err:  status.Error(codes.InvalidArgument, "options must exist in the poll, be distinct and more than one only for multiple choice polls"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, messageID).Return(&model.Message{ID: messageID, Info: message.Info}, nil)
+				mock.GetPollForUpdateMock.Expect(ctx, chatID, messageID).Return(newPoll(), nil)
+				return mock
+			},
+		},
+		{
+			name: "unknown option case",
+			ctx:  ctx,
+			req:  newReq(2),
+			want: nil,
+			err:  status.Error(codes.InvalidArgument, "options must exist in the poll, be distinct and more than one only for multiple choice polls"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, messageID).Return(&model.Message{ID: messageID, Info: message.Info}, nil)
+				mock.GetPollForUpdateMock.Expect(ctx, chatID, messageID).Return(newPoll(), nil)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			res, err := service.Vote(test.ctx, test.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+
+}
+
+func TestClosePoll(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID    = gofakeit.Int64()
+		authorID  = gofakeit.Int64()
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.UUID()
+
+		ctx = auth.WithUserID(context.Background(), userID)
+	)
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			ctx:  ctx,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, messageID).Return(&model.Message{ID: messageID, Info: model.MessageInfo{ChatID: chatID, UserID: userID}}, nil)
+				mock.GetPollForUpdateMock.Expect(ctx, chatID, messageID).Return(&model.Poll{MessageID: messageID, ChatID: chatID}, nil)
+				mock.ClosePollMock.Expect(ctx, chatID, messageID).Return(nil)
+				mock.CountReactionsMock.Expect(ctx, chatID, []string{messageID}).Return(nil, nil)
+				mock.GetMentionsMock.Expect(ctx, chatID, []string{messageID}).Return(nil, nil)
+				mock.GetAttachmentsMock.Expect(ctx, chatID, []string{messageID}).Return(nil, nil)
+				mock.GetPollsMock.Expect(ctx, chatID, []string{messageID}).Return(nil, nil)
+				mock.ListHiddenForMock.Expect(ctx, chatID, messageID).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "not the author case",
+			ctx:  ctx,
+			err:  status.Errorf(codes.PermissionDenied, "user %d is not the author of poll %s", userID, messageID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, messageID).Return(&model.Message{ID: messageID, Info: model.MessageInfo{ChatID: chatID, UserID: authorID}}, nil)
+				return mock
+			},
+		},
+		{
+			name: "unauthenticated case",
+			ctx:  context.Background(),
+			err:  status.Error(codes.Unauthenticated, "caller identity is unknown"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			_, err := service.ClosePoll(test.ctx, chatID, messageID)
+			require.Equal(t, test.err, err)
+		})
+	}
+
+}
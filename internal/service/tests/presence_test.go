@@ -0,0 +1,186 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/hub"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/presence"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWatchPresence(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID    = gofakeit.Int64()
+		contactID = userID + 1
+		strangeID = userID + 2
+	)
+
+	ctx, cancel := context.WithCancel(auth.WithUserID(context.Background(), userID))
+	defer cancel()
+
+	chatRepositoryMock := mocks.NewChatRepositoryMock(mc)
+	chatRepositoryMock.ListContactsMock.Expect(ctx, userID).Return([]int64{contactID}, nil)
+
+	presenceTracker := presence.NewMemoryTracker(hub.NewHub[*model.Presence](0), time.Minute, time.Hour, 24*time.Hour)
+	defer presenceTracker.Close()
+
+	service := newTestService(chatRepositoryMock, serviceDeps{presenceTracker: presenceTracker})
+
+	changes, err := service.WatchPresence(ctx)
+	require.NoError(t, err)
+
+	// the stranger does not share a chat with the caller, so only the contact comes through
+	require.NoError(t, presenceTracker.Heartbeat(ctx, strangeID))
+	require.NoError(t, presenceTracker.Heartbeat(ctx, contactID))
+
+	change := <-changes
+	require.Equal(t, contactID, change.UserID)
+	require.Equal(t, model.PresenceOnline, change.Status)
+
+	presences, err := service.GetPresence(ctx, []int64{contactID, userID})
+	require.NoError(t, err)
+	require.Equal(t, model.PresenceOnline, presences[0].Status)
+	require.Equal(t, &model.Presence{UserID: userID, Status: model.PresenceOffline}, presences[1])
+
+	cancel()
+	_, ok := <-changes
+	require.False(t, ok)
+}
+
+func TestGetPresence(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID    = gofakeit.Int64()
+		contactID = userID + 1
+		strangeID = userID + 2
+
+		ctx = auth.WithUserID(context.Background(), userID)
+	)
+
+	presenceTracker := presence.NewMemoryTracker(hub.NewHub[*model.Presence](0), time.Minute, time.Hour, 24*time.Hour)
+	defer presenceTracker.Close()
+
+	require.NoError(t, presenceTracker.Heartbeat(ctx, contactID))
+	require.NoError(t, presenceTracker.Heartbeat(ctx, strangeID))
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		want               []int64
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			// the stranger does not share a chat with the caller, so it is left out
+			name: "success case",
+			ctx:  ctx,
+			want: []int64{contactID, userID},
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.ListContactsMock.Expect(ctx, userID).Return([]int64{contactID}, nil)
+				return mock
+			},
+		},
+		{
+			name: "unauthenticated case",
+			ctx:  context.Background(),
+			want: nil,
+			err:  status.Error(codes.Unauthenticated, "caller identity is unknown"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{presenceTracker: presenceTracker})
+
+			presences, err := service.GetPresence(test.ctx, []int64{contactID, strangeID, userID})
+			require.Equal(t, test.err, err)
+
+			var userIDs []int64
+			for _, presence := range presences {
+				userIDs = append(userIDs, presence.UserID)
+			}
+			require.Equal(t, test.want, userIDs)
+		})
+	}
+}
+
+func TestPresenceRetention(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID    = gofakeit.Int64()
+		watcherID = userID + 1
+	)
+
+	tests := []struct {
+		name        string
+		forgetAfter time.Duration
+		remembered  bool
+	}{
+		{
+			name:        "offline case",
+			forgetAfter: time.Hour,
+			remembered:  true,
+		},
+		{
+			name:        "forgotten case",
+			forgetAfter: 50 * time.Millisecond,
+			remembered:  false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			presenceTracker := presence.NewMemoryTracker(hub.NewHub[*model.Presence](0), 10*time.Millisecond, 20*time.Millisecond, test.forgetAfter)
+			defer presenceTracker.Close()
+
+			require.NoError(t, presenceTracker.Heartbeat(ctx, userID))
+
+			sub := presenceTracker.Subscribe(userID, watcherID)
+			defer sub.Close()
+
+			// the first sweep announces the user offline and forgets stale users
+			select {
+			case change := <-sub.C:
+				require.Equal(t, model.PresenceOffline, change.Status)
+			case <-time.After(5 * time.Second):
+				t.Fatal("the sweep did not run")
+			}
+
+			presences, err := presenceTracker.Get(ctx, []int64{userID})
+			require.NoError(t, err)
+			require.Equal(t, model.PresenceOffline, presences[0].Status)
+			require.Equal(t, test.remembered, !presences[0].LastSeenAt.IsZero())
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAddReaction(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID    = gofakeit.Int64()
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.UUID()
+		emoji     = gofakeit.Emoji()
+		deletedAt = time.Now()
+
+		ctx = auth.WithUserID(context.Background(), userID)
+
+		reaction = &model.Reaction{
+			MessageID: messageID,
+			ChatID:    chatID,
+			UserID:    userID,
+			Emoji:     emoji,
+		}
+
+		newReq = func() *model.Reaction {
+			return &model.Reaction{
+				MessageID: messageID,
+				ChatID:    chatID,
+				Emoji:     emoji,
+			}
+		}
+	)
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			ctx:  ctx,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, messageID).Return(&model.Message{ID: messageID, Info: model.MessageInfo{ChatID: chatID}}, nil)
+				mock.AddReactionMock.Expect(ctx, reaction).Return(nil)
+				mock.CountReactionsMock.Expect(ctx, chatID, []string{messageID}).Return(nil, nil)
+				mock.GetMentionsMock.Expect(ctx, chatID, []string{messageID}).Return(nil, nil)
+				mock.GetAttachmentsMock.Expect(ctx, chatID, []string{messageID}).Return(nil, nil)
+				mock.GetPollsMock.Expect(ctx, chatID, []string{messageID}).Return(nil, nil)
+				mock.ListHiddenForMock.Expect(ctx, chatID, messageID).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "unauthenticated case",
+			ctx:  context.Background(),
+			err:  status.Error(codes.Unauthenticated, "caller identity is unknown"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "not a member case",
+			ctx:  ctx,
+			err:  status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "deleted message case",
+			ctx:  ctx,
+			err:  status.Error(codes.FailedPrecondition, "deleted message can't be reacted to"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, messageID).Return(&model.Message{ID: messageID, DeletedAt: &deletedAt}, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			err := service.AddReaction(test.ctx, newReq())
+			require.Equal(t, test.err, err)
+		})
+	}
+
+}
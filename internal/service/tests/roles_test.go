@@ -0,0 +1,304 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRemoveMembers(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID  = gofakeit.Int64()
+		chatID  = gofakeit.Int64()
+		userIDs = []int64{gofakeit.Int64(), gofakeit.Int64()}
+
+		ctx = auth.WithUserID(context.Background(), userID)
+	)
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			ctx:  ctx,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.LockChatMock.Expect(ctx, chatID).Return(nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleOwner, nil)
+				mock.GetMemberRolesMock.Expect(ctx, chatID, userIDs).Return(map[int64]string{
+					userIDs[0]: model.MemberRoleAdmin,
+					userIDs[1]: model.MemberRoleMember,
+				}, nil)
+				mock.RemoveMembersMock.Expect(ctx, chatID, userIDs).Return(2, nil)
+				return mock
+			},
+		},
+		{
+			name: "admin removing admin case",
+			ctx:  ctx,
+			err:  status.Errorf(codes.PermissionDenied, "user %d may not remove these members from chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.LockChatMock.Expect(ctx, chatID).Return(nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleAdmin, nil)
+				mock.GetMemberRolesMock.Expect(ctx, chatID, userIDs).Return(map[int64]string{
+					userIDs[0]: model.MemberRoleAdmin,
+				}, nil)
+				return mock
+			},
+		},
+		{
+			name: "plain member case",
+			ctx:  ctx,
+			err:  status.Errorf(codes.PermissionDenied, "user %d may not remove these members from chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.LockChatMock.Expect(ctx, chatID).Return(nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleMember, nil)
+				return mock
+			},
+		},
+		{
+			name: "chat not found case",
+			ctx:  ctx,
+			err:  status.Errorf(codes.NotFound, "chat with id %d not found", chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.LockChatMock.Expect(ctx, chatID).Return(repository.ErrChatNotFound)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			err := service.RemoveMembers(test.ctx, chatID, userIDs)
+			require.Equal(t, test.err, err)
+		})
+	}
+
+}
+
+func TestLeaveChat(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID  = gofakeit.Int64()
+		otherID = gofakeit.Int64()
+		chatID  = gofakeit.Int64()
+
+		ctx = auth.WithUserID(context.Background(), userID)
+	)
+
+	tests := []struct {
+		name               string
+		userID             int64
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name:   "success case",
+			userID: userID,
+			err:    nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.LockChatMock.Expect(ctx, chatID).Return(nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleAdmin, nil)
+				mock.RemoveMembersMock.Expect(ctx, chatID, []int64{userID}).Return(1, nil)
+				return mock
+			},
+		},
+		{
+			name:   "owner case",
+			userID: userID,
+			err:    status.Errorf(codes.FailedPrecondition, "owner of chat %d must transfer the ownership before leaving", chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.LockChatMock.Expect(ctx, chatID).Return(nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleOwner, nil)
+				return mock
+			},
+		},
+		{
+			name:   "another user case",
+			userID: otherID,
+			err:    status.Errorf(codes.PermissionDenied, "user %d can't make user %d leave chat %d", userID, otherID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			err := service.LeaveChat(ctx, chatID, test.userID)
+			require.Equal(t, test.err, err)
+		})
+	}
+
+}
+
+func TestTransferOwnership(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID = gofakeit.Int64()
+		heirID = gofakeit.Int64()
+		chatID = gofakeit.Int64()
+
+		ctx = auth.WithUserID(context.Background(), userID)
+	)
+
+	tests := []struct {
+		name               string
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.LockChatMock.Expect(ctx, chatID).Return(nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleOwner, nil)
+				mock.SetMemberRoleMock.When(ctx, chatID, userID, model.MemberRoleAdmin).Then(nil)
+				mock.SetMemberRoleMock.When(ctx, chatID, heirID, model.MemberRoleOwner).Then(nil)
+				return mock
+			},
+		},
+		{
+			name: "not the owner case",
+			err:  status.Errorf(codes.PermissionDenied, "user %d may not transfer the ownership of chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.LockChatMock.Expect(ctx, chatID).Return(nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleAdmin, nil)
+				return mock
+			},
+		},
+		{
+			name: "heir not a member case",
+			err:  status.Errorf(codes.NotFound, "user %d is not a member of chat %d", heirID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.LockChatMock.Expect(ctx, chatID).Return(nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleOwner, nil)
+				mock.SetMemberRoleMock.When(ctx, chatID, userID, model.MemberRoleAdmin).Then(nil)
+				mock.SetMemberRoleMock.When(ctx, chatID, heirID, model.MemberRoleOwner).Then(repository.ErrMemberNotFound)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			err := service.TransferOwnership(ctx, chatID, heirID)
+			require.Equal(t, test.err, err)
+		})
+	}
+
+}
+
+func TestSetMemberRole(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID   = gofakeit.Int64()
+		memberID = gofakeit.Int64()
+		chatID   = gofakeit.Int64()
+
+		ctx = auth.WithUserID(context.Background(), userID)
+	)
+
+	tests := []struct {
+		name               string
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.LockChatMock.Expect(ctx, chatID).Return(nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleOwner, nil)
+				mock.SetMemberRoleMock.Expect(ctx, chatID, memberID, model.MemberRoleAdmin).Return(nil)
+				return mock
+			},
+		},
+		{
+			// the role of the caller is only read under the lock of the chat
+			name: "chat not found case",
+			err:  status.Errorf(codes.NotFound, "chat with id %d not found", chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.LockChatMock.Expect(ctx, chatID).Return(repository.ErrChatNotFound)
+				return mock
+			},
+		},
+		{
+			name: "not the owner case",
+			err:  status.Errorf(codes.PermissionDenied, "user %d may not change member roles in chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.LockChatMock.Expect(ctx, chatID).Return(nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleAdmin, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			err := service.SetMemberRole(ctx, chatID, memberID, model.MemberRoleAdmin)
+			require.Equal(t, test.err, err)
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestScheduleMessage(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	type args struct {
+		ctx    context.Context
+		text   string
+		sendAt time.Time
+	}
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID = gofakeit.Int64()
+		chatID = gofakeit.Int64()
+		text   = gofakeit.Word()
+		sendAt = time.Now().Add(time.Hour)
+
+		ctx = auth.WithUserID(context.Background(), userID)
+
+		create = &model.ScheduledMessage{
+			Info: model.MessageInfo{
+				ChatID: chatID,
+				UserID: userID,
+				Text:   text,
+			},
+			SendAt: sendAt,
+		}
+
+		scheduled = &model.ScheduledMessage{
+			ID:        gofakeit.UUID(),
+			Info:      create.Info,
+			SendAt:    sendAt,
+			CreatedAt: time.Now(),
+		}
+	)
+
+	tests := []struct {
+		name               string
+		args               args
+		want               *model.ScheduledMessage
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx:    ctx,
+				text:   text,
+				sendAt: sendAt,
+			},
+			want: scheduled,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.CreateScheduledMessageMock.Expect(ctx, create).Return(scheduled, nil)
+				return mock
+			},
+		},
+		{
+			name: "send time in the past case",
+			args: args{
+				ctx:    ctx,
+				text:   text,
+				sendAt: time.Now().Add(-time.Minute),
+			},
+			want: nil,
+			err:  status.Error(codes.InvalidArgument, "send time must be in the future"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "empty text case",
+			args: args{
+				ctx:    ctx,
+				sendAt: sendAt,
+			},
+			want: nil,
+			err:  status.Error(codes.InvalidArgument, "scheduled message must have text"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "not a member case",
+			args: args{
+				ctx:    ctx,
+				text:   text,
+				sendAt: sendAt,
+			},
+			want: nil,
+			err:  status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "unauthenticated case",
+			args: args{
+				ctx:    context.Background(),
+				text:   text,
+				sendAt: sendAt,
+			},
+			want: nil,
+			err:  status.Error(codes.Unauthenticated, "caller identity is unknown"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			res, err := service.ScheduleMessage(test.args.ctx, chatID, test.args.text, test.args.sendAt)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, res)
+		})
+	}
+}
+
+func TestCancelScheduledMessage(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID      = gofakeit.Int64()
+		chatID      = gofakeit.Int64()
+		scheduledID = gofakeit.UUID()
+
+		ctx = auth.WithUserID(context.Background(), userID)
+	)
+
+	tests := []struct {
+		name               string
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.DeleteScheduledMessageMock.Expect(ctx, chatID, scheduledID, userID).Return(true, nil)
+				return mock
+			},
+		},
+		{
+			name: "not found case",
+			err:  status.Errorf(codes.NotFound, "scheduled message %s not found in chat %d", scheduledID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.DeleteScheduledMessageMock.Expect(ctx, chatID, scheduledID, userID).Return(false, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			err := service.CancelScheduledMessage(ctx, chatID, scheduledID)
+			require.Equal(t, test.err, err)
+		})
+	}
+}
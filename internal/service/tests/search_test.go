@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSearchMessages(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID  = gofakeit.Int64()
+		otherID = gofakeit.Int64()
+		chatID  = gofakeit.Int64()
+		text    = gofakeit.Word()
+
+		ctx = auth.WithUserID(context.Background(), userID)
+
+		firstID  = gofakeit.UUID()
+		secondID = gofakeit.UUID()
+
+		// the markup written by the sender comes back escaped
+		rawSnippet = "<b>" + model.SnippetMatchStart + text + model.SnippetMatchStop + "</b>"
+		snippet    = "&lt;b&gt;<mark>" + text + "</mark>&lt;/b&gt;"
+
+		results = []*model.SearchResult{
+			{Message: &model.Message{ID: firstID}, Snippet: snippet, Rank: 0.5},
+			{Message: &model.Message{ID: secondID}, Snippet: snippet, Rank: 0.25},
+		}
+	)
+
+	// the service rewrites the snippets in place, so every case gets its own
+	found := func() []*model.SearchResult {
+		return []*model.SearchResult{
+			{Message: &model.Message{ID: firstID}, Snippet: rawSnippet, Rank: 0.5},
+			{Message: &model.Message{ID: secondID}, Snippet: rawSnippet, Rank: 0.25},
+		}
+	}
+
+	tests := []struct {
+		name               string
+		query              *model.SearchQuery
+		want               *model.SearchPage
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name:  "success case",
+			query: &model.SearchQuery{UserID: userID, Query: text, PageSize: 1},
+			want: &model.SearchPage{
+				Results:       results[:1],
+				NextPageToken: "MQ",
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.SearchMessagesMock.Expect(ctx, &model.SearchQuery{UserID: userID, Query: text, PageSize: 1}, 0, 2).Return(found(), nil)
+				return mock
+			},
+		},
+		{
+			name:  "next page case",
+			query: &model.SearchQuery{UserID: userID, Query: text, ChatID: chatID, PageSize: 1, PageToken: "MQ"},
+			want: &model.SearchPage{
+				Results: results[1:],
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.SearchMessagesMock.Expect(ctx, &model.SearchQuery{UserID: userID, Query: text, ChatID: chatID, PageSize: 1, PageToken: "MQ"}, 1, 2).Return(found()[1:], nil)
+				return mock
+			},
+		},
+		{
+			name:  "not a member case",
+			query: &model.SearchQuery{UserID: userID, Query: text, ChatID: chatID},
+			want:  nil,
+			err:   status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name:  "other user case",
+			query: &model.SearchQuery{UserID: otherID, Query: text},
+			want:  nil,
+			err:   status.Errorf(codes.PermissionDenied, "user %d cannot search messages of user %d", userID, otherID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name:  "empty query case",
+			query: &model.SearchQuery{UserID: userID, Query: "  "},
+			want:  nil,
+			err:   status.Error(codes.InvalidArgument, "search query is empty"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			page, err := service.SearchMessages(ctx, test.query)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, page)
+		})
+	}
+
+}
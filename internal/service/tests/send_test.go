@@ -0,0 +1,354 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/client/directory"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSendMessage(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	type args struct {
+		ctx context.Context
+		req *model.MessageCreate
+	}
+
+	var (
+		mc = minimock.NewController(t)
+
+		id     = gofakeit.UUID()
+		seq    = gofakeit.Int64()
+		userID = gofakeit.Int64()
+		chatID = gofakeit.Int64()
+		text   = gofakeit.Phrase()
+
+		ctx = auth.WithUserID(context.Background(), userID)
+
+		repoErr = fmt.Errorf("repo error")
+
+		sent = &model.MessageCreate{
+			Info: model.MessageInfo{
+				ChatID: chatID,
+				UserID: userID,
+				Text:   text,
+			},
+		}
+
+		message = &model.Message{
+			ID:   id,
+			Seq:  seq,
+			Info: sent.Info,
+		}
+
+		rootID  = gofakeit.UUID()
+		replyID = gofakeit.UUID()
+
+		reply = &model.MessageCreate{
+			Info:      sent.Info,
+			ReplyToID: rootID,
+		}
+
+		replyMessage = &model.Message{
+			ID:        id,
+			Seq:       seq,
+			Info:      sent.Info,
+			ReplyToID: rootID,
+		}
+
+		newReply = func(replyToID string) *model.MessageCreate {
+			return &model.MessageCreate{
+				Info: model.MessageInfo{
+					ChatID: chatID,
+					Text:   text,
+				},
+				ReplyToID: replyToID,
+			}
+		}
+
+		bobID       = gofakeit.Int64()
+		ghostID     = gofakeit.Int64()
+		mentionText = "ping @bob and @all, not a@b.c or @ghost."
+
+		// ghost is a known user outside the chat
+		userDirectory = directory.NewStaticDirectory(map[string]int64{
+			"bob":   bobID,
+			"ghost": ghostID,
+		})
+
+		mentions = []*model.MessageMention{
+			{UserID: bobID, Offset: 5, Length: 4},
+			{All: true, Offset: 14, Length: 4},
+		}
+
+		mentioning = &model.MessageCreate{
+			Info: model.MessageInfo{
+				ChatID: chatID,
+				UserID: userID,
+				Text:   mentionText,
+			},
+			Mentions: mentions,
+		}
+
+		attachmentIDs = []string{gofakeit.UUID(), gofakeit.UUID()}
+
+		withAttachments = &model.MessageCreate{
+			Info: model.MessageInfo{
+				ChatID: chatID,
+				UserID: userID,
+			},
+			AttachmentIDs: attachmentIDs,
+		}
+
+		attachments = []*model.Attachment{
+			{ID: attachmentIDs[0], ChatID: chatID, MessageID: id},
+			{ID: attachmentIDs[1], ChatID: chatID, MessageID: id},
+		}
+
+		newAttachmentsReq = func() *model.MessageCreate {
+			return &model.MessageCreate{
+				Info: model.MessageInfo{
+					ChatID: chatID,
+				},
+				AttachmentIDs: attachmentIDs,
+			}
+		}
+
+		newReq = func() *model.MessageCreate {
+			return &model.MessageCreate{
+				Info: model.MessageInfo{
+					ChatID: chatID,
+					Text:   text,
+				},
+			}
+		}
+	)
+
+	tests := []struct {
+		name               string
+		args               args
+		want               *model.Message
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: newReq(),
+			},
+			want: message,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.NextMessageSeqMock.Expect(ctx, chatID).Return(seq, nil)
+				mock.SendMessageMock.Expect(ctx, sent, seq).Return(message, nil)
+				mock.MarkReadMock.Expect(ctx, chatID, userID, seq).Return(&model.ReadMark{LastReadSeq: seq}, nil)
+				mock.UpdateLastActivityMock.Expect(ctx, chatID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "mentions case",
+			args: args{
+				ctx: ctx,
+				req: &model.MessageCreate{
+					Info: model.MessageInfo{
+						ChatID: chatID,
+						Text:   mentionText,
+					},
+				},
+			},
+			want: &model.Message{
+				ID:       id,
+				Seq:      seq,
+				Info:     mentioning.Info,
+				Mentions: mentions,
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMemberRolesMock.Expect(ctx, chatID, []int64{bobID, ghostID}).Return(map[int64]string{bobID: model.MemberRoleMember}, nil)
+				mock.NextMessageSeqMock.Expect(ctx, chatID).Return(seq, nil)
+				mock.SendMessageMock.Expect(ctx, mentioning, seq).Return(&model.Message{ID: id, Seq: seq, Info: mentioning.Info}, nil)
+				mock.AddMentionsMock.Expect(ctx, chatID, id, mentions).Return(nil)
+				mock.MarkReadMock.Expect(ctx, chatID, userID, seq).Return(&model.ReadMark{LastReadSeq: seq}, nil)
+				mock.UpdateLastActivityMock.Expect(ctx, chatID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "attachments case",
+			args: args{
+				ctx: ctx,
+				req: newAttachmentsReq(),
+			},
+			want: &model.Message{
+				ID:          id,
+				Seq:         seq,
+				Info:        withAttachments.Info,
+				Attachments: attachments,
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.NextMessageSeqMock.Expect(ctx, chatID).Return(seq, nil)
+				mock.SendMessageMock.Expect(ctx, withAttachments, seq).Return(&model.Message{ID: id, Seq: seq, Info: withAttachments.Info}, nil)
+				mock.MarkReadMock.Expect(ctx, chatID, userID, seq).Return(&model.ReadMark{LastReadSeq: seq}, nil)
+				mock.UpdateLastActivityMock.Expect(ctx, chatID).Return(nil)
+				mock.LinkAttachmentsMock.Expect(ctx, chatID, id, userID, attachmentIDs).Return(attachments, nil)
+				return mock
+			},
+		},
+		{
+			name: "unavailable attachments case",
+			args: args{
+				ctx: ctx,
+				req: newAttachmentsReq(),
+			},
+			want: nil,
+			err:  status.Errorf(codes.InvalidArgument, "attachments must be uploaded to chat %d by the sender and not sent yet", chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.NextMessageSeqMock.Expect(ctx, chatID).Return(seq, nil)
+				mock.SendMessageMock.Expect(ctx, withAttachments, seq).Return(&model.Message{ID: id, Seq: seq, Info: withAttachments.Info}, nil)
+				mock.MarkReadMock.Expect(ctx, chatID, userID, seq).Return(&model.ReadMark{LastReadSeq: seq}, nil)
+				mock.UpdateLastActivityMock.Expect(ctx, chatID).Return(nil)
+				mock.LinkAttachmentsMock.Expect(ctx, chatID, id, userID, attachmentIDs).Return(attachments[:1], nil)
+				return mock
+			},
+		},
+		{
+			name: "empty message case",
+			args: args{
+				ctx: ctx,
+				req: &model.MessageCreate{Info: model.MessageInfo{ChatID: chatID}},
+			},
+			want: nil,
+			err:  status.Error(codes.InvalidArgument, "message must have text or attachments"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "reply to a reply case",
+			args: args{
+				ctx: ctx,
+				req: newReply(replyID),
+			},
+			want: replyMessage,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, replyID).Return(&model.Message{ID: replyID, ReplyToID: rootID}, nil)
+				mock.NextMessageSeqMock.Expect(ctx, chatID).Return(seq, nil)
+				mock.SendMessageMock.Expect(ctx, reply, seq).Return(replyMessage, nil)
+				mock.MarkReadMock.Expect(ctx, chatID, userID, seq).Return(&model.ReadMark{LastReadSeq: seq}, nil)
+				mock.UpdateLastActivityMock.Expect(ctx, chatID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "reply target not found case",
+			args: args{
+				ctx: ctx,
+				req: newReply(rootID),
+			},
+			want: nil,
+			err:  status.Errorf(codes.InvalidArgument, "message %s to reply to not found in chat %d", rootID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, rootID).Return(nil, repository.ErrMessageNotFound)
+				return mock
+			},
+		},
+		{
+			name: "unauthenticated case",
+			args: args{
+				ctx: context.Background(),
+				req: newReq(),
+			},
+			want: nil,
+			err:  status.Error(codes.Unauthenticated, "caller identity is unknown"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "not a member case",
+			args: args{
+				ctx: ctx,
+				req: newReq(),
+			},
+			want: nil,
+			err:  status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "chat not found case",
+			args: args{
+				ctx: ctx,
+				req: newReq(),
+			},
+			want: nil,
+			err:  status.Errorf(codes.NotFound, "chat with id %d not found", chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(false, repository.ErrChatNotFound)
+				return mock
+			},
+		},
+		{
+			name: "repository error case",
+			args: args{
+				ctx: ctx,
+				req: newReq(),
+			},
+			want: nil,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.NextMessageSeqMock.Expect(ctx, chatID).Return(seq, nil)
+				mock.SendMessageMock.Expect(ctx, sent, seq).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{userDirectory: userDirectory})
+
+			message, err := service.SendMessage(test.args.ctx, test.args.req)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, message)
+		})
+	}
+
+}
@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"context"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/client"
+	"github.com/BelyaevEI/microservices_chat/internal/client/directory"
+	"github.com/BelyaevEI/microservices_chat/internal/hub"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/presence"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/service"
+	chatService "github.com/BelyaevEI/microservices_chat/internal/service/chat"
+	"github.com/BelyaevEI/microservices_chat/internal/storage"
+	"github.com/BelyaevEI/microservices_chat/internal/typing"
+	"github.com/BelyaevEI/platform_common/pkg/db"
+)
+
+// txManagerStub runs the handler without a real transaction
+type txManagerStub struct{}
+
+func (txManagerStub) ReadCommitted(ctx context.Context, f db.Handler) error {
+	return f(ctx)
+}
+
+// serviceDeps holds the dependencies a test cares about, the ones left unset
+// are filled in by newTestService
+type serviceDeps struct {
+	hub               hub.Hub[*model.Message]
+	userDirectory     client.UserDirectory
+	typingTracker     typing.Tracker
+	presenceTracker   presence.Tracker
+	maxPins           int
+	blobStore         storage.BlobStore
+	maxAttachmentSize int64
+}
+
+// newTestService creates the chat service over the repository mock without
+// real transactions
+func newTestService(chatRepository repository.ChatRepository, deps serviceDeps) service.ChatService {
+	if deps.hub == nil {
+		deps.hub = hub.NewHub[*model.Message](0)
+	}
+	if deps.userDirectory == nil {
+		deps.userDirectory = directory.NewStaticDirectory(nil)
+	}
+	if deps.typingTracker == nil {
+		deps.typingTracker = typing.NewTracker(hub.NewHub[*model.TypingEvent](0), time.Minute, 1, 1)
+	}
+
+	return chatService.NewService(
+		chatRepository,
+		txManagerStub{},
+		deps.hub,
+		deps.userDirectory,
+		deps.typingTracker,
+		deps.presenceTracker,
+		deps.maxPins,
+		deps.blobStore,
+		deps.maxAttachmentSize,
+	)
+}
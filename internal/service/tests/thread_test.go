@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListThread(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID  = gofakeit.Int64()
+		chatID  = gofakeit.Int64()
+		rootID  = gofakeit.UUID()
+		replyID = gofakeit.UUID()
+
+		ctx = auth.WithUserID(context.Background(), userID)
+
+		root  = &model.Message{ID: rootID, Seq: 1, ReplyCount: 1}
+		reply = &model.Message{ID: replyID, Seq: 2, ReplyToID: rootID}
+	)
+
+	tests := []struct {
+		name               string
+		query              *model.ThreadQuery
+		want               *model.ThreadPage
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name:  "success case",
+			query: &model.ThreadQuery{ChatID: chatID, RootID: rootID, Limit: 1},
+			want:  &model.ThreadPage{Root: root, Replies: []*model.Message{reply}, NextCursor: "Mg"},
+			err:   nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				ids := []string{rootID, replyID}
+
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, rootID).Return(root, nil)
+				mock.ListThreadMock.Expect(ctx, chatID, rootID, userID, nil, 2).Return([]*model.Message{reply}, nil)
+				mock.CountReactionsMock.Expect(ctx, chatID, ids).Return(nil, nil)
+				mock.GetMentionsMock.Expect(ctx, chatID, ids).Return(nil, nil)
+				mock.GetAttachmentsMock.Expect(ctx, chatID, ids).Return(nil, nil)
+				mock.GetPollsMock.Expect(ctx, chatID, ids).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name:  "reply as root case",
+			query: &model.ThreadQuery{ChatID: chatID, RootID: replyID},
+			want:  nil,
+			err:   status.Errorf(codes.InvalidArgument, "message %s is a reply, not the root of a thread", replyID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, replyID).Return(reply, nil)
+				return mock
+			},
+		},
+		{
+			name:  "root not found case",
+			query: &model.ThreadQuery{ChatID: chatID, RootID: rootID},
+			want:  nil,
+			err:   status.Errorf(codes.NotFound, "message %s not found in chat %d", rootID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetMessageMock.Expect(ctx, chatID, rootID).Return(nil, repository.ErrMessageNotFound)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			page, err := service.ListThread(ctx, test.query)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, page)
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/hub"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/BelyaevEI/microservices_chat/internal/storage"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSetMessageTTL(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID = gofakeit.Int64()
+		chatID = gofakeit.Int64()
+		ttl    = time.Hour
+
+		ctx = auth.WithUserID(context.Background(), userID)
+	)
+
+	tests := []struct {
+		name               string
+		ttl                time.Duration
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			ttl:  ttl,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleAdmin, nil)
+				mock.SetMessageTTLMock.Expect(ctx, chatID, ttl).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "disable case",
+			ttl:  0,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleAdmin, nil)
+				mock.SetMessageTTLMock.Expect(ctx, chatID, time.Duration(0)).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "negative ttl case",
+			ttl:  -time.Second,
+			err:  status.Error(codes.InvalidArgument, "message time-to-live can't be negative"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "not a member case",
+			ttl:  ttl,
+			err:  status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return("", repository.ErrMemberNotFound)
+				return mock
+			},
+		},
+		{
+			name: "plain member case",
+			ttl:  ttl,
+			err:  status.Errorf(codes.PermissionDenied, "user %d may not change the settings of chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.MemberRoleMember, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{})
+
+			err := service.SetMessageTTL(ctx, chatID, test.ttl)
+			require.Equal(t, test.err, err)
+		})
+	}
+}
+
+func TestPurgeExpiredMessages(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc  = minimock.NewController(t)
+		ctx = context.Background()
+
+		chatID    = gofakeit.Int64()
+		rootID    = gofakeit.UUID()
+		expiresAt = time.Now()
+		limit     = uint64(10)
+		blobKey   = "expired"
+
+		repoErr = fmt.Errorf("repo error")
+	)
+
+	dir := t.TempDir()
+	store, err := storage.NewLocalStore(dir)
+	require.NoError(t, err)
+
+	_, err = store.Put(ctx, blobKey, strings.NewReader(gofakeit.Phrase()))
+	require.NoError(t, err)
+
+	newPurged := func() []*model.Message {
+		return []*model.Message{
+			{ID: gofakeit.UUID(), Info: model.MessageInfo{ChatID: chatID, Text: gofakeit.Word()}, ReplyToID: rootID, ExpiresAt: &expiresAt},
+			{ID: gofakeit.UUID(), Info: model.MessageInfo{ChatID: chatID, Text: gofakeit.Word()}, ReplyToID: rootID, ExpiresAt: &expiresAt},
+			{ID: gofakeit.UUID(), Info: model.MessageInfo{ChatID: chatID, Text: gofakeit.Word()}, ExpiresAt: &expiresAt},
+		}
+	}
+
+	tests := []struct {
+		name               string
+		want               int
+		err                error
+		removedBlobs       []string
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name:         "success case",
+			want:         3,
+			err:          nil,
+			removedBlobs: []string{blobKey},
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.DeleteExpiredMessagesMock.Expect(ctx, limit).Return(newPurged(), []string{blobKey}, nil)
+				mock.DecrementReplyCountMock.Expect(ctx, chatID, rootID, 2).Return(nil)
+				mock.GetUsedStorageKeysMock.Expect(ctx, []string{blobKey}).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "nothing expired case",
+			want: 0,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.DeleteExpiredMessagesMock.Expect(ctx, limit).Return(nil, nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "repo error case",
+			want: 0,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.DeleteExpiredMessagesMock.Expect(ctx, limit).Return(nil, nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatHub := hub.NewHub[*model.Message](test.want)
+			sub := chatHub.Subscribe(chatID, gofakeit.Int64())
+			defer sub.Close()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			service := newTestService(chatRepositoryMock, serviceDeps{hub: chatHub, blobStore: store})
+
+			purged, err := service.PurgeExpiredMessages(ctx, limit)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.want, purged)
+
+			for _, key := range test.removedBlobs {
+				_, errOpen := store.Open(ctx, key)
+				require.ErrorIs(t, errOpen, storage.ErrBlobNotFound)
+			}
+
+			// every purged message is announced as deleted
+			for i := 0; i < test.want; i++ {
+				event := <-sub.C
+				require.Empty(t, event.Info.Text)
+				require.Equal(t, &expiresAt, event.DeletedAt)
+			}
+		})
+	}
+}
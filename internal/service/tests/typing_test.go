@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/auth"
+	"github.com/BelyaevEI/microservices_chat/internal/hub"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+	"github.com/BelyaevEI/microservices_chat/internal/repository"
+	"github.com/BelyaevEI/microservices_chat/internal/repository/mocks"
+	"github.com/BelyaevEI/microservices_chat/internal/typing"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSetTyping(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	type args struct {
+		ctx    context.Context
+		userID int64
+		times  int
+	}
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID = gofakeit.Int64()
+		chatID = gofakeit.Int64()
+
+		ctx = auth.WithUserID(context.Background(), userID)
+	)
+
+	tests := []struct {
+		name               string
+		args               args
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx:    ctx,
+				userID: userID,
+				times:  1,
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				return mock
+			},
+		},
+		{
+			name: "rate limited case",
+			args: args{
+				ctx:    ctx,
+				userID: userID,
+				times:  3,
+			},
+			err: status.Error(codes.ResourceExhausted, "typing updates are sent too often"),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				return mock
+			},
+		},
+		{
+			name: "other user case",
+			args: args{
+				ctx:    ctx,
+				userID: userID + 1,
+				times:  1,
+			},
+			err: status.Errorf(codes.PermissionDenied, "user %d cannot set typing state of user %d", userID, userID+1),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "not a member case",
+			args: args{
+				ctx:    ctx,
+				userID: userID,
+				times:  1,
+			},
+			err: status.Errorf(codes.PermissionDenied, "user %d is not a member of chat %d", userID, chatID),
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.IsMemberMock.Expect(ctx, chatID, userID).Return(false, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepositoryMock := test.chatRepositoryMock(mc)
+			// two updates at once, then one every ten seconds
+			typingTracker := typing.NewTracker(hub.NewHub[*model.TypingEvent](0), time.Minute, 0.1, 2)
+			service := newTestService(chatRepositoryMock, serviceDeps{typingTracker: typingTracker})
+
+			var err error
+			for i := 0; i < test.args.times; i++ {
+				err = service.SetTyping(test.args.ctx, chatID, test.args.userID, true)
+			}
+			require.Equal(t, test.err, err)
+		})
+	}
+
+}
@@ -0,0 +1,22 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrBlobNotFound is returned when there is no blob stored under the key
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore keeps the contents of attachments.
+// Implementations may keep the blobs on a local disk or in an object storage such as S3.
+type BlobStore interface {
+	// Put stores the content under the key and returns the number of bytes written.
+	// A failed Put leaves nothing behind.
+	Put(ctx context.Context, key string, content io.Reader) (int64, error)
+	// Open returns a reader of the blob, the caller must close it
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the blob, deleting a missing blob is not an error
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,179 @@
+package typing
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/BelyaevEI/microservices_chat/internal/hub"
+	"github.com/BelyaevEI/microservices_chat/internal/model"
+)
+
+// bucketIdleTimeout is how often idle rate limit buckets are dropped
+const bucketIdleTimeout = time.Minute
+
+// ErrRateLimited is returned when a user changes their typing state too often.
+var ErrRateLimited = errors.New("typing updates are rate limited")
+
+// Tracker keeps typing indicators in memory only. An indicator that is not
+// refreshed within the expiry is cleared and the stop is announced on its own.
+type Tracker interface {
+	Set(chatID, userID int64, isTyping bool) error
+	Subscribe(chatID, userID int64) *hub.Subscription[*model.TypingEvent]
+	// Kick closes the subscriptions of a user who left the chat
+	Kick(chatID, userID int64)
+}
+
+type key struct {
+	chatID int64
+	userID int64
+}
+
+type indicator struct {
+	timer     *time.Timer
+	expiresAt time.Time
+}
+
+// bucket is a token bucket limiting how often a single user may send updates
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+type tracker struct {
+	hub    hub.Hub[*model.TypingEvent]
+	expiry time.Duration
+	rate   float64
+	burst  float64
+
+	mu         sync.Mutex
+	indicators map[key]*indicator
+	buckets    map[int64]*bucket
+	sweptAt    time.Time
+}
+
+// NewTracker creates a typing tracker publishing to the given hub.
+// Every user may send burst updates at once and rate updates per second after that.
+func NewTracker(h hub.Hub[*model.TypingEvent], expiry time.Duration, rate float64, burst int) Tracker {
+	return &tracker{
+		hub:        h,
+		expiry:     expiry,
+		rate:       rate,
+		burst:      float64(burst),
+		indicators: make(map[key]*indicator),
+		buckets:    make(map[int64]*bucket),
+		sweptAt:    time.Now(),
+	}
+}
+
+// Set starts, refreshes or stops the typing indicator of the user and tells
+// the other subscribers of the chat about it.
+func (t *tracker) Set(chatID, userID int64, isTyping bool) error {
+	now := time.Now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.allow(userID, now) {
+		return ErrRateLimited
+	}
+
+	k := key{chatID: chatID, userID: userID}
+	ind, active := t.indicators[k]
+
+	if !isTyping {
+		if !active {
+			return nil
+		}
+
+		ind.timer.Stop()
+		delete(t.indicators, k)
+		t.publish(chatID, userID, false, now)
+
+		return nil
+	}
+
+	expiresAt := now.Add(t.expiry)
+	if active {
+		ind.expiresAt = expiresAt
+		ind.timer.Reset(t.expiry)
+	} else {
+		t.indicators[k] = &indicator{
+			timer:     time.AfterFunc(t.expiry, func() { t.expire(k) }),
+			expiresAt: expiresAt,
+		}
+	}
+
+	t.publish(chatID, userID, true, expiresAt)
+
+	return nil
+}
+
+func (t *tracker) Subscribe(chatID, userID int64) *hub.Subscription[*model.TypingEvent] {
+	return t.hub.Subscribe(chatID, userID)
+}
+
+func (t *tracker) Kick(chatID, userID int64) {
+	t.hub.Kick(chatID, userID)
+}
+
+// expire clears an indicator that was not refreshed in time
+func (t *tracker) expire(k key) {
+	now := time.Now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	ind, ok := t.indicators[k]
+	// a refresh may have raced with the timer firing
+	if !ok || now.Before(ind.expiresAt) {
+		return
+	}
+
+	delete(t.indicators, k)
+	t.publish(k.chatID, k.userID, false, now)
+}
+
+// publish must be called with t.mu held; the typing user does not get their own events
+func (t *tracker) publish(chatID, userID int64, isTyping bool, expiresAt time.Time) {
+	t.hub.Publish(chatID, &model.TypingEvent{
+		ChatID:    chatID,
+		UserID:    userID,
+		IsTyping:  isTyping,
+		ExpiresAt: expiresAt,
+	}, userID)
+}
+
+// allow must be called with t.mu held
+func (t *tracker) allow(userID int64, now time.Time) bool {
+	if now.Sub(t.sweptAt) > bucketIdleTimeout {
+		t.sweep(now)
+	}
+
+	b, ok := t.buckets[userID]
+	if !ok {
+		b = &bucket{tokens: t.burst, updatedAt: now}
+		t.buckets[userID] = b
+	}
+
+	b.tokens = min(t.burst, b.tokens+now.Sub(b.updatedAt).Seconds()*t.rate)
+	b.updatedAt = now
+
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+	return true
+}
+
+// sweep drops buckets that have refilled completely, they carry no state
+func (t *tracker) sweep(now time.Time) {
+	for userID, b := range t.buckets {
+		if b.tokens+now.Sub(b.updatedAt).Seconds()*t.rate >= t.burst {
+			delete(t.buckets, userID)
+		}
+	}
+
+	t.sweptAt = now
+}
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE Chats ADD COLUMN last_activity_at TIMESTAMP NOT NULL DEFAULT now();
+CREATE INDEX chats_user_ids_idx ON Chats USING GIN (user_ids);
+CREATE INDEX chats_last_activity_idx ON Chats (last_activity_at DESC, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX chats_last_activity_idx;
+DROP INDEX chats_user_ids_idx;
+ALTER TABLE Chats DROP COLUMN last_activity_at;
+-- +goose StatementEnd
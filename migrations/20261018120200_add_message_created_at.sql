@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE Message ADD COLUMN created_at TIMESTAMP NOT NULL DEFAULT now();
+CREATE INDEX message_chat_id_created_at_idx ON Message (chat_id, created_at, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX message_chat_id_created_at_idx;
+ALTER TABLE Message DROP COLUMN created_at;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE Message ADD COLUMN edited_at TIMESTAMP;
+CREATE TABLE Message_revisions
+(
+    id         SERIAL PRIMARY KEY,
+    message_id UUID      NOT NULL,
+    chat_id    INT       NOT NULL,
+    text       TEXT,
+    edited_at  TIMESTAMP NOT NULL DEFAULT now(),
+    FOREIGN KEY (message_id, chat_id) REFERENCES Message (id, chat_id) ON DELETE CASCADE
+);
+CREATE INDEX message_revisions_message_idx ON Message_revisions (chat_id, message_id, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE Message_revisions;
+ALTER TABLE Message DROP COLUMN edited_at;
+-- +goose StatementEnd
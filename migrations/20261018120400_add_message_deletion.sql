@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE Message ADD COLUMN deleted_at TIMESTAMP;
+CREATE TABLE Message_hidden
+(
+    message_id UUID NOT NULL,
+    chat_id    INT  NOT NULL,
+    user_id    INT  NOT NULL,
+    PRIMARY KEY (message_id, chat_id, user_id),
+    FOREIGN KEY (message_id, chat_id) REFERENCES Message (id, chat_id) ON DELETE CASCADE
+);
+CREATE INDEX message_hidden_user_idx ON Message_hidden (chat_id, user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE Message_hidden;
+ALTER TABLE Message DROP COLUMN deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE Chat_members
+(
+    chat_id   INT         NOT NULL REFERENCES Chats (id) ON DELETE CASCADE,
+    user_id   INT         NOT NULL,
+    joined_at TIMESTAMP   NOT NULL DEFAULT now(),
+    role      VARCHAR(16) NOT NULL DEFAULT 'member',
+    PRIMARY KEY (chat_id, user_id)
+);
+CREATE INDEX chat_members_user_id_idx ON Chat_members (user_id);
+
+INSERT INTO Chat_members (chat_id, user_id, joined_at)
+SELECT DISTINCT c.id, u.user_id, c.created_at
+FROM Chats c, unnest(c.user_ids) AS u(user_id)
+WHERE u.user_id IS NOT NULL;
+
+DROP INDEX chats_user_ids_idx;
+ALTER TABLE Chats DROP COLUMN user_ids;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE Chats ADD COLUMN user_ids INT[];
+UPDATE Chats c
+SET user_ids = (SELECT array_agg(m.user_id ORDER BY m.joined_at, m.user_id) FROM Chat_members m WHERE m.chat_id = c.id);
+CREATE INDEX chats_user_ids_idx ON Chats USING GIN (user_ids);
+DROP TABLE Chat_members;
+-- +goose StatementEnd
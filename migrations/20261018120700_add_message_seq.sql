@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE Chats ADD COLUMN last_seq BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE Message ADD COLUMN seq BIGINT;
+
+UPDATE Message m
+SET seq = numbered.seq
+FROM (SELECT id, chat_id, row_number() OVER (PARTITION BY chat_id ORDER BY created_at, id) AS seq
+      FROM Message) AS numbered
+WHERE m.id = numbered.id
+  AND m.chat_id = numbered.chat_id;
+
+UPDATE Chats c
+SET last_seq = COALESCE((SELECT max(m.seq) FROM Message m WHERE m.chat_id = c.id), 0);
+
+ALTER TABLE Message ALTER COLUMN seq SET NOT NULL;
+CREATE UNIQUE INDEX message_chat_id_seq_idx ON Message (chat_id, seq);
+DROP INDEX message_chat_id_created_at_idx;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE INDEX message_chat_id_created_at_idx ON Message (chat_id, created_at, id);
+DROP INDEX message_chat_id_seq_idx;
+ALTER TABLE Message DROP COLUMN seq;
+ALTER TABLE Chats DROP COLUMN last_seq;
+-- +goose StatementEnd
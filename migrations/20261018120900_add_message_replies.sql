@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE Message ADD COLUMN reply_to_id UUID;
+ALTER TABLE Message ADD COLUMN reply_count INT NOT NULL DEFAULT 0;
+ALTER TABLE Message
+    ADD CONSTRAINT message_reply_to_fkey FOREIGN KEY (reply_to_id, chat_id) REFERENCES Message (id, chat_id) ON DELETE CASCADE;
+CREATE INDEX message_reply_to_idx ON Message (chat_id, reply_to_id, seq) WHERE reply_to_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX message_reply_to_idx;
+ALTER TABLE Message DROP CONSTRAINT message_reply_to_fkey;
+ALTER TABLE Message DROP COLUMN reply_count;
+ALTER TABLE Message DROP COLUMN reply_to_id;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE Message_reactions
+(
+    message_id UUID        NOT NULL,
+    chat_id    INT         NOT NULL,
+    user_id    INT         NOT NULL,
+    emoji      VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP   NOT NULL DEFAULT now(),
+    PRIMARY KEY (message_id, chat_id, user_id, emoji),
+    FOREIGN KEY (message_id, chat_id) REFERENCES Message (id, chat_id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE Message_reactions;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE Message ADD COLUMN system_event VARCHAR(32);
+ALTER TABLE Message ADD COLUMN subject_id UUID;
+CREATE TABLE Message_pins
+(
+    chat_id    INT       NOT NULL,
+    message_id UUID      NOT NULL,
+    pinned_by  INT       NOT NULL,
+    pinned_at  TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (chat_id, message_id),
+    FOREIGN KEY (message_id, chat_id) REFERENCES Message (id, chat_id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE Message_pins;
+ALTER TABLE Message DROP COLUMN subject_id;
+ALTER TABLE Message DROP COLUMN system_event;
+-- +goose StatementEnd
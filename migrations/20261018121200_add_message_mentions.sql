@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE Message_mentions
+(
+    message_id   UUID NOT NULL,
+    chat_id      INT  NOT NULL,
+    -- NULL stands for @all
+    user_id      INT,
+    start_offset INT  NOT NULL,
+    length       INT  NOT NULL,
+    FOREIGN KEY (message_id, chat_id) REFERENCES Message (id, chat_id) ON DELETE CASCADE
+);
+
+CREATE INDEX message_mentions_message_idx ON Message_mentions (message_id, chat_id);
+CREATE INDEX message_mentions_user_idx ON Message_mentions (user_id);
+CREATE INDEX message_mentions_all_idx ON Message_mentions (chat_id) WHERE user_id IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE Message_mentions;
+-- +goose StatementEnd
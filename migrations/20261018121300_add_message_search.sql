@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the 'simple' configuration does no stemming, chats mix languages
+ALTER TABLE Message
+    ADD COLUMN text_tsv TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', coalesce(text, ''))) STORED;
+CREATE INDEX message_text_tsv_idx ON Message USING GIN (text_tsv);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX message_text_tsv_idx;
+ALTER TABLE Message DROP COLUMN text_tsv;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE Message_attachments
+(
+    id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    chat_id     INT          NOT NULL REFERENCES Chats (id) ON DELETE CASCADE,
+    uploader_id INT          NOT NULL,
+    -- NULL until the attachment is sent with a message
+    message_id  UUID,
+    file_name   VARCHAR(255) NOT NULL,
+    mime_type   VARCHAR(255) NOT NULL,
+    size        BIGINT       NOT NULL,
+    sha256      CHAR(64)     NOT NULL,
+    storage_key VARCHAR(64)  NOT NULL,
+    created_at  TIMESTAMP    NOT NULL DEFAULT now(),
+    FOREIGN KEY (message_id, chat_id) REFERENCES Message (id, chat_id) ON DELETE CASCADE
+);
+
+CREATE INDEX message_attachments_message_idx ON Message_attachments (message_id, chat_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE Message_attachments;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- no foreign keys, a forwarded copy outlives the original
+ALTER TABLE Message ADD COLUMN forwarded_from_user_id INT;
+ALTER TABLE Message ADD COLUMN forwarded_from_chat_id INT;
+ALTER TABLE Message ADD COLUMN forwarded_from_message_id UUID;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE Message DROP COLUMN forwarded_from_message_id;
+ALTER TABLE Message DROP COLUMN forwarded_from_chat_id;
+ALTER TABLE Message DROP COLUMN forwarded_from_user_id;
+-- +goose StatementEnd
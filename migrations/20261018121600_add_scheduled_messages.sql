@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE Scheduled_messages
+(
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    chat_id    INT       NOT NULL REFERENCES Chats (id) ON DELETE CASCADE,
+    user_id    INT       NOT NULL,
+    text       TEXT      NOT NULL,
+    -- UTC
+    send_at    TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    -- set once the delivery was given up, the row is deleted when it succeeds
+    failure    TEXT
+);
+
+CREATE INDEX scheduled_messages_due_idx ON Scheduled_messages (send_at) WHERE failure IS NULL;
+CREATE INDEX scheduled_messages_chat_user_idx ON Scheduled_messages (chat_id, user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE Scheduled_messages;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- NULL keeps messages forever
+ALTER TABLE Chats ADD COLUMN message_ttl_seconds INT;
+ALTER TABLE Message ADD COLUMN expires_at TIMESTAMP;
+CREATE INDEX message_expires_at_idx ON Message (expires_at) WHERE expires_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX message_expires_at_idx;
+ALTER TABLE Message DROP COLUMN expires_at;
+ALTER TABLE Chats DROP COLUMN message_ttl_seconds;
+-- +goose StatementEnd
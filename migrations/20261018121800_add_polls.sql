@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the question is the text of the poll message
+CREATE TABLE Polls
+(
+    message_id   UUID    NOT NULL,
+    chat_id      INT     NOT NULL,
+    multi_choice BOOLEAN NOT NULL DEFAULT false,
+    anonymous    BOOLEAN NOT NULL DEFAULT false,
+    closes_at    TIMESTAMP,
+    closed_at    TIMESTAMP,
+    PRIMARY KEY (message_id, chat_id),
+    FOREIGN KEY (message_id, chat_id) REFERENCES Message (id, chat_id) ON DELETE CASCADE
+);
+
+CREATE TABLE Poll_options
+(
+    message_id   UUID         NOT NULL,
+    chat_id      INT          NOT NULL,
+    option_index SMALLINT     NOT NULL,
+    text         VARCHAR(100) NOT NULL,
+    PRIMARY KEY (message_id, chat_id, option_index),
+    FOREIGN KEY (message_id, chat_id) REFERENCES Polls (message_id, chat_id) ON DELETE CASCADE
+);
+
+CREATE TABLE Poll_votes
+(
+    message_id   UUID      NOT NULL,
+    chat_id      INT       NOT NULL,
+    option_index SMALLINT  NOT NULL,
+    user_id      INT       NOT NULL,
+    created_at   TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (message_id, chat_id, option_index, user_id),
+    FOREIGN KEY (message_id, chat_id, option_index) REFERENCES Poll_options (message_id, chat_id, option_index) ON DELETE CASCADE
+);
+
+CREATE INDEX poll_votes_user_idx ON Poll_votes (message_id, chat_id, user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE Poll_votes;
+DROP TABLE Poll_options;
+DROP TABLE Polls;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the creator of existing chats is unknown, their earliest member becomes the owner
+UPDATE Chat_members m
+SET role = 'owner'
+FROM (SELECT DISTINCT ON (chat_id) chat_id, user_id
+      FROM Chat_members
+      ORDER BY chat_id, joined_at, user_id) f
+WHERE m.chat_id = f.chat_id
+  AND m.user_id = f.user_id;
+ALTER TABLE Chat_members ADD CONSTRAINT chat_members_role_check CHECK (role IN ('owner', 'admin', 'member'));
+CREATE UNIQUE INDEX chat_members_owner_idx ON Chat_members (chat_id) WHERE role = 'owner';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX chat_members_owner_idx;
+ALTER TABLE Chat_members DROP CONSTRAINT chat_members_role_check;
+UPDATE Chat_members SET role = 'member';
+-- +goose StatementEnd
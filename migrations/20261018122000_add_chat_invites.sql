@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- only the SHA-256 of the token is kept, the token itself is shown once
+CREATE TABLE Chat_invites
+(
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    chat_id    INT       NOT NULL REFERENCES Chats (id) ON DELETE CASCADE,
+    token_hash CHAR(64)  NOT NULL UNIQUE,
+    created_by INT       NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    expires_at TIMESTAMP,
+    max_uses   INT,
+    uses       INT       NOT NULL DEFAULT 0,
+    revoked_at TIMESTAMP
+);
+CREATE INDEX chat_invites_chat_id_idx ON Chat_invites (chat_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE Chat_invites;
+-- +goose StatementEnd
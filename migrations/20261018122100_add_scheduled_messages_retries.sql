@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- a delivery that failed for a transient reason is retried at retry_at (UTC)
+ALTER TABLE Scheduled_messages ADD COLUMN attempts INT NOT NULL DEFAULT 0;
+ALTER TABLE Scheduled_messages ADD COLUMN retry_at TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE Scheduled_messages DROP COLUMN retry_at;
+ALTER TABLE Scheduled_messages DROP COLUMN attempts;
+-- +goose StatementEnd